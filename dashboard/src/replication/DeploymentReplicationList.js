@@ -24,11 +24,11 @@ const HeaderView = ({loading}) => (
   </Table.Header>
 );
 
-const RowView = ({name, mode, stateColor, source, destination, deleteCommand, describeCommand}) => (
+const RowView = ({name, mode, stateColor, paused, source, destination, deleteCommand, describeCommand}) => (
   <Table.Row>
     <Table.Cell>
-      <Popup trigger={<Icon name={(stateColor==="green") ? "check" : "bell"} color={stateColor}/>}>
-        {getStateColorDescription(stateColor)}
+      <Popup trigger={<Icon name={paused ? "pause" : ((stateColor==="green") ? "check" : "bell")} color={stateColor}/>}>
+        {paused ? "Replication is paused." : getStateColorDescription(stateColor)}
       </Popup>
     </Table.Cell>
     <Table.Cell>
@@ -72,6 +72,7 @@ const ListView = ({items, loading}) => (
             name={item.name}
             namespace={item.namespace}
             stateColor={item.state_color}
+            paused={item.paused}
             source={item.source.deployment_name || item.source.master_endpoint}
             destination={item.destination.deployment_name || item.destination.master_endpoint}
             deleteCommand={createDeleteCommand(item.name, item.namespace)}
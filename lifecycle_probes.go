@@ -46,10 +46,11 @@ var (
 	}
 
 	probeInput struct {
-		SSL      bool
-		Auth     bool
-		Endpoint string
-		JWTPath  string
+		SSL           bool
+		Auth          bool
+		Endpoint      string
+		JWTPath       string
+		ClientKeyfile string
 	}
 )
 
@@ -60,20 +61,36 @@ func init() {
 	f.BoolVarP(&probeInput.Auth, "auth", "", false, "Determines if authentication is enabled")
 	f.StringVarP(&probeInput.Endpoint, "endpoint", "", "/_api/version", "Endpoint (path) to call for lifecycle probe")
 	f.StringVarP(&probeInput.JWTPath, "jwt", "", k8sutil.ClusterJWTSecretVolumeMountDir, "Path to the JWT tokens")
+	f.StringVarP(&probeInput.ClientKeyfile, "client-keyfile", "", "", "Path to the keyfile with client certificate")
 }
 
-func probeClient() *http.Client {
+func probeClient() (*http.Client, error) {
 	tr := &http.Transport{}
 
 	if probeInput.SSL {
 		tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+		if probeInput.ClientKeyfile != "" {
+			data, err := ioutil.ReadFile(probeInput.ClientKeyfile)
+			if err != nil {
+				return nil, err
+			}
+
+			// Keyfile contains both certificate and private key
+			cert, err := tls.X509KeyPair(data, data)
+			if err != nil {
+				return nil, err
+			}
+
+			tr.TLSClientConfig.Certificates = []tls.Certificate{cert}
+		}
 	}
 
 	client := &http.Client{
 		Transport: tr,
 	}
 
-	return client
+	return client, nil
 }
 
 func probeEndpoint(endpoint string) string {
@@ -148,7 +165,10 @@ func addAuthHeader(req *http.Request) error {
 }
 
 func doRequest() (*http.Response, error) {
-	client := probeClient()
+	client, err := probeClient()
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := http.NewRequest(http.MethodGet, probeEndpoint(probeInput.Endpoint), nil)
 	if err != nil {
@@ -38,6 +38,8 @@ import (
 	"github.com/rs/zerolog/log"
 
 	deploymentApi "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/audit"
+	"github.com/arangodb/kube-arangodb/pkg/notification"
 
 	"github.com/arangodb/kube-arangodb/pkg/util"
 
@@ -58,11 +60,13 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/scheme"
 	"github.com/arangodb/kube-arangodb/pkg/logging"
 	"github.com/arangodb/kube-arangodb/pkg/operator"
+	"github.com/arangodb/kube-arangodb/pkg/operator/shard"
 	"github.com/arangodb/kube-arangodb/pkg/server"
 	"github.com/arangodb/kube-arangodb/pkg/util/constants"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	"github.com/arangodb/kube-arangodb/pkg/util/probe"
 	"github.com/arangodb/kube-arangodb/pkg/util/retry"
+	"github.com/arangodb/kube-arangodb/pkg/util/tracing"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/klog"
 )
@@ -113,9 +117,39 @@ var (
 		singleMode bool
 		scope      string
 	}
+	leaderElectionOptions struct {
+		lockType      string
+		leaseDuration time.Duration
+		renewDeadline time.Duration
+		retryPeriod   time.Duration
+	}
+	watchOptions struct {
+		namespaces        []string
+		namespaceSelector string
+		resourceSelector  string
+	}
+	shardOptions struct {
+		index    int
+		count    int
+		selector string
+	}
 	chaosOptions struct {
 		allowed bool
 	}
+	auditOptions struct {
+		sink   string
+		target string
+	}
+	notificationOptions struct {
+		webhookURL   string
+		format       string
+		types        []string
+		retryTimeout time.Duration
+	}
+	tracingOptions struct {
+		otlpEndpoint string
+		serviceName  string
+	}
 	livenessProbe              probe.LivenessProbe
 	deploymentProbe            probe.ReadyProbe
 	deploymentReplicationProbe probe.ReadyProbe
@@ -143,6 +177,25 @@ func init() {
 	f.BoolVar(&operatorOptions.singleMode, "mode.single", false, "Enable single mode in Operator. WARNING: There should be only one replica of Operator, otherwise Operator can take unexpected actions")
 	f.StringVar(&operatorOptions.scope, "scope", scope.DefaultScope.String(), "Define scope on which Operator works. Legacy - pre 1.1.0 scope with limited cluster access")
 
+	f.StringArrayVar(&watchOptions.namespaces, "watch.namespace", nil, "Namespace watched by Operator in multi-namespace scope. Can be repeated")
+	f.StringVar(&watchOptions.namespaceSelector, "watch.namespace-selector", "", "Label selector of namespaces watched by Operator in multi-namespace scope")
+	f.StringVar(&watchOptions.resourceSelector, "watch.resource-selector", "", "Label selector of custom resources handled by Operator. Allows multiple Operators to coexist in one cluster")
+	f.StringVar(&leaderElectionOptions.lockType, "leader-election.lock-type", "leases", "Type of the leader election lock (endpoints or leases). Replicas with different types do not see each other, so scale the Operator to zero once before changing it, also when upgrading from versions using endpoints")
+	f.DurationVar(&leaderElectionOptions.leaseDuration, "leader-election.lease-duration", operator.DefaultLeaseDuration, "Duration that non-leader Operators will wait to force acquire leadership")
+	f.DurationVar(&leaderElectionOptions.renewDeadline, "leader-election.renew-deadline", operator.DefaultRenewDeadline, "Duration that the acting leader will retry refreshing leadership before giving up")
+	f.DurationVar(&leaderElectionOptions.retryPeriod, "leader-election.retry-period", operator.DefaultRetryPeriod, "Duration between leader election actions")
+	f.IntVar(&shardOptions.index, "operator.deployment.shard-index", 0, "Index of the ArangoDeployment shard handled by this Operator")
+	f.IntVar(&shardOptions.count, "operator.deployment.shard-count", 1, "Number of shards ArangoDeployments are split into by hash of their name. Each shard has its own leader")
+	f.StringVar(&shardOptions.selector, "operator.deployment.shard-selector", "", "Label selector of ArangoDeployments handled by this Operator")
+	f.StringVar(&auditOptions.sink, "audit.sink", string(audit.SinkTypeNone), "Sink of the audit log of operator actions. Possible values: none, stdout, file, webhook")
+	f.StringVar(&auditOptions.target, "audit.target", "", "Path of the audit log file (file sink) or URL receiving audit records (webhook sink)")
+	f.StringVar(&notificationOptions.webhookURL, "notification.webhook-url", "", "URL of the webhook receiving notifications about deployment lifecycle events. Notifications are disabled if empty")
+	f.StringVar(&notificationOptions.format, "notification.format", string(notification.FormatGeneric), "Format of the notifications. Possible values: generic, slack, cloudevents")
+	f.StringArrayVar(&notificationOptions.types, "notification.type", nil, "Type of the notifications sent to the webhook. Can be repeated. All types are sent if not set")
+	f.DurationVar(&notificationOptions.retryTimeout, "notification.retry-timeout", notification.DefaultRetryTimeout, "Time after which the delivery of the notification is given up")
+	f.StringVar(&tracingOptions.otlpEndpoint, "tracing.otlp-endpoint", "", "URL of the OTLP/HTTP collector receiving reconciliation traces. Tracing is disabled if empty")
+	f.StringVar(&tracingOptions.serviceName, "tracing.service-name", tracing.DefaultServiceName, "Service name reported with the traces")
+
 	features.Init(&cmdMain)
 }
 
@@ -185,6 +238,38 @@ func cmdMainRun(cmd *cobra.Command, args []string) {
 	klog.Info("nice to meet you")
 	klog.Flush()
 
+	auditSink, err := audit.NewSink(audit.SinkType(auditOptions.sink), auditOptions.target)
+	if err != nil {
+		cliLog.Fatal().Err(err).Msg("Failed to create audit sink")
+	}
+	audit.SetSink(auditSink)
+
+	if notificationOptions.webhookURL != "" {
+		types := make([]k8sutil.EventNotification, len(notificationOptions.types))
+		for id, t := range notificationOptions.types {
+			types[id] = k8sutil.EventNotification(t)
+		}
+
+		notifier, err := notification.NewNotifier(notification.Config{
+			URL:          notificationOptions.webhookURL,
+			Format:       notification.Format(notificationOptions.format),
+			Types:        types,
+			RetryTimeout: notificationOptions.retryTimeout,
+		}, nil)
+		if err != nil {
+			cliLog.Fatal().Err(err).Msg("Failed to create notifier")
+		}
+		notification.SetNotifier(notifier)
+	}
+
+	if tracingOptions.otlpEndpoint != "" {
+		exporter, err := tracing.NewOTLPExporter(tracingOptions.otlpEndpoint, tracingOptions.serviceName, nil)
+		if err != nil {
+			cliLog.Fatal().Err(err).Msg("Failed to create tracing exporter")
+		}
+		tracing.SetExporter(exporter)
+	}
+
 	// Check operating mode
 	if !operatorOptions.enableDeployment && !operatorOptions.enableDeploymentReplication && !operatorOptions.enableStorage && !operatorOptions.enableBackup {
 		cliLog.Fatal().Err(err).Msg("Turn on --operator.deployment, --operator.deployment-replication, --operator.storage, --operator.backup or any combination of these")
@@ -301,13 +386,34 @@ func newOperatorConfigAndDeps(id, namespace, name string) (operator.Config, oper
 	}
 	eventRecorder := createRecorder(cliLog, kubecli, name, namespace)
 
-	scope, ok := scope.AsScope(operatorOptions.scope)
+	operatorScope, ok := scope.AsScope(operatorOptions.scope)
 	if !ok {
 		return operator.Config{}, operator.Dependencies{}, maskAny(fmt.Errorf("Scope %s is not known by Operator", operatorOptions.scope))
 	}
 
+	watch, err := scope.NewWatch(operatorScope, watchOptions.namespaces, watchOptions.namespaceSelector, watchOptions.resourceSelector)
+	if err != nil {
+		return operator.Config{}, operator.Dependencies{}, maskAny(fmt.Errorf("Invalid watch configuration: %s", err))
+	}
+
+	leaderElection := operator.LeaderElectionConfig{
+		LockType:      leaderElectionOptions.lockType,
+		LeaseDuration: leaderElectionOptions.leaseDuration,
+		RenewDeadline: leaderElectionOptions.renewDeadline,
+		RetryPeriod:   leaderElectionOptions.retryPeriod,
+	}
+	if err := leaderElection.Validate(); err != nil {
+		return operator.Config{}, operator.Dependencies{}, maskAny(fmt.Errorf("Invalid leader election configuration: %s", err))
+	}
+
+	deploymentShard, err := shard.New(shardOptions.index, shardOptions.count, shardOptions.selector)
+	if err != nil {
+		return operator.Config{}, operator.Dependencies{}, maskAny(fmt.Errorf("Invalid shard configuration: %s", err))
+	}
+
 	cfg := operator.Config{
 		ID:                          id,
+		Version:                     projectVersion,
 		Namespace:                   namespace,
 		PodName:                     name,
 		ServiceAccount:              serviceAccount,
@@ -321,7 +427,10 @@ func newOperatorConfigAndDeps(id, namespace, name string) (operator.Config, oper
 		MetricsExporterImage:        operatorOptions.metricsExporterImage,
 		ArangoImage:                 operatorOptions.arangoImage,
 		SingleMode:                  operatorOptions.singleMode,
-		Scope:                       scope,
+		Scope:                       operatorScope,
+		Watch:                       watch,
+		LeaderElection:              leaderElection,
+		Shard:                       deploymentShard,
 	}
 	deps := operator.Dependencies{
 		LogService:                 logService,
@@ -62,6 +62,7 @@ func (a *ArangoBackupPolicy) NewBackup(d *deployment.ArangoDeployment) *ArangoBa
 		},
 		Upload:     a.Spec.BackupTemplate.Upload.DeepCopy(),
 		Options:    a.Spec.BackupTemplate.Options.DeepCopy(),
+		Hooks:      a.Spec.BackupTemplate.Hooks.DeepCopy(),
 		PolicyName: &policyName,
 	}
 
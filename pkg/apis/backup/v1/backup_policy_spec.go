@@ -38,4 +38,6 @@ type ArangoBackupTemplate struct {
 	Options *ArangoBackupSpecOptions `json:"options,omitempty"`
 
 	Upload *ArangoBackupSpecOperation `json:"upload,omitempty"`
+
+	Hooks *ArangoBackupSpecHooks `json:"hooks,omitempty"`
 }
@@ -44,5 +44,9 @@ func (a *ArangoBackupPolicySpec) Validate() error {
 		return errors.Newf("invalid schedule format")
 	}
 
+	if err := a.BackupTemplate.Hooks.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -35,6 +35,25 @@ type ArangoBackupSpec struct {
 	Upload *ArangoBackupSpecOperation `json:"upload,omitempty"`
 
 	PolicyName *string `json:"policyName,omitempty"`
+
+	// Type of the backup. Defaults to hot backup
+	Type *ArangoBackupType `json:"type,omitempty"`
+
+	// Logical holds settings of the logical (arangodump) backup
+	Logical *ArangoBackupSpecLogical `json:"logical,omitempty"`
+
+	// Hooks executed before backup is created and after it is ready
+	Hooks *ArangoBackupSpecHooks `json:"hooks,omitempty"`
+}
+
+// GetType returns the type of the backup
+func (a *ArangoBackupSpec) GetType() ArangoBackupType {
+	return a.Type.Get()
+}
+
+// IsLogical returns true when backup is created with arangodump
+func (a *ArangoBackupSpec) IsLogical() bool {
+	return a.GetType() == ArangoBackupTypeLogical
 }
 
 type ArangoBackupSpecDeployment struct {
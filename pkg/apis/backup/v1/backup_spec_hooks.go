@@ -0,0 +1,298 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	batch "k8s.io/api/batch/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ArangoBackupHookPhase defines when hook is executed
+type ArangoBackupHookPhase string
+
+const (
+	// ArangoBackupHookPhasePre hooks are executed before backup is created
+	ArangoBackupHookPhasePre ArangoBackupHookPhase = "pre"
+	// ArangoBackupHookPhasePost hooks are executed once backup is ready
+	// or when backup failed after pre hooks were started
+	ArangoBackupHookPhasePost ArangoBackupHookPhase = "post"
+)
+
+// ArangoBackupHookFailurePolicy defines what happens when hook fails
+type ArangoBackupHookFailurePolicy string
+
+const (
+	// ArangoBackupHookFailurePolicyFail stops execution of the remaining hooks.
+	// Failure of the pre hook fails the backup
+	ArangoBackupHookFailurePolicyFail ArangoBackupHookFailurePolicy = "Fail"
+	// ArangoBackupHookFailurePolicyIgnore continues with the next hook
+	ArangoBackupHookFailurePolicyIgnore ArangoBackupHookFailurePolicy = "Ignore"
+)
+
+// Validate checks if failure policy is supported
+func (a ArangoBackupHookFailurePolicy) Validate() error {
+	switch a {
+	case ArangoBackupHookFailurePolicyFail, ArangoBackupHookFailurePolicyIgnore:
+		return nil
+	default:
+		return errors.Newf("hook failure policy %s is not supported", a)
+	}
+}
+
+const (
+	// DefaultBackupHookTimeout is used when hook timeout is not set
+	DefaultBackupHookTimeout = 5 * time.Minute
+)
+
+// ArangoBackupSpecHooks defines hooks executed around the backup
+type ArangoBackupSpecHooks struct {
+	// Pre hooks are executed one after another before backup is created
+	Pre []ArangoBackupHook `json:"pre,omitempty"`
+	// Post hooks are executed one after another once backup is ready
+	Post []ArangoBackupHook `json:"post,omitempty"`
+}
+
+// Get returns hooks of the given phase
+func (a *ArangoBackupSpecHooks) Get(phase ArangoBackupHookPhase) []ArangoBackupHook {
+	if a == nil {
+		return nil
+	}
+
+	switch phase {
+	case ArangoBackupHookPhasePre:
+		return a.Pre
+	case ArangoBackupHookPhasePost:
+		return a.Post
+	}
+
+	return nil
+}
+
+// Validate hooks of all phases
+func (a *ArangoBackupSpecHooks) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	for _, phase := range []ArangoBackupHookPhase{ArangoBackupHookPhasePre, ArangoBackupHookPhasePost} {
+		names := map[string]bool{}
+		for _, hook := range a.Get(phase) {
+			if err := hook.Validate(); err != nil {
+				return errors.Wrapf(err, "%s hook %s", phase, hook.Name)
+			}
+
+			if names[hook.Name] {
+				return errors.Newf("%s hook %s is defined more than once", phase, hook.Name)
+			}
+			names[hook.Name] = true
+		}
+	}
+
+	return nil
+}
+
+// ArangoBackupHook defines single action executed around the backup.
+// Exactly one of Job or Exec needs to be set.
+type ArangoBackupHook struct {
+	// Name of the hook, unique within the phase
+	Name string `json:"name"`
+
+	// Job is executed as Kubernetes Job in the namespace of the backup
+	Job *batch.JobSpec `json:"job,omitempty"`
+
+	// Exec runs command in the selected pods
+	Exec *ArangoBackupHookExec `json:"exec,omitempty"`
+
+	// Timeout of the hook. Defaults to 5 minutes
+	Timeout *meta.Duration `json:"timeout,omitempty"`
+
+	// FailurePolicy defines what happens when hook fails or times out. Defaults to Fail
+	FailurePolicy *ArangoBackupHookFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// GetTimeout returns timeout of the hook or default
+func (a ArangoBackupHook) GetTimeout() time.Duration {
+	if a.Timeout == nil {
+		return DefaultBackupHookTimeout
+	}
+
+	return a.Timeout.Duration
+}
+
+// GetFailurePolicy returns failure policy of the hook or default
+func (a ArangoBackupHook) GetFailurePolicy() ArangoBackupHookFailurePolicy {
+	if a.FailurePolicy == nil {
+		return ArangoBackupHookFailurePolicyFail
+	}
+
+	return *a.FailurePolicy
+}
+
+// Validate the hook
+func (a ArangoBackupHook) Validate() error {
+	if a.Name == "" {
+		return errors.Newf("name can not be empty")
+	}
+
+	if (a.Job == nil) == (a.Exec == nil) {
+		return errors.Newf("exactly one of job or exec needs to be defined")
+	}
+
+	if a.Exec != nil {
+		if err := a.Exec.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if a.Timeout != nil && a.Timeout.Duration <= 0 {
+		return errors.Newf("timeout needs to be positive")
+	}
+
+	if a.FailurePolicy != nil {
+		if err := a.FailurePolicy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ArangoBackupHookExec defines command executed in parallel in all running pods selected by the hook.
+// Hook fails when no running pod is selected.
+type ArangoBackupHookExec struct {
+	// Selector of the pods in the namespace of the backup
+	Selector *meta.LabelSelector `json:"selector"`
+
+	// Container in which command is executed. Defaults to the first container of the pod
+	Container string `json:"container,omitempty"`
+
+	// Command to execute
+	Command []string `json:"command"`
+}
+
+// Validate the exec hook
+func (a *ArangoBackupHookExec) Validate() error {
+	if a.Selector == nil {
+		return errors.Newf("exec selector can not be empty")
+	}
+
+	if len(a.Command) == 0 {
+		return errors.Newf("exec command can not be empty")
+	}
+
+	return nil
+}
+
+// ArangoBackupHookState defines state of the hook
+type ArangoBackupHookState string
+
+const (
+	// ArangoBackupHookStateRunning hook is in progress
+	ArangoBackupHookStateRunning ArangoBackupHookState = "Running"
+	// ArangoBackupHookStateSucceeded hook finished successfully
+	ArangoBackupHookStateSucceeded ArangoBackupHookState = "Succeeded"
+	// ArangoBackupHookStateFailed hook failed or timed out
+	ArangoBackupHookStateFailed ArangoBackupHookState = "Failed"
+)
+
+// ArangoBackupHookStatus keeps result of the hook
+type ArangoBackupHookStatus struct {
+	Name  string                `json:"name"`
+	Phase ArangoBackupHookPhase `json:"phase"`
+	State ArangoBackupHookState `json:"state"`
+
+	// Message with the hook output or failure reason
+	Message string `json:"message,omitempty"`
+
+	StartTime      meta.Time  `json:"startTime"`
+	CompletionTime *meta.Time `json:"completionTime,omitempty"`
+}
+
+func (a *ArangoBackupHookStatus) Equal(b *ArangoBackupHookStatus) bool {
+	if a == b {
+		return true
+	}
+
+	if a == nil && b != nil || a != nil && b == nil {
+		return false
+	}
+
+	return a.Name == b.Name &&
+		a.Phase == b.Phase &&
+		a.State == b.State &&
+		a.Message == b.Message &&
+		a.StartTime.Equal(&b.StartTime) &&
+		a.CompletionTime.Equal(b.CompletionTime)
+}
+
+// ArangoBackupHookStatusList keeps results of all hooks
+type ArangoBackupHookStatusList []ArangoBackupHookStatus
+
+// Get returns status of the hook
+func (a ArangoBackupHookStatusList) Get(phase ArangoBackupHookPhase, name string) (ArangoBackupHookStatus, bool) {
+	for _, s := range a {
+		if s.Phase == phase && s.Name == name {
+			return s, true
+		}
+	}
+
+	return ArangoBackupHookStatus{}, false
+}
+
+// HasPhase returns true if any hook of the phase was started
+func (a ArangoBackupHookStatusList) HasPhase(phase ArangoBackupHookPhase) bool {
+	for _, s := range a {
+		if s.Phase == phase {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Update returns list with the status replaced or appended
+func (a ArangoBackupHookStatusList) Update(status ArangoBackupHookStatus) ArangoBackupHookStatusList {
+	for id, s := range a {
+		if s.Phase == status.Phase && s.Name == status.Name {
+			a[id] = status
+			return a
+		}
+	}
+
+	return append(a, status)
+}
+
+func (a ArangoBackupHookStatusList) Equal(b ArangoBackupHookStatusList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for id := range a {
+		if !a[id].Equal(&b[id]) {
+			return false
+		}
+	}
+
+	return true
+}
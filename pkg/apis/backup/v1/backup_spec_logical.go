@@ -0,0 +1,158 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// ArangoBackupType defines how backup is taken
+type ArangoBackupType string
+
+const (
+	// ArangoBackupTypeHot backup is taken with hot backup API of the ArangoDB
+	ArangoBackupTypeHot ArangoBackupType = "hot"
+	// ArangoBackupTypeLogical backup is taken with arangodump and restored with arangorestore
+	ArangoBackupTypeLogical ArangoBackupType = "logical"
+)
+
+// Get returns type or default (hot) if not set
+func (a *ArangoBackupType) Get() ArangoBackupType {
+	if a == nil {
+		return ArangoBackupTypeHot
+	}
+
+	return *a
+}
+
+// New returns pointer to the type
+func (a ArangoBackupType) New() *ArangoBackupType {
+	return &a
+}
+
+// Validate checks if type is supported
+func (a ArangoBackupType) Validate() error {
+	switch a {
+	case ArangoBackupTypeHot, ArangoBackupTypeLogical:
+		return nil
+	default:
+		return errors.Newf("backup type %s is not supported", a)
+	}
+}
+
+// ArangoBackupSpecLogical defines where and how logical backup is stored
+type ArangoBackupSpecLogical struct {
+	// PersistentVolumeClaimName is the name of the claim where dump is written
+	PersistentVolumeClaimName *string `json:"persistentVolumeClaimName,omitempty"`
+
+	// ObjectStorage defines repository where dump is uploaded
+	ObjectStorage *ArangoBackupSpecOperation `json:"objectStorage,omitempty"`
+
+	// Databases which are included in the dump. All databases are dumped when empty
+	Databases []string `json:"databases,omitempty"`
+
+	// Threads used by arangodump and arangorestore
+	Threads *int `json:"threads,omitempty"`
+
+	// Image used by dump and restore jobs. Defaults to the image of the deployment
+	Image *string `json:"image,omitempty"`
+
+	// UploaderImage used to transfer dump from and to the object storage
+	UploaderImage *string `json:"uploaderImage,omitempty"`
+}
+
+const (
+	// DefaultLogicalUploaderImage is used when uploader image is not set
+	DefaultLogicalUploaderImage = "rclone/rclone:1.55"
+)
+
+// GetPersistentVolumeClaimName returns name of the claim or empty string
+func (a *ArangoBackupSpecLogical) GetPersistentVolumeClaimName() string {
+	if a == nil || a.PersistentVolumeClaimName == nil {
+		return ""
+	}
+
+	return *a.PersistentVolumeClaimName
+}
+
+// GetThreads returns number of threads or 0 if not set
+func (a *ArangoBackupSpecLogical) GetThreads() int {
+	if a == nil || a.Threads == nil {
+		return 0
+	}
+
+	return *a.Threads
+}
+
+// GetImage returns image or empty string if not set
+func (a *ArangoBackupSpecLogical) GetImage() string {
+	if a == nil || a.Image == nil {
+		return ""
+	}
+
+	return *a.Image
+}
+
+// GetUploaderImage returns uploader image or default one
+func (a *ArangoBackupSpecLogical) GetUploaderImage() string {
+	if a == nil || a.UploaderImage == nil {
+		return DefaultLogicalUploaderImage
+	}
+
+	return *a.UploaderImage
+}
+
+// Validate checks logical backup spec
+func (a *ArangoBackupSpecLogical) Validate() error {
+	if a.PersistentVolumeClaimName == nil && a.ObjectStorage == nil {
+		return errors.Newf("persistentVolumeClaimName or objectStorage needs to be defined")
+	}
+
+	if a.PersistentVolumeClaimName != nil && a.ObjectStorage != nil {
+		return errors.Newf("persistentVolumeClaimName and objectStorage can not be defined together")
+	}
+
+	if a.PersistentVolumeClaimName != nil && *a.PersistentVolumeClaimName == "" {
+		return errors.Newf("persistentVolumeClaimName can not be empty")
+	}
+
+	if a.ObjectStorage != nil {
+		if err := a.ObjectStorage.Validate(); err != nil {
+			return err
+		}
+
+		if a.ObjectStorage.CredentialsSecretName == "" {
+			return errors.Newf("objectStorage credentialsSecretName can not be empty")
+		}
+	}
+
+	for _, db := range a.Databases {
+		if db == "" {
+			return errors.Newf("database name can not be empty")
+		}
+	}
+
+	if a.Threads != nil && *a.Threads <= 0 {
+		return errors.Newf("threads needs to be greater than 0")
+	}
+
+	return nil
+}
@@ -42,6 +42,7 @@ const (
 	ArangoBackupStateDeleted       state.State = "Deleted"
 	ArangoBackupStateFailed        state.State = "Failed"
 	ArangoBackupStateUnavailable   state.State = "Unavailable"
+	ArangoBackupStateRemote        state.State = "Remote"
 )
 
 var ArangoBackupStateMap = state.Map{
@@ -59,6 +60,7 @@ var ArangoBackupStateMap = state.Map{
 	ArangoBackupStateDeleted:       {ArangoBackupStateFailed, ArangoBackupStateReady},
 	ArangoBackupStateFailed:        {ArangoBackupStatePending},
 	ArangoBackupStateUnavailable:   {ArangoBackupStateReady, ArangoBackupStateDeleted, ArangoBackupStateFailed},
+	ArangoBackupStateRemote:        {ArangoBackupStatePending, ArangoBackupStateFailed},
 }
 
 type ArangoBackupState struct {
@@ -33,6 +33,9 @@ type ArangoBackupStatus struct {
 	ArangoBackupState `json:",inline"`
 	Backup            *ArangoBackupDetails `json:"backup,omitempty"`
 	Available         bool                 `json:"available"`
+
+	// Hooks keeps results of the backup hooks
+	Hooks ArangoBackupHookStatusList `json:"hooks,omitempty"`
 }
 
 func (a *ArangoBackupStatus) Equal(b *ArangoBackupStatus) bool {
@@ -46,7 +49,8 @@ func (a *ArangoBackupStatus) Equal(b *ArangoBackupStatus) bool {
 
 	return a.ArangoBackupState.Equal(&b.ArangoBackupState) &&
 		a.Backup.Equal(b.Backup) &&
-		a.Available == b.Available
+		a.Available == b.Available &&
+		a.Hooks.Equal(b.Hooks)
 }
 
 type ArangoBackupDetails struct {
@@ -60,6 +64,10 @@ type ArangoBackupDetails struct {
 	Imported                *bool           `json:"imported,omitempty"`
 	CreationTimestamp       meta.Time       `json:"createdAt"`
 	Keys                    shared.HashList `json:"keys,omitempty"`
+	RepositoryURL           string          `json:"repositoryURL,omitempty"`
+
+	// Logical keeps details of the logical backup
+	Logical *ArangoBackupLogicalDetails `json:"logical,omitempty"`
 }
 
 func (a *ArangoBackupDetails) Equal(b *ArangoBackupDetails) bool {
@@ -80,7 +88,36 @@ func (a *ArangoBackupDetails) Equal(b *ArangoBackupDetails) bool {
 		compareBoolPointer(a.Uploaded, b.Uploaded) &&
 		compareBoolPointer(a.Downloaded, b.Downloaded) &&
 		compareBoolPointer(a.Imported, b.Imported) &&
-		a.Keys.Equal(b.Keys)
+		a.Keys.Equal(b.Keys) &&
+		a.RepositoryURL == b.RepositoryURL &&
+		a.Logical.Equal(b.Logical)
+}
+
+// ArangoBackupLogicalDetails keeps information where logical backup is stored
+type ArangoBackupLogicalDetails struct {
+	// JobName is the name of the job which created the dump
+	JobName string `json:"jobName,omitempty"`
+	// PersistentVolumeClaimName is the name of the claim which keeps the dump
+	PersistentVolumeClaimName string `json:"persistentVolumeClaimName,omitempty"`
+	// RepositoryURL is the location of the dump in the object storage
+	RepositoryURL string `json:"repositoryURL,omitempty"`
+	// Path of the dump in the claim or repository
+	Path string `json:"path"`
+}
+
+func (a *ArangoBackupLogicalDetails) Equal(b *ArangoBackupLogicalDetails) bool {
+	if a == b {
+		return true
+	}
+
+	if a == nil && b != nil || a != nil && b == nil {
+		return false
+	}
+
+	return a.JobName == b.JobName &&
+		a.PersistentVolumeClaimName == b.PersistentVolumeClaimName &&
+		a.RepositoryURL == b.RepositoryURL &&
+		a.Path == b.Path
 }
 
 func compareBoolPointer(a, b *bool) bool {
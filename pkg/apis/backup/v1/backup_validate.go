@@ -53,6 +53,32 @@ func (a *ArangoBackupSpec) Validate() error {
 		}
 	}
 
+	if a.Type != nil {
+		if err := a.Type.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if a.IsLogical() {
+		if a.Logical == nil {
+			return errors.Newf("logical spec needs to be defined for logical backup")
+		}
+
+		if a.Download != nil || a.Upload != nil {
+			return errors.Newf("download and upload are not supported for logical backup")
+		}
+
+		if err := a.Logical.Validate(); err != nil {
+			return err
+		}
+	} else if a.Logical != nil {
+		return errors.Newf("logical spec can be defined only for logical backup")
+	}
+
+	if err := a.Hooks.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -26,6 +26,7 @@ package v1
 
 import (
 	sharedv1 "github.com/arangodb/kube-arangodb/pkg/apis/shared/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -87,6 +88,11 @@ func (in *ArangoBackupDetails) DeepCopyInto(out *ArangoBackupDetails) {
 		*out = make(sharedv1.HashList, len(*in))
 		copy(*out, *in)
 	}
+	if in.Logical != nil {
+		in, out := &in.Logical, &out.Logical
+		*out = new(ArangoBackupLogicalDetails)
+		**out = **in
+	}
 	return
 }
 
@@ -100,6 +106,111 @@ func (in *ArangoBackupDetails) DeepCopy() *ArangoBackupDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupHook) DeepCopyInto(out *ArangoBackupHook) {
+	*out = *in
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(batchv1.JobSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(ArangoBackupHookExec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(ArangoBackupHookFailurePolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupHook.
+func (in *ArangoBackupHook) DeepCopy() *ArangoBackupHook {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupHookExec) DeepCopyInto(out *ArangoBackupHookExec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupHookExec.
+func (in *ArangoBackupHookExec) DeepCopy() *ArangoBackupHookExec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupHookExec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupHookStatus) DeepCopyInto(out *ArangoBackupHookStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupHookStatus.
+func (in *ArangoBackupHookStatus) DeepCopy() *ArangoBackupHookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupHookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ArangoBackupHookStatusList) DeepCopyInto(out *ArangoBackupHookStatusList) {
+	{
+		in := &in
+		*out = make(ArangoBackupHookStatusList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupHookStatusList.
+func (in ArangoBackupHookStatusList) DeepCopy() ArangoBackupHookStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupHookStatusList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupList) DeepCopyInto(out *ArangoBackupList) {
 	*out = *in
@@ -133,6 +244,22 @@ func (in *ArangoBackupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupLogicalDetails) DeepCopyInto(out *ArangoBackupLogicalDetails) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupLogicalDetails.
+func (in *ArangoBackupLogicalDetails) DeepCopy() *ArangoBackupLogicalDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupLogicalDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupPolicy) DeepCopyInto(out *ArangoBackupPolicy) {
 	*out = *in
@@ -273,6 +400,21 @@ func (in *ArangoBackupSpec) DeepCopyInto(out *ArangoBackupSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(ArangoBackupType)
+		**out = **in
+	}
+	if in.Logical != nil {
+		in, out := &in.Logical, &out.Logical
+		*out = new(ArangoBackupSpecLogical)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(ArangoBackupSpecHooks)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -319,6 +461,82 @@ func (in *ArangoBackupSpecDownload) DeepCopy() *ArangoBackupSpecDownload {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupSpecHooks) DeepCopyInto(out *ArangoBackupSpecHooks) {
+	*out = *in
+	if in.Pre != nil {
+		in, out := &in.Pre, &out.Pre
+		*out = make([]ArangoBackupHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Post != nil {
+		in, out := &in.Post, &out.Post
+		*out = make([]ArangoBackupHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupSpecHooks.
+func (in *ArangoBackupSpecHooks) DeepCopy() *ArangoBackupSpecHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupSpecHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoBackupSpecLogical) DeepCopyInto(out *ArangoBackupSpecLogical) {
+	*out = *in
+	if in.PersistentVolumeClaimName != nil {
+		in, out := &in.PersistentVolumeClaimName, &out.PersistentVolumeClaimName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ObjectStorage != nil {
+		in, out := &in.ObjectStorage, &out.ObjectStorage
+		*out = new(ArangoBackupSpecOperation)
+		**out = **in
+	}
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Threads != nil {
+		in, out := &in.Threads, &out.Threads
+		*out = new(int)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.UploaderImage != nil {
+		in, out := &in.UploaderImage, &out.UploaderImage
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoBackupSpecLogical.
+func (in *ArangoBackupSpecLogical) DeepCopy() *ArangoBackupSpecLogical {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoBackupSpecLogical)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoBackupSpecOperation) DeepCopyInto(out *ArangoBackupSpecOperation) {
 	*out = *in
@@ -392,6 +610,13 @@ func (in *ArangoBackupStatus) DeepCopyInto(out *ArangoBackupStatus) {
 		*out = new(ArangoBackupDetails)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make(ArangoBackupHookStatusList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -418,6 +643,11 @@ func (in *ArangoBackupTemplate) DeepCopyInto(out *ArangoBackupTemplate) {
 		*out = new(ArangoBackupSpecOperation)
 		**out = **in
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(ArangoBackupSpecHooks)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
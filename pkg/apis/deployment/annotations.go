@@ -23,9 +23,13 @@
 package deployment
 
 const (
-	ArangoDeploymentAnnotationPrefix         = "deployment.arangodb.com"
-	ArangoDeploymentPodMaintenanceAnnotation = ArangoDeploymentAnnotationPrefix + "/maintenance"
-	ArangoDeploymentPodRotateAnnotation      = ArangoDeploymentAnnotationPrefix + "/rotate"
-	ArangoDeploymentPodReplaceAnnotation     = ArangoDeploymentAnnotationPrefix + "/replace"
-	ArangoDeploymentPlanCleanAnnotation      = "plan." + ArangoDeploymentAnnotationPrefix + "/clean"
+	ArangoDeploymentAnnotationPrefix           = "deployment.arangodb.com"
+	ArangoDeploymentPodMaintenanceAnnotation   = ArangoDeploymentAnnotationPrefix + "/maintenance"
+	ArangoDeploymentPodRotateAnnotation        = ArangoDeploymentAnnotationPrefix + "/rotate"
+	ArangoDeploymentPodReplaceAnnotation       = ArangoDeploymentAnnotationPrefix + "/replace"
+	ArangoDeploymentPVCEvacuateAnnotation      = ArangoDeploymentAnnotationPrefix + "/evacuate"
+	ArangoDeploymentPlanCleanAnnotation        = "plan." + ArangoDeploymentAnnotationPrefix + "/clean"
+	ArangoDeploymentUpgradeApproveAnnotation   = ArangoDeploymentAnnotationPrefix + "/upgrade-approve"
+	ArangoDeploymentUpgradeCandidateAnnotation = ArangoDeploymentAnnotationPrefix + "/upgrade-candidate"
+	ArangoDeploymentNotificationsAnnotation    = ArangoDeploymentAnnotationPrefix + "/notifications"
 )
@@ -31,6 +31,10 @@ const (
 	ArangoMemberResourceKind   = "ArangoMember"
 	ArangoMemberResourcePlural = "arangomembers"
 
+	ArangoClientCertificateCRDName        = ArangoClientCertificateResourcePlural + "." + ArangoDeploymentGroupName
+	ArangoClientCertificateResourceKind   = "ArangoClientCertificate"
+	ArangoClientCertificateResourcePlural = "arangoclientcertificates"
+
 	ArangoDeploymentGroupName = "database.arangodb.com"
 )
 
@@ -0,0 +1,76 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+const (
+	defaultClientCertificateTTL = Duration("2160h") // About 3 month
+)
+
+// ArangoClientCertificateSpec defines the client certificate which should be issued
+type ArangoClientCertificateSpec struct {
+	// DeploymentName is the name of the ArangoDeployment which client CA signs the certificate
+	DeploymentName string `json:"deploymentName"`
+	// CommonName of the certificate, defaults to the name of the resource
+	CommonName *string `json:"commonName,omitempty"`
+	// SecretName is the name of the secret where the certificate is stored, defaults to the name of the resource
+	SecretName *string `json:"secretName,omitempty"`
+	// TTL of the certificate
+	TTL *Duration `json:"ttl,omitempty"`
+}
+
+// GetCommonName returns the value of commonName or the given default.
+func (s ArangoClientCertificateSpec) GetCommonName(name string) string {
+	return util.StringOrDefault(s.CommonName, name)
+}
+
+// GetSecretName returns the value of secretName or the given default.
+func (s ArangoClientCertificateSpec) GetSecretName(name string) string {
+	return util.StringOrDefault(s.SecretName, name)
+}
+
+// GetTTL returns the value of ttl.
+func (s ArangoClientCertificateSpec) GetTTL() Duration {
+	return DurationOrDefault(s.TTL, defaultClientCertificateTTL)
+}
+
+// Validate the given spec
+func (s ArangoClientCertificateSpec) Validate() error {
+	if err := k8sutil.ValidateResourceName(s.DeploymentName); err != nil {
+		return errors.WithStack(errors.Wrapf(err, "deploymentName"))
+	}
+	if s.SecretName != nil {
+		if err := k8sutil.ValidateResourceName(*s.SecretName); err != nil {
+			return errors.WithStack(errors.Wrapf(err, "secretName"))
+		}
+	}
+	if s.TTL != nil {
+		if err := s.TTL.Validate(); err != nil {
+			return errors.WithStack(errors.Wrapf(err, "ttl"))
+		}
+	}
+	return nil
+}
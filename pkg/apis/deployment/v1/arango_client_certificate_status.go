@@ -0,0 +1,33 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// ArangoClientCertificateStatus holds the state of the issued client certificate
+type ArangoClientCertificateStatus struct {
+	// SecretName is the name of the secret which holds the issued certificate
+	SecretName string `json:"secretName,omitempty"`
+	// NotAfter keeps the expiry time of the issued certificate
+	NotAfter *meta.Time `json:"notAfter,omitempty"`
+	// Conditions specific to the client certificate
+	Conditions ConditionList `json:"conditions,omitempty"`
+}
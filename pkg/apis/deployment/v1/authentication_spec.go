@@ -23,6 +23,8 @@
 package v1
 
 import (
+	"time"
+
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
@@ -31,11 +33,20 @@ import (
 // AuthenticationSpec holds authentication specific configuration settings
 type AuthenticationSpec struct {
 	JWTSecretName *string `json:"jwtSecretName,omitempty"`
+	// RotationInterval enables scheduled rotation of the JWT secret when set
+	RotationInterval *Duration `json:"rotationInterval,omitempty"`
+	// RotationRetention is the number of retired JWT keys which are still accepted by servers after rotation
+	RotationRetention *int `json:"rotationRetention,omitempty"`
 }
 
 const (
 	// JWTSecretNameDisabled is the value of JWTSecretName to use for disabling authentication.
 	JWTSecretNameDisabled = "None"
+
+	// defaultJWTRotationRetention keeps the previous key valid until the next rotation
+	defaultJWTRotationRetention = 1
+	// minJWTRotationInterval prevents rotations faster than keys can be propagated
+	minJWTRotationInterval = time.Hour
 )
 
 // GetJWTSecretName returns the value of jwtSecretName.
@@ -49,6 +60,21 @@ func (s AuthenticationSpec) IsAuthenticated() bool {
 	return s.GetJWTSecretName() != JWTSecretNameDisabled
 }
 
+// IsRotationEnabled returns true if scheduled rotation of the JWT secret is enabled.
+func (s AuthenticationSpec) IsRotationEnabled() bool {
+	return s.IsAuthenticated() && s.RotationInterval != nil && s.RotationInterval.AsDuration() > 0
+}
+
+// GetRotationInterval returns the value of rotationInterval.
+func (s AuthenticationSpec) GetRotationInterval() Duration {
+	return DurationOrDefault(s.RotationInterval)
+}
+
+// GetRotationRetention returns the value of rotationRetention.
+func (s AuthenticationSpec) GetRotationRetention() int {
+	return util.IntOrDefault(s.RotationRetention, defaultJWTRotationRetention)
+}
+
 // Validate the given spec
 func (s AuthenticationSpec) Validate(required bool) error {
 	if required && !s.IsAuthenticated() {
@@ -59,6 +85,17 @@ func (s AuthenticationSpec) Validate(required bool) error {
 			return errors.WithStack(err)
 		}
 	}
+	if s.RotationInterval != nil {
+		if err := s.RotationInterval.Validate(); err != nil {
+			return errors.WithStack(errors.Wrapf(err, "rotationInterval"))
+		}
+		if d := s.RotationInterval.AsDuration(); d > 0 && d < minJWTRotationInterval {
+			return errors.WithStack(errors.Wrapf(ValidationError, "rotationInterval must be at least %s", minJWTRotationInterval))
+		}
+	}
+	if s.RotationRetention != nil && *s.RotationRetention < 0 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "rotationRetention must not be negative"))
+	}
 	return nil
 }
 
@@ -76,6 +113,12 @@ func (s *AuthenticationSpec) SetDefaultsFrom(source AuthenticationSpec) {
 	if s.JWTSecretName == nil {
 		s.JWTSecretName = util.NewStringOrNil(source.JWTSecretName)
 	}
+	if s.RotationInterval == nil {
+		s.RotationInterval = NewDurationOrNil(source.RotationInterval)
+	}
+	if s.RotationRetention == nil {
+		s.RotationRetention = util.NewIntOrNil(source.RotationRetention)
+	}
 }
 
 // ResetImmutableFields replaces all immutable fields in the given target with values from the source spec.
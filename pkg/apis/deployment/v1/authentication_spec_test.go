@@ -39,6 +39,26 @@ func TestAuthenticationSpecValidate(t *testing.T) {
 	assert.Error(t, AuthenticationSpec{JWTSecretName: util.NewString("Foo")}.Validate(false))
 }
 
+func TestAuthenticationSpecRotation(t *testing.T) {
+	interval := func(d Duration) *Duration { return &d }
+
+	assert.False(t, AuthenticationSpec{JWTSecretName: util.NewString("foo")}.IsRotationEnabled())
+	assert.False(t, AuthenticationSpec{JWTSecretName: util.NewString("None"), RotationInterval: interval("24h")}.IsRotationEnabled())
+	assert.True(t, AuthenticationSpec{JWTSecretName: util.NewString("foo"), RotationInterval: interval("24h")}.IsRotationEnabled())
+
+	assert.Equal(t, 1, AuthenticationSpec{}.GetRotationRetention())
+	assert.Equal(t, 3, AuthenticationSpec{RotationRetention: util.NewInt(3)}.GetRotationRetention())
+
+	// Valid
+	assert.Nil(t, AuthenticationSpec{JWTSecretName: util.NewString("foo"), RotationInterval: interval("720h"), RotationRetention: util.NewInt(0)}.Validate(false))
+	assert.Nil(t, AuthenticationSpec{JWTSecretName: util.NewString("foo"), RotationInterval: interval("0s")}.Validate(false))
+
+	// Not valid
+	assert.Error(t, AuthenticationSpec{JWTSecretName: util.NewString("foo"), RotationInterval: interval("1m")}.Validate(false))
+	assert.Error(t, AuthenticationSpec{JWTSecretName: util.NewString("foo"), RotationInterval: interval("invalid")}.Validate(false))
+	assert.Error(t, AuthenticationSpec{JWTSecretName: util.NewString("foo"), RotationRetention: util.NewInt(-1)}.Validate(false))
+}
+
 func TestAuthenticationSpecIsAuthenticated(t *testing.T) {
 	assert.False(t, AuthenticationSpec{JWTSecretName: util.NewString("None")}.IsAuthenticated())
 	assert.True(t, AuthenticationSpec{JWTSecretName: util.NewString("foo")}.IsAuthenticated())
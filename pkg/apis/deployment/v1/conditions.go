@@ -65,6 +65,10 @@ const (
 	ConditionTypeMarkedToRemove ConditionType = "MarkedToRemove"
 	// ConditionTypeUpgradeFailed indicates that mem
 	ConditionTypeUpgradeFailed ConditionType = "UpgradeFailed"
+	// ConditionTypeUpgradeRolledBack indicates that the failed upgrade of the deployment was rolled back.
+	ConditionTypeUpgradeRolledBack ConditionType = "UpgradeRolledBack"
+	// ConditionTypeUpgradeReportFailed indicates that the upgrade report for the candidate image could not be created.
+	ConditionTypeUpgradeReportFailed ConditionType = "UpgradeReportFailed"
 )
 
 // Condition represents one current condition of a deployment or deployment member.
@@ -0,0 +1,158 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// DeploymentCloneSpec defines the source of the data for a deployment which is created as a clone of another deployment.
+type DeploymentCloneSpec struct {
+	// Backup is the name of the ArangoBackup (in the same namespace) which is restored into the deployment
+	Backup *string `json:"backup,omitempty"`
+	// RepositoryURL is the URL of the repository from which the backup is downloaded
+	RepositoryURL *string `json:"repositoryURL,omitempty"`
+	// CredentialsSecretName is the name of the secret with the repository credentials
+	CredentialsSecretName *string `json:"credentialsSecretName,omitempty"`
+	// ID of the backup in the repository
+	ID *string `json:"id,omitempty"`
+	// ScrubUsers removes all users except root and resets the root password once the backup is restored
+	ScrubUsers *bool `json:"scrubUsers,omitempty"`
+}
+
+// GetBackup returns the name of the source ArangoBackup
+func (d *DeploymentCloneSpec) GetBackup() string {
+	if d == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(d.Backup)
+}
+
+// GetRepositoryURL returns the URL of the source repository
+func (d *DeploymentCloneSpec) GetRepositoryURL() string {
+	if d == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(d.RepositoryURL)
+}
+
+// GetCredentialsSecretName returns the name of the secret with the repository credentials
+func (d *DeploymentCloneSpec) GetCredentialsSecretName() string {
+	if d == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(d.CredentialsSecretName)
+}
+
+// GetID returns the ID of the backup in the repository
+func (d *DeploymentCloneSpec) GetID() string {
+	if d == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(d.ID)
+}
+
+// IsScrubUsers returns true when users should be removed after the restore
+func (d *DeploymentCloneSpec) IsScrubUsers() bool {
+	if d == nil {
+		return false
+	}
+
+	return util.BoolOrDefault(d.ScrubUsers, false)
+}
+
+// Validate the clone spec.
+func (d *DeploymentCloneSpec) Validate() error {
+	if d == nil {
+		return nil
+	}
+
+	if d.GetBackup() != "" {
+		if d.RepositoryURL != nil || d.ID != nil || d.CredentialsSecretName != nil {
+			return errors.WithStack(errors.Wrapf(ValidationError, "backup cannot be combined with repositoryURL, id or credentialsSecretName"))
+		}
+		return nil
+	}
+
+	if d.GetRepositoryURL() == "" {
+		return errors.WithStack(errors.Wrapf(ValidationError, "backup or repositoryURL must be set"))
+	}
+
+	if d.GetID() == "" {
+		return errors.WithStack(errors.Wrapf(ValidationError, "id must be set when repositoryURL is used"))
+	}
+
+	return nil
+}
+
+// DeploymentClonePhase defines the progress of the clone
+type DeploymentClonePhase string
+
+const (
+	// DeploymentClonePhaseDownloading means that the backup is being downloaded into the deployment
+	DeploymentClonePhaseDownloading DeploymentClonePhase = "Downloading"
+	// DeploymentClonePhaseRestoring means that the backup is being restored
+	DeploymentClonePhaseRestoring DeploymentClonePhase = "Restoring"
+	// DeploymentClonePhaseScrubbing means that users are being removed from the restored data
+	DeploymentClonePhaseScrubbing DeploymentClonePhase = "Scrubbing"
+	// DeploymentClonePhaseCompleted means that the clone is done
+	DeploymentClonePhaseCompleted DeploymentClonePhase = "Completed"
+	// DeploymentClonePhaseFailed means that the clone failed and will not be retried
+	DeploymentClonePhaseFailed DeploymentClonePhase = "Failed"
+)
+
+// IsFinal returns true when no further clone actions are done
+func (d DeploymentClonePhase) IsFinal() bool {
+	return d == DeploymentClonePhaseCompleted || d == DeploymentClonePhaseFailed
+}
+
+// DeploymentCloneStatus keeps the progress of the clone
+type DeploymentCloneStatus struct {
+	Phase DeploymentClonePhase `json:"phase,omitempty"`
+	// Backup is the name of the ArangoBackup which is restored
+	Backup  string `json:"backup,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Equal compares two DeploymentCloneStatus
+func (d *DeploymentCloneStatus) Equal(other *DeploymentCloneStatus) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+
+	return d.Phase == other.Phase &&
+		d.Backup == other.Backup &&
+		d.Message == other.Message
+}
+
+// GetPhase returns the clone phase or empty string if clone was not started
+func (d *DeploymentCloneStatus) GetPhase() DeploymentClonePhase {
+	if d == nil {
+		return ""
+	}
+
+	return d.Phase
+}
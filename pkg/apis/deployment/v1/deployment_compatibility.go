@@ -0,0 +1,110 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"reflect"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	// DeploymentCompatibilityLevelBase is the pod rendering of operators released before compatibility levels were introduced
+	DeploymentCompatibilityLevelBase = 1
+	// DeploymentCompatibilityLevelLatest is the most recent pod rendering known by the operator.
+	// Only pod spec changes which rotate existing members by default are introduced with a new level,
+	// changes behind opt-in spec fields are applied at every level.
+	DeploymentCompatibilityLevelLatest = DeploymentCompatibilityLevelBase
+)
+
+// ValidateCompatibilityLevel returns an error if the given compatibility level is not known by the operator
+func ValidateCompatibilityLevel(level int) error {
+	if level < DeploymentCompatibilityLevelBase || level > DeploymentCompatibilityLevelLatest {
+		return errors.WithStack(errors.Wrapf(ValidationError, "compatibility level must be in range [%d, %d], got %d",
+			DeploymentCompatibilityLevelBase, DeploymentCompatibilityLevelLatest, level))
+	}
+	return nil
+}
+
+// GetCompatibilityLevel returns the compatibility level used to render pods of the deployment.
+// Level pinned in the spec has priority. Otherwise the level recorded in the status is kept,
+// so operator upgrades do not change pods of running deployments. Deployments created by operators
+// without compatibility levels use the base level, new deployments use the latest one.
+func GetCompatibilityLevel(spec DeploymentSpec, status DeploymentStatus) int {
+	if spec.CompatibilityLevel != nil {
+		return *spec.CompatibilityLevel
+	}
+
+	if c := status.Compatibility; c != nil && c.Level > 0 {
+		return c.Level
+	}
+
+	created := false
+	status.Members.ForeachServerGroup(func(group ServerGroup, list MemberStatusList) error {
+		for _, m := range list {
+			if m.PodSpecVersion != "" {
+				created = true
+			}
+		}
+		return nil
+	})
+
+	if created {
+		return DeploymentCompatibilityLevelBase
+	}
+
+	return DeploymentCompatibilityLevelLatest
+}
+
+// DeploymentCompatibilityStatus keeps track of the pod rendering used by the deployment
+type DeploymentCompatibilityStatus struct {
+	// OperatorVersion is the version of the operator which rendered the last pod
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+	// Level is the compatibility level used to render pods
+	Level int `json:"level,omitempty"`
+	// LatestLevel is the most recent compatibility level known by the running operator
+	LatestLevel int `json:"latestLevel,omitempty"`
+	// Rotation contains IDs of members which are rotated by the running operator at the current level
+	Rotation []string `json:"rotation,omitempty"`
+	// LatestRotation contains IDs of members which would be rotated by the running operator after switching
+	// to its latest level. Pod rendering of other operator versions is not known, so this does not tell
+	// which members are rotated by a different operator version.
+	LatestRotation []string `json:"latestRotation,omitempty"`
+}
+
+// Equal compares two DeploymentCompatibilityStatus
+func (d *DeploymentCompatibilityStatus) Equal(other *DeploymentCompatibilityStatus) bool {
+	if d == nil && other == nil {
+		return true
+	}
+	if d == nil || other == nil {
+		return false
+	}
+	return reflect.DeepEqual(d, other)
+}
+
+// IsUpgradeAvailable returns true when the deployment does not use the latest compatibility level
+func (d *DeploymentCompatibilityStatus) IsUpgradeAvailable() bool {
+	if d == nil {
+		return false
+	}
+	return d.Level < d.LatestLevel
+}
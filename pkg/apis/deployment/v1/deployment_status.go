@@ -49,11 +49,21 @@ type DeploymentStatus struct {
 
 	Restore *DeploymentRestoreResult `json:"restore,omitempty"`
 
+	// Clone keeps the progress of the clone from backup
+	Clone *DeploymentCloneStatus `json:"clone,omitempty"`
+
 	// Images holds a list of ArangoDB images with their ID and ArangoDB version.
 	Images ImageInfoList `json:"arangodb-images,omitempty"`
 	// Image that is currently being used when new pods are created
 	CurrentImage *ImageInfo `json:"current-image,omitempty"`
 
+	// Upgrade keeps track of the last upgrade of the deployment image
+	Upgrade *DeploymentUpgradeStatus `json:"upgrade,omitempty"`
+	// UpgradeReport describes what happens when the deployment is upgraded to the candidate image
+	UpgradeReport *DeploymentUpgradeReport `json:"upgradeReport,omitempty"`
+	// Compatibility keeps track of the pod rendering used by the deployment
+	Compatibility *DeploymentCompatibilityStatus `json:"compatibility,omitempty"`
+
 	// Members holds the status for all members in all server groups
 	Members DeploymentStatusMembers `json:"members"`
 
@@ -75,6 +85,9 @@ type DeploymentStatus struct {
 
 	// ForceStatusReload if set to true forces a reload of the status from the custom resource.
 	ForceStatusReload *bool `json:"force-status-reload,omitempty"`
+
+	// Certificates keeps expiry details of TLS certificates managed by the operator
+	Certificates CertificateStatusList `json:"certificates,omitempty"`
 }
 
 // Equal checks for equality
@@ -87,12 +100,17 @@ func (ds *DeploymentStatus) Equal(other DeploymentStatus) bool {
 		ds.ExporterServiceMonitorName == other.ExporterServiceMonitorName &&
 		ds.Images.Equal(other.Images) &&
 		ds.Restore.Equal(other.Restore) &&
+		ds.Clone.Equal(other.Clone) &&
 		ds.CurrentImage.Equal(other.CurrentImage) &&
+		ds.Upgrade.Equal(other.Upgrade) &&
+		ds.UpgradeReport.Equal(other.UpgradeReport) &&
+		ds.Compatibility.Equal(other.Compatibility) &&
 		ds.Members.Equal(other.Members) &&
 		ds.Conditions.Equal(other.Conditions) &&
 		ds.Plan.Equal(other.Plan) &&
 		ds.AcceptedSpec.Equal(other.AcceptedSpec) &&
-		ds.SecretHashes.Equal(other.SecretHashes)
+		ds.SecretHashes.Equal(other.SecretHashes) &&
+		ds.Certificates.Equal(other.Certificates)
 }
 
 // IsForceReload returns true if ForceStatusReload is set to true
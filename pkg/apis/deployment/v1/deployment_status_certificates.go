@@ -0,0 +1,123 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"time"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CertificateType defines the kind of certificate managed by the operator
+type CertificateType string
+
+const (
+	// CertificateTypeCA defines the CA certificate of the deployment
+	CertificateTypeCA CertificateType = "CA"
+	// CertificateTypeKeyfile defines the server certificate of a member
+	CertificateTypeKeyfile CertificateType = "Keyfile"
+	// CertificateTypeSNI defines the additional SNI certificate
+	CertificateTypeSNI CertificateType = "SNI"
+	// CertificateTypeClientKeyfile defines the client certificate used by the operator
+	CertificateTypeClientKeyfile CertificateType = "ClientKeyfile"
+)
+
+// CertificateStatus holds the expiry details of a certificate managed by the operator
+type CertificateStatus struct {
+	// Type of the certificate
+	Type CertificateType `json:"type"`
+	// Secret keeps name of the secret which holds the certificate
+	Secret string `json:"secret"`
+	// Group of the member which uses the certificate (only for keyfile)
+	Group ServerGroup `json:"group,omitempty"`
+	// MemberID of the member which uses the certificate (only for keyfile)
+	MemberID string `json:"memberID,omitempty"`
+	// NotAfter keeps the expiry time of the certificate
+	NotAfter meta.Time `json:"notAfter"`
+	// Warning keeps the lowest threshold for which the expiry warning was emitted
+	Warning *Duration `json:"warning,omitempty"`
+}
+
+// Equal checks for equality
+func (c CertificateStatus) Equal(other CertificateStatus) bool {
+	return c.Type == other.Type &&
+		c.Secret == other.Secret &&
+		c.Group == other.Group &&
+		c.MemberID == other.MemberID &&
+		c.NotAfter.Equal(&other.NotAfter) &&
+		DurationOrDefault(c.Warning) == DurationOrDefault(other.Warning)
+}
+
+// ExpiresIn returns the remaining lifetime of the certificate
+func (c CertificateStatus) ExpiresIn(now time.Time) time.Duration {
+	return c.NotAfter.Time.Sub(now)
+}
+
+// CertificateStatusList is a list of certificates managed by the operator
+type CertificateStatusList []CertificateStatus
+
+// Equal checks for equality
+func (l CertificateStatusList) Equal(other CertificateStatusList) bool {
+	if len(l) != len(other) {
+		return false
+	}
+
+	for id := range l {
+		if !l[id].Equal(other[id]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetBySecret returns the status of the certificate kept in the given secret
+func (l CertificateStatusList) GetBySecret(secret string) (CertificateStatus, bool) {
+	for _, c := range l {
+		if c.Secret == secret {
+			return c, true
+		}
+	}
+
+	return CertificateStatus{}, false
+}
+
+// GetByMemberID returns the status of the keyfile certificate of the given member
+func (l CertificateStatusList) GetByMemberID(id string) (CertificateStatus, bool) {
+	for _, c := range l {
+		if c.Type == CertificateTypeKeyfile && c.MemberID == id {
+			return c, true
+		}
+	}
+
+	return CertificateStatus{}, false
+}
+
+// GetByType returns the status of the first certificate of the given type
+func (l CertificateStatusList) GetByType(t CertificateType) (CertificateStatus, bool) {
+	for _, c := range l {
+		if c.Type == t {
+			return c, true
+		}
+	}
+
+	return CertificateStatus{}, false
+}
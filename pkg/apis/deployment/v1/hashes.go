@@ -22,7 +22,10 @@
 
 package v1
 
-import shared "github.com/arangodb/kube-arangodb/pkg/apis/shared/v1"
+import (
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 type DeploymentStatusHashes struct {
 	Encryption DeploymentStatusHashesEncryption `json:"rocksDBEncryption,omitempty"`
@@ -34,6 +37,11 @@ type DeploymentStatusHashesEncryption struct {
 	Keys shared.HashList `json:"keys,omitempty"`
 
 	Propagated bool `json:"propagated,omitempty"`
+
+	// KeyID is the ID of the current encryption key
+	KeyID string `json:"keyID,omitempty"`
+	// RotatedAt keeps the time of the last scheduled encryption key rotation
+	RotatedAt *meta.Time `json:"rotatedAt,omitempty"`
 }
 
 type DeploymentStatusHashesTLS struct {
@@ -48,4 +56,9 @@ type DeploymentStatusHashesJWT struct {
 	Passive shared.HashList `json:"passive,omitempty"`
 
 	Propagated bool `json:"propagated,omitempty"`
+
+	// RotatedAt keeps the time of the last scheduled JWT rotation
+	RotatedAt *meta.Time `json:"rotatedAt,omitempty"`
+	// Retired keeps previously active keys which are retained after scheduled rotation, newest first
+	Retired shared.HashList `json:"retired,omitempty"`
 }
@@ -0,0 +1,178 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"net/url"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+type RocksDBEncryptionProvider string
+
+const (
+	// RocksDBEncryptionProviderSecret keeps the plain encryption key in the key secret
+	RocksDBEncryptionProviderSecret RocksDBEncryptionProvider = "Secret"
+	// RocksDBEncryptionProviderKMS keeps the encryption key in the key secret wrapped by the key of an external KMS
+	RocksDBEncryptionProviderKMS RocksDBEncryptionProvider = "KMS"
+)
+
+type RocksDBEncryptionKMSType string
+
+const (
+	// RocksDBEncryptionKMSTypeVault uses the transit secrets engine of a Vault compatible server
+	RocksDBEncryptionKMSTypeVault RocksDBEncryptionKMSType = "Vault"
+	// RocksDBEncryptionKMSTypeLocal uses a master key file available in the operator pod
+	RocksDBEncryptionKMSTypeLocal RocksDBEncryptionKMSType = "Local"
+)
+
+const (
+	defaultRocksDBEncryptionKMSMountPath = "transit"
+)
+
+// RocksDBEncryptionKMSSpec holds the configuration of the KMS used to wrap encryption keys
+type RocksDBEncryptionKMSSpec struct {
+	// Type of the KMS, Vault (default) or Local
+	Type *RocksDBEncryptionKMSType `json:"type,omitempty"`
+	// Endpoint is the address of the Vault server
+	Endpoint *string `json:"endpoint,omitempty"`
+	// MountPath of the transit secrets engine, transit by default
+	MountPath *string `json:"mountPath,omitempty"`
+	// KeyName is the name of the key in the KMS used to wrap encryption keys
+	KeyName *string `json:"keyName,omitempty"`
+	// TokenSecretName is the name of the secret with the Vault token
+	TokenSecretName *string `json:"tokenSecretName,omitempty"`
+	// KeyFile is the path to the master key file of the Local KMS
+	KeyFile *string `json:"keyFile,omitempty"`
+}
+
+// GetType returns the type of the KMS.
+func (s *RocksDBEncryptionKMSSpec) GetType() RocksDBEncryptionKMSType {
+	if s == nil || s.Type == nil {
+		return RocksDBEncryptionKMSTypeVault
+	}
+
+	return *s.Type
+}
+
+// GetEndpoint returns the value of endpoint.
+func (s *RocksDBEncryptionKMSSpec) GetEndpoint() string {
+	if s == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(s.Endpoint)
+}
+
+// GetMountPath returns the value of mountPath.
+func (s *RocksDBEncryptionKMSSpec) GetMountPath() string {
+	if s == nil {
+		return defaultRocksDBEncryptionKMSMountPath
+	}
+
+	return util.StringOrDefault(s.MountPath, defaultRocksDBEncryptionKMSMountPath)
+}
+
+// GetKeyName returns the value of keyName.
+func (s *RocksDBEncryptionKMSSpec) GetKeyName() string {
+	if s == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(s.KeyName)
+}
+
+// GetTokenSecretName returns the value of tokenSecretName.
+func (s *RocksDBEncryptionKMSSpec) GetTokenSecretName() string {
+	if s == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(s.TokenSecretName)
+}
+
+// GetKeyFile returns the value of keyFile.
+func (s *RocksDBEncryptionKMSSpec) GetKeyFile() string {
+	if s == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(s.KeyFile)
+}
+
+// Validate the given spec
+func (s *RocksDBEncryptionKMSSpec) Validate() error {
+	if s == nil {
+		return errors.WithStack(errors.Wrapf(ValidationError, "kms configuration is required"))
+	}
+
+	switch s.GetType() {
+	case RocksDBEncryptionKMSTypeVault:
+		if s.GetEndpoint() == "" {
+			return errors.WithStack(errors.Wrapf(ValidationError, "endpoint is required"))
+		}
+		if _, err := url.Parse(s.GetEndpoint()); err != nil {
+			return errors.WithStack(errors.Wrapf(ValidationError, "endpoint is invalid: %s", err.Error()))
+		}
+		if s.GetKeyName() == "" {
+			return errors.WithStack(errors.Wrapf(ValidationError, "keyName is required"))
+		}
+		if err := k8sutil.ValidateResourceName(s.GetTokenSecretName()); err != nil {
+			return errors.WithStack(errors.Wrapf(err, "tokenSecretName"))
+		}
+	case RocksDBEncryptionKMSTypeLocal:
+		if s.GetKeyFile() == "" {
+			return errors.WithStack(errors.Wrapf(ValidationError, "keyFile is required"))
+		}
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "unknown kms type %s", s.GetType()))
+	}
+
+	return nil
+}
+
+// SetDefaultsFrom fills unspecified fields with a value from given source spec.
+func (s *RocksDBEncryptionKMSSpec) SetDefaultsFrom(source *RocksDBEncryptionKMSSpec) {
+	if source == nil {
+		return
+	}
+	if s.Type == nil && source.Type != nil {
+		t := *source.Type
+		s.Type = &t
+	}
+	if s.Endpoint == nil {
+		s.Endpoint = util.NewStringOrNil(source.Endpoint)
+	}
+	if s.MountPath == nil {
+		s.MountPath = util.NewStringOrNil(source.MountPath)
+	}
+	if s.KeyName == nil {
+		s.KeyName = util.NewStringOrNil(source.KeyName)
+	}
+	if s.TokenSecretName == nil {
+		s.TokenSecretName = util.NewStringOrNil(source.TokenSecretName)
+	}
+	if s.KeyFile == nil {
+		s.KeyFile = util.NewStringOrNil(source.KeyFile)
+	}
+}
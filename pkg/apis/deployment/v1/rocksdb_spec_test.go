@@ -38,6 +38,38 @@ func TestRocksDBSpecValidate(t *testing.T) {
 	assert.Error(t, RocksDBSpec{Encryption: RocksDBEncryptionSpec{KeySecretName: util.NewString("Foo")}}.Validate())
 }
 
+func TestRocksDBEncryptionSpecProviderValidate(t *testing.T) {
+	kms := RocksDBEncryptionProviderKMS
+	local := RocksDBEncryptionKMSTypeLocal
+	unknown := RocksDBEncryptionProvider("unknown")
+	interval := func(d Duration) *Duration { return &d }
+
+	assert.Equal(t, RocksDBEncryptionProviderSecret, RocksDBEncryptionSpec{}.GetProvider())
+
+	// Valid
+	assert.Nil(t, RocksDBEncryptionSpec{KeySecretName: util.NewString("foo"), RotationInterval: interval("720h")}.Validate())
+	assert.Nil(t, RocksDBEncryptionSpec{KeySecretName: util.NewString("foo"), Provider: &kms, KMS: &RocksDBEncryptionKMSSpec{
+		Endpoint:        util.NewString("https://vault:8200"),
+		KeyName:         util.NewString("arangodb"),
+		TokenSecretName: util.NewString("vault-token"),
+	}}.Validate())
+	assert.Nil(t, RocksDBEncryptionSpec{KeySecretName: util.NewString("foo"), Provider: &kms, KMS: &RocksDBEncryptionKMSSpec{
+		Type:    &local,
+		KeyFile: util.NewString("/secrets/kms/key"),
+	}}.Validate())
+
+	// Not valid
+	assert.Error(t, RocksDBEncryptionSpec{KeySecretName: util.NewString("foo"), Provider: &unknown}.Validate())
+	assert.Error(t, RocksDBEncryptionSpec{KeySecretName: util.NewString("foo"), Provider: &kms}.Validate())
+	assert.Error(t, RocksDBEncryptionSpec{KeySecretName: util.NewString("foo"), Provider: &kms, KMS: &RocksDBEncryptionKMSSpec{
+		Endpoint: util.NewString("https://vault:8200"),
+	}}.Validate())
+	assert.Error(t, RocksDBEncryptionSpec{KeySecretName: util.NewString("foo"), Provider: &kms, KMS: &RocksDBEncryptionKMSSpec{
+		Type: &local,
+	}}.Validate())
+	assert.Error(t, RocksDBEncryptionSpec{KeySecretName: util.NewString("foo"), RotationInterval: interval("5m")}.Validate())
+}
+
 func TestRocksDBSpecIsEncrypted(t *testing.T) {
 	assert.False(t, RocksDBSpec{}.IsEncrypted())
 	assert.False(t, RocksDBSpec{Encryption: RocksDBEncryptionSpec{KeySecretName: util.NewString("")}}.IsEncrypted())
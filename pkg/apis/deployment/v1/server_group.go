@@ -143,6 +143,16 @@ func (g ServerGroup) DefaultTerminationGracePeriod() time.Duration {
 	}
 }
 
+// IsIn returns true when the group is one of the given groups.
+func (g ServerGroup) IsIn(groups ...ServerGroup) bool {
+	for _, group := range groups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
+
 // IsStateless returns true when the groups runs servers without a persistent volume.
 func (g ServerGroup) IsStateless() bool {
 	switch g {
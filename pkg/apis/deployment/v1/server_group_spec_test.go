@@ -131,3 +131,23 @@ func TestServerGroupSpecValidateArgs(t *testing.T) {
 	assert.Error(t, ServerGroupSpec{Count: util.NewInt(1), Args: []string{"--master.endpoint=http://something"}}.Validate(ServerGroupSyncMasters, true, DeploymentModeCluster, EnvironmentDevelopment))
 	assert.Error(t, ServerGroupSpec{Count: util.NewInt(1), Args: []string{"--mq.type=strange"}}.Validate(ServerGroupSyncMasters, true, DeploymentModeCluster, EnvironmentDevelopment))
 }
+
+func TestServerGroupSpecValidateMaxUnavailable(t *testing.T) {
+	// Valid
+	assert.Nil(t, ServerGroupSpec{Count: util.NewInt(3), MaxUnavailable: util.NewInt(1)}.Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Nil(t, ServerGroupSpec{Count: util.NewInt(5), MaxUnavailable: util.NewInt(3)}.Validate(ServerGroupCoordinators, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Nil(t, ServerGroupSpec{Count: util.NewInt(5), MaxUnavailable: util.NewInt(3)}.Validate(ServerGroupSyncWorkers, true, DeploymentModeCluster, EnvironmentDevelopment))
+
+	// Invalid
+	assert.Error(t, ServerGroupSpec{Count: util.NewInt(5), MaxUnavailable: util.NewInt(0)}.Validate(ServerGroupCoordinators, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Error(t, ServerGroupSpec{Count: util.NewInt(3), MaxUnavailable: util.NewInt(2)}.Validate(ServerGroupAgents, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Error(t, ServerGroupSpec{Count: util.NewInt(5), MaxUnavailable: util.NewInt(2)}.Validate(ServerGroupDBServers, true, DeploymentModeCluster, EnvironmentDevelopment))
+	assert.Error(t, ServerGroupSpec{Count: util.NewInt(5), MaxUnavailable: util.NewInt(2)}.Validate(ServerGroupSyncMasters, true, DeploymentModeCluster, EnvironmentDevelopment))
+}
+
+func TestServerGroupSpecGetMaxUnavailable(t *testing.T) {
+	assert.Equal(t, 1, ServerGroupSpec{Count: util.NewInt(5)}.GetMaxUnavailable())
+	assert.Equal(t, 3, ServerGroupSpec{Count: util.NewInt(5), MaxUnavailable: util.NewInt(3)}.GetMaxUnavailable())
+	assert.Equal(t, 4, ServerGroupSpec{Count: util.NewInt(5), MaxUnavailable: util.NewInt(10)}.GetMaxUnavailable())
+	assert.Equal(t, 1, ServerGroupSpec{Count: util.NewInt(1), MaxUnavailable: util.NewInt(3)}.GetMaxUnavailable())
+}
@@ -0,0 +1,66 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// SyncRateLimitSpec holds limits applied to the sync workers of a deployment.
+type SyncRateLimitSpec struct {
+	// MaxBandwidth holds the maximum amount of bytes per second transferred by a single sync worker.
+	// The limit is applied to the pod network interface by the CNI bandwidth plugin
+	// (kubernetes.io/ingress-bandwidth & kubernetes.io/egress-bandwidth annotations),
+	// so it has no effect on clusters without that plugin.
+	MaxBandwidth *resource.Quantity `json:"maxBandwidth,omitempty"`
+}
+
+// GetMaxBandwidth returns the value of maxBandwidth in bytes per second (0 if not set).
+func (s *SyncRateLimitSpec) GetMaxBandwidth() int64 {
+	if s == nil || s.MaxBandwidth == nil {
+		return 0
+	}
+	return s.MaxBandwidth.Value()
+}
+
+// Validate the given spec
+func (s *SyncRateLimitSpec) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.MaxBandwidth != nil && s.MaxBandwidth.Sign() < 0 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "maxBandwidth must not be negative"))
+	}
+	return nil
+}
+
+// SetDefaultsFrom fills unspecified fields with a value from given source spec.
+func (s *SyncRateLimitSpec) SetDefaultsFrom(source *SyncRateLimitSpec) {
+	if source == nil {
+		return
+	}
+
+	if s.MaxBandwidth == nil && source.MaxBandwidth != nil {
+		q := source.MaxBandwidth.DeepCopy()
+		s.MaxBandwidth = &q
+	}
+}
@@ -37,6 +37,7 @@ type SyncSpec struct {
 	TLS            TLSSpec                `json:"tls"`
 	Monitoring     MonitoringSpec         `json:"monitoring"`
 	Image          *string                `json:"image"`
+	RateLimit      *SyncRateLimitSpec     `json:"rateLimit,omitempty"`
 }
 
 // IsEnabled returns the value of enabled.
@@ -69,6 +70,12 @@ func (s SyncSpec) Validate(mode DeploymentMode) error {
 		if err := s.TLS.Validate(); err != nil {
 			return errors.WithStack(err)
 		}
+		if s.TLS.IsExternallyIssued() {
+			return errors.WithStack(errors.Wrapf(ValidationError, "tls.issuer is not supported for sync"))
+		}
+		if err := s.RateLimit.Validate(); err != nil {
+			return errors.WithStack(err)
+		}
 	}
 	if err := s.Monitoring.Validate(); err != nil {
 		return errors.WithStack(err)
@@ -96,6 +103,11 @@ func (s *SyncSpec) SetDefaultsFrom(source SyncSpec) {
 	s.Authentication.SetDefaultsFrom(source.Authentication)
 	s.TLS.SetDefaultsFrom(source.TLS)
 	s.Monitoring.SetDefaultsFrom(source.Monitoring)
+	if s.RateLimit == nil {
+		s.RateLimit = source.RateLimit.DeepCopy()
+	} else {
+		s.RateLimit.SetDefaultsFrom(source.RateLimit)
+	}
 }
 
 // ResetImmutableFields replaces all immutable fields in the given target with values from the source spec.
@@ -27,6 +27,7 @@ import (
 
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 func TestSyncSpecValidate(t *testing.T) {
@@ -43,6 +44,34 @@ func TestSyncSpecValidate(t *testing.T) {
 	assert.Error(t, SyncSpec{Authentication: auth, TLS: tls, Enabled: util.NewBool(true)}.Validate(DeploymentModeActiveFailover))
 }
 
+func TestSyncRateLimitSpecValidate(t *testing.T) {
+	bandwidth := resource.MustParse("10Mi")
+	negative := resource.MustParse("-1")
+
+	var nilSpec *SyncRateLimitSpec
+	assert.Nil(t, nilSpec.Validate())
+	assert.Equal(t, int64(0), nilSpec.GetMaxBandwidth())
+
+	assert.Nil(t, (&SyncRateLimitSpec{}).Validate())
+	assert.Nil(t, (&SyncRateLimitSpec{MaxBandwidth: &bandwidth}).Validate())
+	assert.Equal(t, int64(10*1024*1024), (&SyncRateLimitSpec{MaxBandwidth: &bandwidth}).GetMaxBandwidth())
+
+	assert.Error(t, (&SyncRateLimitSpec{MaxBandwidth: &negative}).Validate())
+}
+
+func TestSyncRateLimitSpecSetDefaultsFrom(t *testing.T) {
+	bandwidth := resource.MustParse("10Mi")
+
+	var s SyncSpec
+	s.SetDefaultsFrom(SyncSpec{})
+	assert.Nil(t, s.RateLimit)
+
+	s.SetDefaultsFrom(SyncSpec{RateLimit: &SyncRateLimitSpec{MaxBandwidth: &bandwidth}})
+	if assert.NotNil(t, s.RateLimit) {
+		assert.Equal(t, int64(10*1024*1024), s.RateLimit.GetMaxBandwidth())
+	}
+}
+
 func TestSyncSpecSetDefaults(t *testing.T) {
 	def := func(spec SyncSpec) SyncSpec {
 		spec.SetDefaults("test-jwt", "test-client-auth-ca", "test-tls-ca", "test-mon")
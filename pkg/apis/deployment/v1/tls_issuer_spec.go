@@ -0,0 +1,118 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+type TLSIssuerType string
+
+const (
+	// TLSIssuerTypeCertManager signs server certificates with cert-manager CertificateRequest resources
+	TLSIssuerTypeCertManager TLSIssuerType = "CertManager"
+	// TLSIssuerTypeKubernetes signs server certificates with Kubernetes CertificateSigningRequest resources
+	TLSIssuerTypeKubernetes TLSIssuerType = "Kubernetes"
+)
+
+const (
+	TLSIssuerKindIssuer        = "Issuer"
+	TLSIssuerKindClusterIssuer = "ClusterIssuer"
+
+	defaultTLSIssuerGroup = "cert-manager.io"
+)
+
+// TLSIssuerSpec holds the reference to an external issuer which signs server certificates.
+// When it is set, the CA secret contains only the CA certificate of the issuer.
+type TLSIssuerSpec struct {
+	// Type of the issuer, CertManager or Kubernetes
+	Type TLSIssuerType `json:"type,omitempty"`
+	// Name of the cert-manager Issuer or ClusterIssuer
+	Name *string `json:"name,omitempty"`
+	// Kind of the cert-manager issuer, Issuer (default) or ClusterIssuer
+	Kind *string `json:"kind,omitempty"`
+	// Group of the cert-manager issuer, cert-manager.io by default
+	Group *string `json:"group,omitempty"`
+	// Approve enables approval of CertificateSigningRequests by the operator
+	Approve *bool `json:"approve,omitempty"`
+}
+
+// GetName returns the name of the issuer.
+func (s *TLSIssuerSpec) GetName() string {
+	if s == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(s.Name)
+}
+
+// GetKind returns the kind of the issuer.
+func (s *TLSIssuerSpec) GetKind() string {
+	if s == nil {
+		return TLSIssuerKindIssuer
+	}
+
+	return util.StringOrDefault(s.Kind, TLSIssuerKindIssuer)
+}
+
+// GetGroup returns the API group of the issuer.
+func (s *TLSIssuerSpec) GetGroup() string {
+	if s == nil {
+		return defaultTLSIssuerGroup
+	}
+
+	return util.StringOrDefault(s.Group, defaultTLSIssuerGroup)
+}
+
+// GetApprove returns true when the operator should approve its own signing requests.
+func (s *TLSIssuerSpec) GetApprove() bool {
+	if s == nil {
+		return false
+	}
+
+	return util.BoolOrDefault(s.Approve)
+}
+
+// Validate the given spec
+func (s *TLSIssuerSpec) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	switch s.Type {
+	case TLSIssuerTypeCertManager:
+		if s.GetName() == "" {
+			return errors.Newf("issuer name is required for %s issuer", s.Type)
+		}
+
+		switch s.GetKind() {
+		case TLSIssuerKindIssuer, TLSIssuerKindClusterIssuer:
+		default:
+			return errors.Newf("issuer kind %s is not supported", s.GetKind())
+		}
+	case TLSIssuerTypeKubernetes:
+	default:
+		return errors.Newf("issuer type %s is not supported", s.Type)
+	}
+
+	return nil
+}
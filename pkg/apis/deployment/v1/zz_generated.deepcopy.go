@@ -61,6 +61,125 @@ func (in *Action) DeepCopy() *Action {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoClientCertificate) DeepCopyInto(out *ArangoClientCertificate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoClientCertificate.
+func (in *ArangoClientCertificate) DeepCopy() *ArangoClientCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoClientCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoClientCertificate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoClientCertificateList) DeepCopyInto(out *ArangoClientCertificateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArangoClientCertificate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoClientCertificateList.
+func (in *ArangoClientCertificateList) DeepCopy() *ArangoClientCertificateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoClientCertificateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArangoClientCertificateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoClientCertificateSpec) DeepCopyInto(out *ArangoClientCertificateSpec) {
+	*out = *in
+	if in.CommonName != nil {
+		in, out := &in.CommonName, &out.CommonName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoClientCertificateSpec.
+func (in *ArangoClientCertificateSpec) DeepCopy() *ArangoClientCertificateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoClientCertificateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArangoClientCertificateStatus) DeepCopyInto(out *ArangoClientCertificateStatus) {
+	*out = *in
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(ConditionList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArangoClientCertificateStatus.
+func (in *ArangoClientCertificateStatus) DeepCopy() *ArangoClientCertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArangoClientCertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArangoDeployment) DeepCopyInto(out *ArangoDeployment) {
 	*out = *in
@@ -249,6 +368,16 @@ func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RotationInterval != nil {
+		in, out := &in.RotationInterval, &out.RotationInterval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.RotationRetention != nil {
+		in, out := &in.RotationRetention, &out.RotationRetention
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
@@ -285,6 +414,50 @@ func (in *BootstrapSpec) DeepCopy() *BootstrapSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
+	*out = *in
+	in.NotAfter.DeepCopyInto(&out.NotAfter)
+	if in.Warning != nil {
+		in, out := &in.Warning, &out.Warning
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
+func (in *CertificateStatus) DeepCopy() *CertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in CertificateStatusList) DeepCopyInto(out *CertificateStatusList) {
+	{
+		in := &in
+		*out = make(CertificateStatusList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatusList.
+func (in CertificateStatusList) DeepCopy() CertificateStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateStatusList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChaosSpec) DeepCopyInto(out *ChaosSpec) {
 	*out = *in
@@ -377,6 +550,89 @@ func (in *DatabaseSpec) DeepCopy() *DatabaseSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentCloneSpec) DeepCopyInto(out *DeploymentCloneSpec) {
+	*out = *in
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(string)
+		**out = **in
+	}
+	if in.RepositoryURL != nil {
+		in, out := &in.RepositoryURL, &out.RepositoryURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.CredentialsSecretName != nil {
+		in, out := &in.CredentialsSecretName, &out.CredentialsSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScrubUsers != nil {
+		in, out := &in.ScrubUsers, &out.ScrubUsers
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentCloneSpec.
+func (in *DeploymentCloneSpec) DeepCopy() *DeploymentCloneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentCloneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentCloneStatus) DeepCopyInto(out *DeploymentCloneStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentCloneStatus.
+func (in *DeploymentCloneStatus) DeepCopy() *DeploymentCloneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentCloneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentCompatibilityStatus) DeepCopyInto(out *DeploymentCompatibilityStatus) {
+	*out = *in
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LatestRotation != nil {
+		in, out := &in.LatestRotation, &out.LatestRotation
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentCompatibilityStatus.
+func (in *DeploymentCompatibilityStatus) DeepCopy() *DeploymentCompatibilityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentCompatibilityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeploymentFeatures) DeepCopyInto(out *DeploymentFeatures) {
 	*out = *in
@@ -465,7 +721,7 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 	if in.Upgrade != nil {
 		in, out := &in.Upgrade, &out.Upgrade
 		*out = new(DeploymentUpgradeSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Features != nil {
 		in, out := &in.Features, &out.Features
@@ -521,11 +777,21 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Clone != nil {
+		in, out := &in.Clone, &out.Clone
+		*out = new(DeploymentCloneSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AllowUnsafeUpgrade != nil {
 		in, out := &in.AllowUnsafeUpgrade, &out.AllowUnsafeUpgrade
 		*out = new(bool)
 		**out = **in
 	}
+	if in.CompatibilityLevel != nil {
+		in, out := &in.CompatibilityLevel, &out.CompatibilityLevel
+		*out = new(int)
+		**out = **in
+	}
 	in.ExternalAccess.DeepCopyInto(&out.ExternalAccess)
 	in.RocksDB.DeepCopyInto(&out.RocksDB)
 	in.Authentication.DeepCopyInto(&out.Authentication)
@@ -588,6 +854,11 @@ func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
 		*out = new(DeploymentRestoreResult)
 		**out = **in
 	}
+	if in.Clone != nil {
+		in, out := &in.Clone, &out.Clone
+		*out = new(DeploymentCloneStatus)
+		**out = **in
+	}
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
 		*out = make(ImageInfoList, len(*in))
@@ -598,6 +869,21 @@ func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
 		*out = new(ImageInfo)
 		**out = **in
 	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(DeploymentUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeReport != nil {
+		in, out := &in.UpgradeReport, &out.UpgradeReport
+		*out = new(DeploymentUpgradeReport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Compatibility != nil {
+		in, out := &in.Compatibility, &out.Compatibility
+		*out = new(DeploymentCompatibilityStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Members.DeepCopyInto(&out.Members)
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -629,6 +915,13 @@ func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make(CertificateStatusList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -669,6 +962,10 @@ func (in *DeploymentStatusHashesEncryption) DeepCopyInto(out *DeploymentStatusHa
 		*out = make(sharedv1.HashList, len(*in))
 		copy(*out, *in)
 	}
+	if in.RotatedAt != nil {
+		in, out := &in.RotatedAt, &out.RotatedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -690,6 +987,15 @@ func (in *DeploymentStatusHashesJWT) DeepCopyInto(out *DeploymentStatusHashesJWT
 		*out = make(sharedv1.HashList, len(*in))
 		copy(*out, *in)
 	}
+	if in.RotatedAt != nil {
+		in, out := &in.RotatedAt, &out.RotatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Retired != nil {
+		in, out := &in.Retired, &out.Retired
+		*out = make(sharedv1.HashList, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -787,9 +1093,97 @@ func (in *DeploymentStatusMembers) DeepCopy() *DeploymentStatusMembers {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentUpgradeCanarySpec) DeepCopyInto(out *DeploymentUpgradeCanarySpec) {
+	*out = *in
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentUpgradeCanarySpec.
+func (in *DeploymentUpgradeCanarySpec) DeepCopy() *DeploymentUpgradeCanarySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentUpgradeCanarySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentUpgradeReport) DeepCopyInto(out *DeploymentUpgradeReport) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]DeploymentUpgradeReportMember, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentUpgradeReport.
+func (in *DeploymentUpgradeReport) DeepCopy() *DeploymentUpgradeReport {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentUpgradeReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentUpgradeReportMember) DeepCopyInto(out *DeploymentUpgradeReportMember) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentUpgradeReportMember.
+func (in *DeploymentUpgradeReportMember) DeepCopy() *DeploymentUpgradeReportMember {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentUpgradeReportMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeploymentUpgradeSpec) DeepCopyInto(out *DeploymentUpgradeSpec) {
 	*out = *in
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(DeploymentUpgradeStrategy)
+		**out = **in
+	}
+	if in.MaxFailures != nil {
+		in, out := &in.MaxFailures, &out.MaxFailures
+		*out = new(int)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = make([]ServerGroup, len(*in))
+		copy(*out, *in)
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(DeploymentUpgradeCanarySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthGate != nil {
+		in, out := &in.HealthGate, &out.HealthGate
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -803,6 +1197,31 @@ func (in *DeploymentUpgradeSpec) DeepCopy() *DeploymentUpgradeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentUpgradeStatus) DeepCopyInto(out *DeploymentUpgradeStatus) {
+	*out = *in
+	if in.FromImage != nil {
+		in, out := &in.FromImage, &out.FromImage
+		*out = new(ImageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentUpgradeStatus.
+func (in *DeploymentUpgradeStatus) DeepCopy() *DeploymentUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalAccessSpec) DeepCopyInto(out *ExternalAccessSpec) {
 	*out = *in
@@ -1122,6 +1541,52 @@ func (in Plan) DeepCopy() Plan {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RocksDBEncryptionKMSSpec) DeepCopyInto(out *RocksDBEncryptionKMSSpec) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(RocksDBEncryptionKMSType)
+		**out = **in
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.MountPath != nil {
+		in, out := &in.MountPath, &out.MountPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyName != nil {
+		in, out := &in.KeyName, &out.KeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TokenSecretName != nil {
+		in, out := &in.TokenSecretName, &out.TokenSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyFile != nil {
+		in, out := &in.KeyFile, &out.KeyFile
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RocksDBEncryptionKMSSpec.
+func (in *RocksDBEncryptionKMSSpec) DeepCopy() *RocksDBEncryptionKMSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RocksDBEncryptionKMSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RocksDBEncryptionSpec) DeepCopyInto(out *RocksDBEncryptionSpec) {
 	*out = *in
@@ -1130,6 +1595,21 @@ func (in *RocksDBEncryptionSpec) DeepCopyInto(out *RocksDBEncryptionSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(RocksDBEncryptionProvider)
+		**out = **in
+	}
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(RocksDBEncryptionKMSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RotationInterval != nil {
+		in, out := &in.RotationInterval, &out.RotationInterval
+		*out = new(Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1502,6 +1982,11 @@ func (in *ServerGroupSpec) DeepCopyInto(out *ServerGroupSpec) {
 		*out = new(ServerGroupInitContainers)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
@@ -1883,6 +2368,27 @@ func (in *SyncExternalAccessSpec) DeepCopy() *SyncExternalAccessSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncRateLimitSpec) DeepCopyInto(out *SyncRateLimitSpec) {
+	*out = *in
+	if in.MaxBandwidth != nil {
+		in, out := &in.MaxBandwidth, &out.MaxBandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncRateLimitSpec.
+func (in *SyncRateLimitSpec) DeepCopy() *SyncRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncSpec) DeepCopyInto(out *SyncSpec) {
 	*out = *in
@@ -1900,6 +2406,11 @@ func (in *SyncSpec) DeepCopyInto(out *SyncSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(SyncRateLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1913,6 +2424,68 @@ func (in *SyncSpec) DeepCopy() *SyncSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSClientAuthSpec) DeepCopyInto(out *TLSClientAuthSpec) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(TLSClientAuthMode)
+		**out = **in
+	}
+	if in.CASecretName != nil {
+		in, out := &in.CASecretName, &out.CASecretName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSClientAuthSpec.
+func (in *TLSClientAuthSpec) DeepCopy() *TLSClientAuthSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSClientAuthSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSIssuerSpec) DeepCopyInto(out *TLSIssuerSpec) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(string)
+		**out = **in
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(string)
+		**out = **in
+	}
+	if in.Approve != nil {
+		in, out := &in.Approve, &out.Approve
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSIssuerSpec.
+func (in *TLSIssuerSpec) DeepCopy() *TLSIssuerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSIssuerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSSNISpec) DeepCopyInto(out *TLSSNISpec) {
 	*out = *in
@@ -1972,6 +2545,26 @@ func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
 		*out = new(TLSRotateMode)
 		**out = **in
 	}
+	if in.Issuer != nil {
+		in, out := &in.Issuer, &out.Issuer
+		*out = new(TLSIssuerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.ExpiryWarnings != nil {
+		in, out := &in.ExpiryWarnings, &out.ExpiryWarnings
+		*out = make([]Duration, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClientAuth != nil {
+		in, out := &in.ClientAuth, &out.ClientAuth
+		*out = new(TLSClientAuthSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
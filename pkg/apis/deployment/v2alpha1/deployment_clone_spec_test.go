@@ -0,0 +1,59 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentCloneSpecValidation(t *testing.T) {
+	var nilSpec *DeploymentCloneSpec
+	assert.NoError(t, nilSpec.Validate())
+
+	assert.NoError(t, (&DeploymentCloneSpec{Backup: util.NewString("backup")}).Validate())
+	assert.NoError(t, (&DeploymentCloneSpec{RepositoryURL: util.NewString("s3://bucket"), ID: util.NewString("id")}).Validate())
+
+	assert.Error(t, (&DeploymentCloneSpec{}).Validate())
+	assert.Error(t, (&DeploymentCloneSpec{RepositoryURL: util.NewString("s3://bucket")}).Validate())
+	assert.Error(t, (&DeploymentCloneSpec{Backup: util.NewString("backup"), ID: util.NewString("id")}).Validate())
+}
+
+func TestDeploymentCloneSpecImmutable(t *testing.T) {
+	source := DeploymentSpec{Clone: &DeploymentCloneSpec{Backup: util.NewString("backup")}}
+	target := DeploymentSpec{Clone: &DeploymentCloneSpec{Backup: util.NewString("other")}}
+
+	fields := source.ResetImmutableFields(&target)
+	require.Contains(t, fields, "clone")
+	require.Equal(t, "backup", target.Clone.GetBackup())
+}
+
+func TestDeploymentClonePhase(t *testing.T) {
+	var status *DeploymentCloneStatus
+	assert.False(t, status.GetPhase().IsFinal())
+
+	assert.False(t, DeploymentClonePhaseRestoring.IsFinal())
+	assert.True(t, DeploymentClonePhaseCompleted.IsFinal())
+	assert.True(t, DeploymentClonePhaseFailed.IsFinal())
+}
@@ -0,0 +1,66 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCompatibilityLevel(t *testing.T) {
+	assert.Error(t, ValidateCompatibilityLevel(0))
+	assert.NoError(t, ValidateCompatibilityLevel(DeploymentCompatibilityLevelBase))
+	assert.NoError(t, ValidateCompatibilityLevel(DeploymentCompatibilityLevelLatest))
+	assert.Error(t, ValidateCompatibilityLevel(DeploymentCompatibilityLevelLatest+1))
+
+	spec := DeploymentSpec{CompatibilityLevel: util.NewInt(DeploymentCompatibilityLevelLatest + 1)}
+	spec.SetDefaults("test")
+	assert.Error(t, spec.Validate())
+}
+
+func TestGetCompatibilityLevel(t *testing.T) {
+	var status DeploymentStatus
+
+	// New deployment
+	assert.Equal(t, DeploymentCompatibilityLevelLatest, GetCompatibilityLevel(DeploymentSpec{}, status))
+
+	// Deployment created before compatibility levels
+	status.Members.Agents = MemberStatusList{{ID: "a", PodSpecVersion: "checksum"}}
+	assert.Equal(t, DeploymentCompatibilityLevelBase, GetCompatibilityLevel(DeploymentSpec{}, status))
+
+	// Recorded level is kept
+	status.Compatibility = &DeploymentCompatibilityStatus{Level: 2}
+	assert.Equal(t, 2, GetCompatibilityLevel(DeploymentSpec{}, status))
+
+	// Pinned level has priority
+	assert.Equal(t, 3, GetCompatibilityLevel(DeploymentSpec{CompatibilityLevel: util.NewInt(3)}, status))
+}
+
+func TestDeploymentCompatibilityStatus_IsUpgradeAvailable(t *testing.T) {
+	var c *DeploymentCompatibilityStatus
+	assert.False(t, c.IsUpgradeAvailable())
+	assert.False(t, (&DeploymentCompatibilityStatus{Level: 1, LatestLevel: 1}).IsUpgradeAvailable())
+	assert.True(t, (&DeploymentCompatibilityStatus{Level: 1, LatestLevel: 2}).IsUpgradeAvailable())
+	assert.True(t, c.Equal(nil))
+	assert.False(t, c.Equal(&DeploymentCompatibilityStatus{}))
+}
@@ -85,9 +85,16 @@ type DeploymentSpec struct {
 
 	RestoreEncryptionSecret *string `json:"restoreEncryptionSecret,omitempty"`
 
+	// Clone defines the backup from which the deployment is cloned
+	Clone *DeploymentCloneSpec `json:"clone,omitempty"`
+
 	// AllowUnsafeUpgrade determines if upgrade on missing member or with not in sync shards is allowed
 	AllowUnsafeUpgrade *bool `json:"allowUnsafeUpgrade,omitempty"`
 
+	// CompatibilityLevel pins the pod rendering of the operator. Pod spec changes introduced
+	// in higher levels are applied only when the level is raised.
+	CompatibilityLevel *int `json:"compatibilityLevel,omitempty"`
+
 	ExternalAccess ExternalAccessSpec `json:"externalAccess"`
 	RocksDB        RocksDBSpec        `json:"rocksdb"`
 	Authentication AuthenticationSpec `json:"auth"`
@@ -267,6 +274,7 @@ func (s *DeploymentSpec) SetDefaults(deploymentName string) {
 	s.RocksDB.SetDefaults()
 	s.Authentication.SetDefaults(deploymentName + "-jwt")
 	s.TLS.SetDefaults(deploymentName + "-ca")
+	s.TLS.ClientAuth.SetDefaults(deploymentName + "-client-ca")
 	s.Sync.SetDefaults(deploymentName+"-sync-jwt", deploymentName+"-sync-client-auth-ca", deploymentName+"-sync-ca", deploymentName+"-sync-mt")
 	s.Single.SetDefaults(ServerGroupSingle, s.GetMode().HasSingleServers(), s.GetMode())
 	s.Agents.SetDefaults(ServerGroupAgents, s.GetMode().HasAgents(), s.GetMode())
@@ -306,9 +314,15 @@ func (s *DeploymentSpec) SetDefaultsFrom(source DeploymentSpec) {
 	if s.AllowUnsafeUpgrade == nil {
 		s.AllowUnsafeUpgrade = util.NewBoolOrNil(source.AllowUnsafeUpgrade)
 	}
+	if s.CompatibilityLevel == nil {
+		s.CompatibilityLevel = util.NewIntOrNil(source.CompatibilityLevel)
+	}
 	if s.Database == nil {
 		s.Database = source.Database.DeepCopy()
 	}
+	if s.Clone == nil {
+		s.Clone = source.Clone.DeepCopy()
+	}
 
 	s.License.SetDefaultsFrom(source.License)
 	s.ExternalAccess.SetDefaultsFrom(source.ExternalAccess)
@@ -391,6 +405,26 @@ func (s *DeploymentSpec) Validate() error {
 	if err := s.Bootstrap.Validate(); err != nil {
 		return errors.WithStack(err)
 	}
+	if err := s.Clone.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.clone"))
+	}
+	if err := s.Upgrade.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "spec.upgrade"))
+	}
+	if s.CompatibilityLevel != nil {
+		if err := ValidateCompatibilityLevel(*s.CompatibilityLevel); err != nil {
+			return errors.WithStack(errors.Wrap(err, "spec.compatibilityLevel"))
+		}
+	}
+	if s.IsSecure() && s.TLS.ClientAuth.IsRequired() {
+		// Exporter and syncmasters connect to the coordinators without client certificate
+		if s.Metrics.IsEnabled() {
+			return errors.WithStack(errors.Wrapf(ValidationError, "spec.tls.clientAuth.mode %s is not supported when spec.metrics is enabled", TLSClientAuthModeRequired))
+		}
+		if s.Sync.IsEnabled() {
+			return errors.WithStack(errors.Wrapf(ValidationError, "spec.tls.clientAuth.mode %s is not supported when spec.sync is enabled", TLSClientAuthModeRequired))
+		}
+	}
 	return nil
 }
 
@@ -421,6 +455,10 @@ func (s DeploymentSpec) ResetImmutableFields(target *DeploymentSpec) []string {
 		target.DisableIPv6 = util.NewBoolOrNil(s.DisableIPv6)
 		resetFields = append(resetFields, "disableIPv6")
 	}
+	if !reflect.DeepEqual(s.Clone, target.Clone) {
+		target.Clone = s.Clone.DeepCopy()
+		resetFields = append(resetFields, "clone")
+	}
 	if l := s.ExternalAccess.ResetImmutableFields("externalAccess", &target.ExternalAccess); l != nil {
 		resetFields = append(resetFields, l...)
 	}
@@ -0,0 +1,90 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"reflect"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+// DeploymentUpgradeReportDecision defines how the operator would upgrade the deployment to the candidate image
+type DeploymentUpgradeReportDecision string
+
+const (
+	// DeploymentUpgradeReportDecisionNone means that the candidate image is already used
+	DeploymentUpgradeReportDecisionNone DeploymentUpgradeReportDecision = "None"
+	// DeploymentUpgradeReportDecisionPatch means that only the patch version changes
+	DeploymentUpgradeReportDecisionPatch DeploymentUpgradeReportDecision = "Patch"
+	// DeploymentUpgradeReportDecisionMinor means that members are restarted with --database.auto-upgrade
+	DeploymentUpgradeReportDecisionMinor DeploymentUpgradeReportDecision = "Minor"
+	// DeploymentUpgradeReportDecisionNotAllowed means that the upgrade rules do not allow the change
+	DeploymentUpgradeReportDecisionNotAllowed DeploymentUpgradeReportDecision = "NotAllowed"
+)
+
+// DeploymentUpgradeReportMember describes what happens to the member during the upgrade
+type DeploymentUpgradeReportMember struct {
+	// ID of the member
+	ID string `json:"id"`
+	// Group of the member
+	Group ServerGroup `json:"group"`
+	// Upgrade is true when the member is restarted in upgrade mode, otherwise the member is rotated
+	Upgrade bool `json:"upgrade,omitempty"`
+}
+
+// DeploymentUpgradeReport describes what happens when the deployment image is changed to the candidate image
+type DeploymentUpgradeReport struct {
+	// Image is the candidate image
+	Image string `json:"image"`
+	// Discovered is false while the candidate image is inspected
+	Discovered bool `json:"discovered,omitempty"`
+	// FromVersion is the ArangoDB version which is currently used
+	FromVersion driver.Version `json:"fromVersion,omitempty"`
+	// ToVersion is the ArangoDB version of the candidate image
+	ToVersion driver.Version `json:"toVersion,omitempty"`
+	// FromEnterprise is true when the enterprise edition is currently used
+	FromEnterprise bool `json:"fromEnterprise,omitempty"`
+	// ToEnterprise is true when the candidate image runs the enterprise edition
+	ToEnterprise bool `json:"toEnterprise,omitempty"`
+	// LicenseChanged is true when the candidate image changes the edition
+	LicenseChanged bool `json:"licenseChanged,omitempty"`
+	// Allowed is true when the operator allows the upgrade
+	Allowed bool `json:"allowed,omitempty"`
+	// Decision is the upgrade rule which applies
+	Decision DeploymentUpgradeReportDecision `json:"decision,omitempty"`
+	// Message explains why the upgrade is not allowed
+	Message string `json:"message,omitempty"`
+	// Members which are restarted during the upgrade
+	Members []DeploymentUpgradeReportMember `json:"members,omitempty"`
+	// UpgradeVersionCheck is true when the version check init container runs before the upgrade
+	UpgradeVersionCheck bool `json:"upgradeVersionCheck,omitempty"`
+}
+
+// Equal checks for equality
+func (d *DeploymentUpgradeReport) Equal(other *DeploymentUpgradeReport) bool {
+	if d == nil && other == nil {
+		return true
+	} else if d == nil || other == nil {
+		return false
+	}
+
+	return reflect.DeepEqual(*d, *other)
+}
@@ -18,13 +18,82 @@
 // Copyright holder is ArangoDB GmbH, Cologne, Germany
 //
 // Author Adam Janikowski
-//
 
 package v2alpha1
 
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// DeploymentUpgradeStrategy defines what is done when upgrade of the member fails
+type DeploymentUpgradeStrategy string
+
+const (
+	// DeploymentUpgradeStrategyNone keeps the deployment in the failed state until manual intervention
+	DeploymentUpgradeStrategyNone DeploymentUpgradeStrategy = "None"
+	// DeploymentUpgradeStrategyRollback reverts the upgrade once the first upgraded member keeps failing
+	DeploymentUpgradeStrategyRollback DeploymentUpgradeStrategy = "Rollback"
+
+	// DefaultDeploymentUpgradeMaxFailures is the number of failed upgrade attempts after which upgrade is rolled back
+	DefaultDeploymentUpgradeMaxFailures = 1
+)
+
+// Validate the upgrade strategy.
+func (d DeploymentUpgradeStrategy) Validate() error {
+	switch d {
+	case DeploymentUpgradeStrategyNone, DeploymentUpgradeStrategyRollback:
+		return nil
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "Unknown upgrade strategy: '%s'", string(d)))
+	}
+}
+
 type DeploymentUpgradeSpec struct {
 	// Flag specify if upgrade should be auto-injected, even if is not required (in case of stuck)
 	AutoUpgrade bool `json:"autoUpgrade"`
+	// Strategy defines what is done when upgrade of the member fails, defaults to None
+	Strategy *DeploymentUpgradeStrategy `json:"strategy,omitempty"`
+	// MaxFailures defines after how many failed upgrades of the first member the upgrade is rolled back
+	MaxFailures *int `json:"maxFailures,omitempty"`
+	// Timeout defines how long the upgrade of the first member can take before the upgrade is rolled back
+	Timeout *Duration `json:"timeout,omitempty"`
+	// Order defines the order in which server groups are upgraded.
+	// Groups which are not listed are upgraded afterwards in the default order.
+	Order []ServerGroup `json:"order,omitempty"`
+	// Canary enables the canary step, only one member of each group is upgraded before the upgrade pauses
+	Canary *DeploymentUpgradeCanarySpec `json:"canary,omitempty"`
+	// HealthGate ensures that the cluster is healthy before the next upgrade stage is started
+	HealthGate *bool `json:"healthGate,omitempty"`
+}
+
+// DeploymentUpgradeCanarySpec defines the pause after the canary member of the group was upgraded
+type DeploymentUpgradeCanarySpec struct {
+	// Pause defines how long the upgrade waits after the canary member is ready.
+	// When not set, the upgrade waits for the approval annotation.
+	Pause *Duration `json:"pause,omitempty"`
+}
+
+// GetPause returns the pause after the canary member was upgraded, 0 when approval is required
+func (d *DeploymentUpgradeCanarySpec) GetPause() Duration {
+	if d == nil {
+		return ""
+	}
+
+	return DurationOrDefault(d.Pause)
+}
+
+// Validate the canary spec.
+func (d *DeploymentUpgradeCanarySpec) Validate() error {
+	if d == nil {
+		return nil
+	}
+
+	if err := d.GetPause().Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "pause"))
+	}
+
+	return nil
 }
 
 func (d *DeploymentUpgradeSpec) Get() DeploymentUpgradeSpec {
@@ -34,3 +103,90 @@ func (d *DeploymentUpgradeSpec) Get() DeploymentUpgradeSpec {
 
 	return *d
 }
+
+// GetStrategy returns the upgrade strategy
+func (d DeploymentUpgradeSpec) GetStrategy() DeploymentUpgradeStrategy {
+	if d.Strategy == nil {
+		return DeploymentUpgradeStrategyNone
+	}
+
+	return *d.Strategy
+}
+
+// IsRollbackEnabled returns true if failed upgrades are rolled back
+func (d DeploymentUpgradeSpec) IsRollbackEnabled() bool {
+	return d.GetStrategy() == DeploymentUpgradeStrategyRollback
+}
+
+// GetMaxFailures returns the number of failed upgrades after which upgrade is rolled back
+func (d DeploymentUpgradeSpec) GetMaxFailures() int {
+	return util.IntOrDefault(d.MaxFailures, DefaultDeploymentUpgradeMaxFailures)
+}
+
+// GetTimeout returns the timeout of the first member upgrade, 0 when disabled
+func (d DeploymentUpgradeSpec) GetTimeout() Duration {
+	return DurationOrDefault(d.Timeout)
+}
+
+// GetOrder returns all server groups in the order in which they are upgraded
+func (d DeploymentUpgradeSpec) GetOrder(defaultOrder ...ServerGroup) []ServerGroup {
+	if len(defaultOrder) == 0 {
+		defaultOrder = AllServerGroups
+	}
+
+	order := make([]ServerGroup, 0, len(defaultOrder))
+	order = append(order, d.Order...)
+
+	for _, group := range defaultOrder {
+		if !group.IsIn(order...) {
+			order = append(order, group)
+		}
+	}
+
+	return order
+}
+
+// IsCanaryEnabled returns true if one member of each group is upgraded before the upgrade pauses
+func (d DeploymentUpgradeSpec) IsCanaryEnabled() bool {
+	return d.Canary != nil
+}
+
+// IsHealthGateEnabled returns true if cluster health is checked before the next upgrade stage
+func (d DeploymentUpgradeSpec) IsHealthGateEnabled() bool {
+	return util.BoolOrDefault(d.HealthGate, false)
+}
+
+// Validate the upgrade spec.
+func (d *DeploymentUpgradeSpec) Validate() error {
+	if d == nil {
+		return nil
+	}
+
+	if err := d.GetStrategy().Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "strategy"))
+	}
+
+	if d.GetMaxFailures() < 1 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "maxFailures must be at least 1"))
+	}
+
+	if err := d.GetTimeout().Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "timeout"))
+	}
+
+	for id, group := range d.Order {
+		if !group.IsIn(AllServerGroups...) {
+			return errors.WithStack(errors.Wrapf(ValidationError, "order[%d]: unknown server group", id))
+		}
+
+		if group.IsIn(d.Order[:id]...) {
+			return errors.WithStack(errors.Wrapf(ValidationError, "order[%d]: server group %s is listed twice", id, group.AsRole()))
+		}
+	}
+
+	if err := d.Canary.Validate(); err != nil {
+		return errors.WithStack(errors.Wrap(err, "canary"))
+	}
+
+	return nil
+}
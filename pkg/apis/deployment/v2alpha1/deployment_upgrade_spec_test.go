@@ -0,0 +1,100 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeploymentUpgradeSpecValidation(t *testing.T) {
+	var nilSpec *DeploymentUpgradeSpec
+	assert.NoError(t, nilSpec.Validate())
+	assert.False(t, nilSpec.Get().IsRollbackEnabled())
+
+	rollback := DeploymentUpgradeStrategyRollback
+	spec := &DeploymentUpgradeSpec{Strategy: &rollback, MaxFailures: util.NewInt(2), Timeout: NewDuration("30m")}
+	assert.NoError(t, spec.Validate())
+	assert.True(t, spec.Get().IsRollbackEnabled())
+	assert.Equal(t, 2, spec.GetMaxFailures())
+	assert.Equal(t, 30*time.Minute, spec.GetTimeout().AsDuration())
+
+	unknown := DeploymentUpgradeStrategy("Unknown")
+	assert.Error(t, (&DeploymentUpgradeSpec{Strategy: &unknown}).Validate())
+	assert.Error(t, (&DeploymentUpgradeSpec{MaxFailures: util.NewInt(0)}).Validate())
+	assert.Error(t, (&DeploymentUpgradeSpec{Timeout: NewDuration("invalid")}).Validate())
+}
+
+func TestDeploymentUpgradeStatus(t *testing.T) {
+	var status *DeploymentUpgradeStatus
+	assert.False(t, status.IsRolledBack("image"))
+	assert.False(t, status.IsTimedOut(time.Minute))
+
+	start := metav1.NewTime(time.Now().Add(-time.Hour))
+	status = &DeploymentUpgradeStatus{ToImage: "image", StartTime: &start}
+	assert.False(t, status.IsRolledBack("image"))
+	assert.True(t, status.IsTimedOut(time.Minute))
+	assert.False(t, status.IsTimedOut(2*time.Hour))
+	assert.False(t, status.IsTimedOut(0))
+
+	status.RolledBack = true
+	assert.True(t, status.IsRolledBack("image"))
+	assert.False(t, status.IsRolledBack("other"))
+}
+
+func TestDeploymentUpgradeSpecOrder(t *testing.T) {
+	var spec DeploymentUpgradeSpec
+	assert.Equal(t, AllServerGroups, spec.GetOrder())
+
+	spec.Order = []ServerGroup{ServerGroupCoordinators, ServerGroupDBServers}
+	assert.NoError(t, spec.Validate())
+	assert.Equal(t, []ServerGroup{
+		ServerGroupCoordinators,
+		ServerGroupDBServers,
+		ServerGroupAgents,
+		ServerGroupSingle,
+		ServerGroupSyncMasters,
+		ServerGroupSyncWorkers,
+	}, spec.GetOrder())
+
+	assert.Error(t, (&DeploymentUpgradeSpec{Order: []ServerGroup{ServerGroupAgents, ServerGroupAgents}}).Validate())
+	assert.Error(t, (&DeploymentUpgradeSpec{Order: []ServerGroup{ServerGroupUnknown}}).Validate())
+}
+
+func TestDeploymentUpgradeSpecCanary(t *testing.T) {
+	var spec DeploymentUpgradeSpec
+	assert.False(t, spec.IsCanaryEnabled())
+	assert.False(t, spec.IsHealthGateEnabled())
+
+	spec.Canary = &DeploymentUpgradeCanarySpec{}
+	spec.HealthGate = util.NewBool(true)
+	assert.True(t, spec.IsCanaryEnabled())
+	assert.True(t, spec.IsHealthGateEnabled())
+	assert.Equal(t, Duration(""), spec.Canary.GetPause())
+	assert.NoError(t, spec.Validate())
+
+	spec.Canary.Pause = NewDuration("invalid")
+	assert.Error(t, spec.Validate())
+}
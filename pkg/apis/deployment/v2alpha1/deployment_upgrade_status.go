@@ -0,0 +1,78 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeploymentUpgradeStatus keeps track of the last upgrade of the deployment image
+type DeploymentUpgradeStatus struct {
+	// FromImage is the image which was used before the upgrade started
+	FromImage *ImageInfo `json:"fromImage,omitempty"`
+	// ToImage is the image to which the deployment is upgraded
+	ToImage string `json:"toImage,omitempty"`
+	// FirstMember is the ID of the first upgraded member
+	FirstMember string `json:"firstMember,omitempty"`
+	// StartTime is the time when the upgrade of the first member started
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// Failures is the number of failed upgrades of the first member
+	Failures int `json:"failures,omitempty"`
+	// RolledBack is set when the upgrade was rolled back to FromImage
+	RolledBack bool `json:"rolledBack,omitempty"`
+}
+
+// Equal checks for equality
+func (d *DeploymentUpgradeStatus) Equal(other *DeploymentUpgradeStatus) bool {
+	if d == nil && other == nil {
+		return true
+	} else if d == nil || other == nil {
+		return false
+	}
+
+	return d.FromImage.Equal(other.FromImage) &&
+		d.ToImage == other.ToImage &&
+		d.FirstMember == other.FirstMember &&
+		util.TimeCompareEqualPointer(d.StartTime, other.StartTime) &&
+		d.Failures == other.Failures &&
+		d.RolledBack == other.RolledBack
+}
+
+// IsRolledBack returns true if upgrade to the given image was rolled back
+func (d *DeploymentUpgradeStatus) IsRolledBack(image string) bool {
+	if d == nil {
+		return false
+	}
+
+	return d.RolledBack && d.ToImage == image
+}
+
+// IsTimedOut returns true when the first member did not finish the upgrade in the given time
+func (d *DeploymentUpgradeStatus) IsTimedOut(timeout time.Duration) bool {
+	if d == nil || timeout <= 0 || d.StartTime == nil {
+		return false
+	}
+
+	return time.Since(d.StartTime.Time) > timeout
+}
@@ -69,6 +69,8 @@ const (
 	ActionTypeRenewTLSCertificate ActionType = "RenewTLSCertificate"
 	// ActionTypeRenewTLSCACertificate causes the TLS CA certificate of the entire deployment to be renewed.
 	ActionTypeRenewTLSCACertificate ActionType = "RenewTLSCACertificate"
+	// ActionTypeRenewTLSClientKeyfile causes the client certificate used by the operator to be renewed.
+	ActionTypeRenewTLSClientKeyfile ActionType = "RenewTLSClientKeyfile"
 	// ActionTypeAppendTLSCACertificate add TLS CA certificate to local truststore.
 	ActionTypeAppendTLSCACertificate ActionType = "AppendTLSCACertificate"
 	// ActionTypeCleanTLSCACertificate clean TLS CA certificate from local truststore.
@@ -101,6 +103,8 @@ const (
 	ActionTypeBackupRestore ActionType = "BackupRestore"
 	// ActionTypeBackupRestoreClean restore plan
 	ActionTypeBackupRestoreClean ActionType = "BackupRestoreClean"
+	// ActionTypeBackupRestoreLogical restore logical backup with arangorestore
+	ActionTypeBackupRestoreLogical ActionType = "BackupRestoreLogical"
 	// ActionTypeEncryptionKeyAdd add new encryption key to list
 	ActionTypeEncryptionKeyAdd ActionType = "EncryptionKeyAdd"
 	// ActionTypeEncryptionKeyRemove removes encryption key to list
@@ -111,6 +115,8 @@ const (
 	ActionTypeEncryptionKeyStatusUpdate ActionType = "EncryptionKeyStatusUpdate"
 	// ActionTypeEncryptionKeyPropagated change propagated flag
 	ActionTypeEncryptionKeyPropagated ActionType = "EncryptionKeyPropagated"
+	// ActionTypeEncryptionKeyGenerate generates new encryption key during scheduled rotation
+	ActionTypeEncryptionKeyGenerate ActionType = "EncryptionKeyGenerate"
 	// ActionTypeJWTStatusUpdate update status of JWT Secret
 	ActionTypeJWTStatusUpdate ActionType = "JWTStatusUpdate"
 	// ActionTypeJWTSetActive change active JWT key
@@ -119,6 +125,8 @@ const (
 	ActionTypeJWTAdd ActionType = "JWTAdd"
 	// ActionTypeJWTClean Clean old JWT key
 	ActionTypeJWTClean ActionType = "JWTClean"
+	// ActionTypeJWTGenerate generates new JWT key during scheduled rotation
+	ActionTypeJWTGenerate ActionType = "JWTGenerate"
 	// ActionTypeJWTRefresh refresh jwt tokens
 	ActionTypeJWTRefresh ActionType = "JWTRefresh"
 	// ActionTypeJWTPropagated change propagated flag
@@ -133,6 +141,8 @@ const (
 	ActionTypeBootstrapUpdate ActionType = "BootstrapUpdate"
 	// ActionTypeBootstrapSetPassword set password to the bootstrapped user
 	ActionTypeBootstrapSetPassword ActionType = "BootstrapSetPassword"
+	// ActionTypeUpgradeRollback marks the upgrade of the deployment as rolled back
+	ActionTypeUpgradeRollback ActionType = "UpgradeRollback"
 )
 
 const (
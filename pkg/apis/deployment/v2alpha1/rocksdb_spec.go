@@ -23,6 +23,8 @@
 package v2alpha1
 
 import (
+	"time"
+
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
@@ -31,6 +33,12 @@ import (
 // RocksDBEncryptionSpec holds rocksdb encryption at rest specific configuration settings
 type RocksDBEncryptionSpec struct {
 	KeySecretName *string `json:"keySecretName,omitempty"`
+	// Provider of the encryption key, Secret (default) or KMS
+	Provider *RocksDBEncryptionProvider `json:"provider,omitempty"`
+	// KMS configuration used by the KMS provider
+	KMS *RocksDBEncryptionKMSSpec `json:"kms,omitempty"`
+	// RotationInterval enables scheduled rotation of the encryption key when set
+	RotationInterval *Duration `json:"rotationInterval,omitempty"`
 }
 
 // GetKeySecretName returns the value of keySecretName.
@@ -44,6 +52,62 @@ func (s RocksDBEncryptionSpec) IsEncrypted() bool {
 	return s.GetKeySecretName() != ""
 }
 
+// GetProvider returns the provider of the encryption key.
+func (s RocksDBEncryptionSpec) GetProvider() RocksDBEncryptionProvider {
+	if s.Provider == nil {
+		return RocksDBEncryptionProviderSecret
+	}
+
+	return *s.Provider
+}
+
+// IsRotationEnabled returns true if scheduled rotation of the encryption key is enabled.
+func (s RocksDBEncryptionSpec) IsRotationEnabled() bool {
+	return s.IsEncrypted() && s.RotationInterval != nil && s.RotationInterval.AsDuration() > 0
+}
+
+// GetRotationInterval returns the value of rotationInterval.
+func (s RocksDBEncryptionSpec) GetRotationInterval() Duration {
+	return DurationOrDefault(s.RotationInterval)
+}
+
+// Validate the given spec
+func (s RocksDBEncryptionSpec) Validate() error {
+	if err := k8sutil.ValidateOptionalResourceName(s.GetKeySecretName()); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if !s.IsEncrypted() {
+		return nil
+	}
+
+	switch s.GetProvider() {
+	case RocksDBEncryptionProviderSecret:
+	case RocksDBEncryptionProviderKMS:
+		if err := s.KMS.Validate(); err != nil {
+			return errors.WithStack(errors.Wrapf(err, "kms"))
+		}
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "unknown provider %s", s.GetProvider()))
+	}
+
+	if s.RotationInterval != nil {
+		if err := s.RotationInterval.Validate(); err != nil {
+			return errors.WithStack(errors.Wrapf(err, "rotationInterval"))
+		}
+		if d := s.RotationInterval.AsDuration(); d > 0 && d < minEncryptionRotationInterval {
+			return errors.WithStack(errors.Wrapf(ValidationError, "rotationInterval must be at least %s", minEncryptionRotationInterval))
+		}
+	}
+
+	return nil
+}
+
+const (
+	// minEncryptionRotationInterval prevents rotations faster than keys can be propagated
+	minEncryptionRotationInterval = time.Hour
+)
+
 // RocksDBSpec holds rocksdb specific configuration settings
 type RocksDBSpec struct {
 	Encryption RocksDBEncryptionSpec `json:"encryption"`
@@ -57,7 +121,7 @@ func (s RocksDBSpec) IsEncrypted() bool {
 
 // Validate the given spec
 func (s RocksDBSpec) Validate() error {
-	if err := k8sutil.ValidateOptionalResourceName(s.Encryption.GetKeySecretName()); err != nil {
+	if err := s.Encryption.Validate(); err != nil {
 		return errors.WithStack(err)
 	}
 	return nil
@@ -73,6 +137,18 @@ func (s *RocksDBSpec) SetDefaultsFrom(source RocksDBSpec) {
 	if s.Encryption.KeySecretName == nil {
 		s.Encryption.KeySecretName = util.NewStringOrNil(source.Encryption.KeySecretName)
 	}
+	if s.Encryption.Provider == nil && source.Encryption.Provider != nil {
+		p := *source.Encryption.Provider
+		s.Encryption.Provider = &p
+	}
+	if s.Encryption.KMS == nil {
+		s.Encryption.KMS = source.Encryption.KMS.DeepCopy()
+	} else {
+		s.Encryption.KMS.SetDefaultsFrom(source.Encryption.KMS)
+	}
+	if s.Encryption.RotationInterval == nil {
+		s.Encryption.RotationInterval = NewDurationOrNil(source.Encryption.RotationInterval)
+	}
 }
 
 // ResetImmutableFields replaces all immutable fields in the given target with values from the source spec.
@@ -85,5 +161,10 @@ func (s RocksDBSpec) ResetImmutableFields(fieldPrefix string, target *RocksDBSpe
 		target.Encryption.KeySecretName = util.NewStringOrNil(s.Encryption.KeySecretName)
 		resetFields = append(resetFields, fieldPrefix+".encryption.keySecretName")
 	}
+	if s.Encryption.GetProvider() != target.Encryption.GetProvider() {
+		// Note: Keys stored by one provider can not be read by another one.
+		target.Encryption.Provider = s.Encryption.Provider
+		resetFields = append(resetFields, fieldPrefix+".encryption.provider")
+	}
 	return resetFields
 }
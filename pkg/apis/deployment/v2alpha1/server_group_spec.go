@@ -106,6 +106,9 @@ type ServerGroupSpec struct {
 	ExtendedRotationCheck *bool `json:"extendedRotationCheck,omitempty"`
 	// InitContainers Init containers specification
 	InitContainers *ServerGroupInitContainers `json:"initContainers,omitempty"`
+	// MaxUnavailable specifies how many members of the group can be rotated at the same time.
+	// Only coordinators and syncworkers can be rotated in batches, defaults to 1.
+	MaxUnavailable *int `json:"maxUnavailable,omitempty"`
 }
 
 // ServerGroupSpecSecurityContext contains specification for pod security context
@@ -320,6 +323,19 @@ func (s ServerGroupSpec) GetCount() int {
 	return util.IntOrDefault(s.Count)
 }
 
+// GetMaxUnavailable returns the number of members which can be rotated at the same time.
+// At least one member of the group is always kept available.
+func (s ServerGroupSpec) GetMaxUnavailable() int {
+	v := util.IntOrDefault(s.MaxUnavailable, 1)
+	if count := s.GetCount(); v >= count {
+		v = count - 1
+	}
+	if v < 1 {
+		return 1
+	}
+	return v
+}
+
 // GetMinCount returns MinCount or 1 if not set
 func (s ServerGroupSpec) GetMinCount() int {
 	return util.IntOrDefault(s.MinCount, 1)
@@ -460,6 +476,15 @@ func (s ServerGroupSpec) Validate(group ServerGroup, used bool, mode DeploymentM
 			}
 		}
 
+		if v := s.MaxUnavailable; v != nil {
+			if *v < 1 {
+				return errors.WithStack(errors.Wrapf(ValidationError, "Invalid maxUnavailable value %d. Expected >= 1", *v))
+			}
+			if *v > 1 && group != ServerGroupCoordinators && group != ServerGroupSyncWorkers {
+				return errors.WithStack(errors.Wrapf(ValidationError, "Invalid maxUnavailable value %d. Only coordinators and syncworkers can be rotated in batches", *v))
+			}
+		}
+
 		if err := s.validate(); err != nil {
 			return errors.WithStack(err)
 		}
@@ -0,0 +1,126 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// TLSClientAuthMode defines if client certificates are verified by the servers
+type TLSClientAuthMode string
+
+const (
+	// TLSClientAuthModeDisabled disables verification of client certificates
+	TLSClientAuthModeDisabled TLSClientAuthMode = "Disabled"
+	// TLSClientAuthModeOptional verifies client certificates when they are presented
+	TLSClientAuthModeOptional TLSClientAuthMode = "Optional"
+	// TLSClientAuthModeRequired rejects connections without valid client certificate.
+	// Metrics exporter, arangosync and logical backups connect without client certificate,
+	// so they can not be used in this mode.
+	TLSClientAuthModeRequired TLSClientAuthMode = "Required"
+)
+
+// TLSClientAuthSpec holds mutual TLS specific configuration settings.
+type TLSClientAuthSpec struct {
+	// Mode defines if client certificates are verified
+	Mode *TLSClientAuthMode `json:"mode,omitempty"`
+	// CASecretName is the name of the secret with CA which signs client certificates
+	CASecretName *string `json:"caSecretName,omitempty"`
+}
+
+// GetMode returns the value of mode.
+func (s *TLSClientAuthSpec) GetMode() TLSClientAuthMode {
+	if s == nil || s.Mode == nil {
+		return TLSClientAuthModeDisabled
+	}
+
+	return *s.Mode
+}
+
+// IsEnabled returns true when client certificates are verified.
+func (s *TLSClientAuthSpec) IsEnabled() bool {
+	return s.GetMode() != TLSClientAuthModeDisabled
+}
+
+// IsRequired returns true when client certificates are required.
+func (s *TLSClientAuthSpec) IsRequired() bool {
+	return s.GetMode() == TLSClientAuthModeRequired
+}
+
+// GetCASecretName returns the value of caSecretName.
+func (s *TLSClientAuthSpec) GetCASecretName() string {
+	if s == nil {
+		return ""
+	}
+
+	return util.StringOrDefault(s.CASecretName)
+}
+
+// Validate the given spec
+func (s *TLSClientAuthSpec) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	switch m := s.GetMode(); m {
+	case TLSClientAuthModeDisabled:
+		return nil
+	case TLSClientAuthModeOptional, TLSClientAuthModeRequired:
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "Unknown client authentication mode %s", m))
+	}
+
+	if err := k8sutil.ValidateResourceName(s.GetCASecretName()); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// SetDefaults fills in missing defaults
+func (s *TLSClientAuthSpec) SetDefaults(defaultCASecretName string) {
+	if s == nil {
+		return
+	}
+
+	if s.GetCASecretName() == "" {
+		// Note that we don't check for nil here, since even a specified, but empty
+		// string should result in the default value.
+		s.CASecretName = util.NewString(defaultCASecretName)
+	}
+}
+
+// SetDefaultsFrom fills unspecified fields with a value from given source spec.
+func (s *TLSClientAuthSpec) SetDefaultsFrom(source *TLSClientAuthSpec) {
+	if source == nil {
+		return
+	}
+
+	if s.Mode == nil && source.Mode != nil {
+		mode := *source.Mode
+		s.Mode = &mode
+	}
+	if s.CASecretName == nil {
+		s.CASecretName = util.NewStringOrNil(source.CASecretName)
+	}
+}
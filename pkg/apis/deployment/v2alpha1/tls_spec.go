@@ -52,9 +52,13 @@ const (
 )
 
 const (
-	defaultTLSTTL = Duration("2610h") // About 3 month
+	defaultTLSTTL         = Duration("2610h") // About 3 month
+	defaultTLSRenewBefore = Duration("168h")  // One week
 )
 
+// defaultTLSExpiryWarnings defines thresholds for certificate expiry warnings
+var defaultTLSExpiryWarnings = []Duration{"720h", "168h", "24h"}
+
 // TLSSpec holds TLS specific configuration settings
 type TLSSpec struct {
 	CASecretName *string        `json:"caSecretName,omitempty"`
@@ -62,6 +66,13 @@ type TLSSpec struct {
 	TTL          *Duration      `json:"ttl,omitempty"`
 	SNI          *TLSSNISpec    `json:"sni,omitempty"`
 	Mode         *TLSRotateMode `json:"mode,omitempty"`
+	Issuer       *TLSIssuerSpec `json:"issuer,omitempty"`
+	// RenewBefore defines how long before expiry certificates are renewed
+	RenewBefore *Duration `json:"renewBefore,omitempty"`
+	// ExpiryWarnings defines thresholds before expiry at which warning events are emitted
+	ExpiryWarnings []Duration `json:"expiryWarnings,omitempty"`
+	// ClientAuth defines verification of client certificates
+	ClientAuth *TLSClientAuthSpec `json:"clientAuth,omitempty"`
 }
 
 const (
@@ -84,6 +95,20 @@ func (s TLSSpec) GetTTL() Duration {
 	return DurationOrDefault(s.TTL)
 }
 
+// GetRenewBefore returns the value of renewBefore.
+func (s TLSSpec) GetRenewBefore() Duration {
+	return DurationOrDefault(s.RenewBefore, defaultTLSRenewBefore)
+}
+
+// GetExpiryWarnings returns the value of expiryWarnings.
+func (s TLSSpec) GetExpiryWarnings() []Duration {
+	if s.ExpiryWarnings == nil {
+		return defaultTLSExpiryWarnings
+	}
+
+	return s.ExpiryWarnings
+}
+
 func (a TLSSpec) GetSNI() TLSSNISpec {
 	if a.SNI == nil {
 		return TLSSNISpec{}
@@ -92,6 +117,11 @@ func (a TLSSpec) GetSNI() TLSSNISpec {
 	return *a.SNI
 }
 
+// IsExternallyIssued returns true when server certificates are signed by an external issuer.
+func (s TLSSpec) IsExternallyIssued() bool {
+	return s.Issuer != nil
+}
+
 // IsSecure returns true when a CA secret has been set, false otherwise.
 func (s TLSSpec) IsSecure() bool {
 	return s.GetCASecretName() != CASecretNameDisabled
@@ -126,6 +156,27 @@ func (s TLSSpec) Validate() error {
 		if err := s.GetTTL().Validate(); err != nil {
 			return errors.WithStack(err)
 		}
+		if err := s.Issuer.Validate(); err != nil {
+			return errors.WithStack(errors.Wrapf(err, "tls.issuer"))
+		}
+		if s.RenewBefore != nil {
+			if err := s.RenewBefore.Validate(); err != nil {
+				return errors.WithStack(errors.Wrapf(err, "tls.renewBefore"))
+			}
+			if ttl := s.GetTTL().AsDuration(); ttl > 0 && s.RenewBefore.AsDuration() >= ttl {
+				return errors.WithStack(errors.Wrapf(ValidationError, "tls.renewBefore needs to be lower than tls.ttl"))
+			}
+		}
+		for _, warning := range s.ExpiryWarnings {
+			if err := warning.Validate(); err != nil {
+				return errors.WithStack(errors.Wrapf(err, "tls.expiryWarnings"))
+			}
+		}
+		if err := s.ClientAuth.Validate(); err != nil {
+			return errors.WithStack(errors.Wrapf(err, "tls.clientAuth"))
+		}
+	} else if s.ClientAuth.IsEnabled() {
+		return errors.WithStack(errors.Wrapf(ValidationError, "tls.clientAuth requires TLS to be enabled"))
 	}
 	return nil
 }
@@ -158,4 +209,18 @@ func (s *TLSSpec) SetDefaultsFrom(source TLSSpec) {
 	if s.SNI == nil {
 		s.SNI = source.SNI.DeepCopy()
 	}
+	if s.Issuer == nil {
+		s.Issuer = source.Issuer.DeepCopy()
+	}
+	if s.RenewBefore == nil {
+		s.RenewBefore = NewDurationOrNil(source.RenewBefore)
+	}
+	if s.ExpiryWarnings == nil {
+		s.ExpiryWarnings = source.ExpiryWarnings
+	}
+	if s.ClientAuth == nil {
+		s.ClientAuth = source.ClientAuth.DeepCopy()
+	} else {
+		s.ClientAuth.SetDefaultsFrom(source.ClientAuth)
+	}
 }
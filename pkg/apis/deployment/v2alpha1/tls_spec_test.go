@@ -45,6 +45,59 @@ func TestTLSSpecValidate(t *testing.T) {
 	assert.Error(t, TLSSpec{CASecretName: util.NewString("foo"), AltNames: []string{"@@"}}.Validate())
 }
 
+func TestTLSSpecIssuerValidate(t *testing.T) {
+	// Valid
+	assert.Nil(t, TLSSpec{CASecretName: util.NewString("foo"), Issuer: &TLSIssuerSpec{Type: TLSIssuerTypeKubernetes}}.Validate())
+	assert.Nil(t, TLSSpec{CASecretName: util.NewString("foo"), Issuer: &TLSIssuerSpec{Type: TLSIssuerTypeCertManager, Name: util.NewString("ca")}}.Validate())
+	assert.Nil(t, TLSSpec{CASecretName: util.NewString("foo"), Issuer: &TLSIssuerSpec{Type: TLSIssuerTypeCertManager, Name: util.NewString("ca"), Kind: util.NewString(TLSIssuerKindClusterIssuer)}}.Validate())
+
+	// Not valid
+	assert.Error(t, TLSSpec{CASecretName: util.NewString("foo"), Issuer: &TLSIssuerSpec{}}.Validate())
+	assert.Error(t, TLSSpec{CASecretName: util.NewString("foo"), Issuer: &TLSIssuerSpec{Type: TLSIssuerTypeCertManager}}.Validate())
+	assert.Error(t, TLSSpec{CASecretName: util.NewString("foo"), Issuer: &TLSIssuerSpec{Type: TLSIssuerTypeCertManager, Name: util.NewString("ca"), Kind: util.NewString("Other")}}.Validate())
+}
+
+func TestTLSSpecRenewalValidate(t *testing.T) {
+	// Valid
+	assert.Nil(t, TLSSpec{CASecretName: util.NewString("foo"), TTL: NewDuration("1h")}.Validate())
+	assert.Nil(t, TLSSpec{CASecretName: util.NewString("foo"), TTL: NewDuration("48h"), RenewBefore: NewDuration("24h")}.Validate())
+	assert.Nil(t, TLSSpec{CASecretName: util.NewString("foo"), ExpiryWarnings: []Duration{"72h", "1h"}}.Validate())
+
+	// Not valid
+	assert.Error(t, TLSSpec{CASecretName: util.NewString("foo"), RenewBefore: NewDuration("1x")}.Validate())
+	assert.Error(t, TLSSpec{CASecretName: util.NewString("foo"), TTL: NewDuration("24h"), RenewBefore: NewDuration("48h")}.Validate())
+	assert.Error(t, TLSSpec{CASecretName: util.NewString("foo"), ExpiryWarnings: []Duration{"1x"}}.Validate())
+}
+
+func TestTLSSpecClientAuthValidate(t *testing.T) {
+	required := TLSClientAuthModeRequired
+	optional := TLSClientAuthModeOptional
+	disabled := TLSClientAuthModeDisabled
+	unknown := TLSClientAuthMode("Unknown")
+
+	// Valid
+	assert.Nil(t, TLSSpec{CASecretName: util.NewString("foo"), ClientAuth: &TLSClientAuthSpec{Mode: &required, CASecretName: util.NewString("client-ca")}}.Validate())
+	assert.Nil(t, TLSSpec{CASecretName: util.NewString("foo"), ClientAuth: &TLSClientAuthSpec{Mode: &optional, CASecretName: util.NewString("client-ca")}}.Validate())
+	assert.Nil(t, TLSSpec{CASecretName: util.NewString("None"), ClientAuth: &TLSClientAuthSpec{Mode: &disabled}}.Validate())
+
+	// Not valid
+	assert.Error(t, TLSSpec{CASecretName: util.NewString("foo"), ClientAuth: &TLSClientAuthSpec{Mode: &required}}.Validate())
+	assert.Error(t, TLSSpec{CASecretName: util.NewString("foo"), ClientAuth: &TLSClientAuthSpec{Mode: &unknown, CASecretName: util.NewString("client-ca")}}.Validate())
+	assert.Error(t, TLSSpec{CASecretName: util.NewString("None"), ClientAuth: &TLSClientAuthSpec{Mode: &required, CASecretName: util.NewString("client-ca")}}.Validate())
+}
+
+func TestTLSClientAuthSpecMode(t *testing.T) {
+	required := TLSClientAuthModeRequired
+	optional := TLSClientAuthModeOptional
+
+	var nilSpec *TLSClientAuthSpec
+	assert.False(t, nilSpec.IsEnabled())
+	assert.False(t, (&TLSClientAuthSpec{}).IsEnabled())
+	assert.True(t, (&TLSClientAuthSpec{Mode: &optional}).IsEnabled())
+	assert.False(t, (&TLSClientAuthSpec{Mode: &optional}).IsRequired())
+	assert.True(t, (&TLSClientAuthSpec{Mode: &required}).IsRequired())
+}
+
 func TestTLSSpecIsSecure(t *testing.T) {
 	assert.True(t, TLSSpec{CASecretName: util.NewString("")}.IsSecure())
 	assert.True(t, TLSSpec{CASecretName: util.NewString("foo")}.IsSecure())
@@ -63,4 +116,30 @@ func TestTLSSpecSetDefaults(t *testing.T) {
 	assert.Len(t, def(TLSSpec{AltNames: []string{"foo.local"}}).GetAltNames(), 1)
 	assert.Equal(t, defaultTLSTTL, def(TLSSpec{}).GetTTL())
 	assert.Equal(t, time.Hour, def(TLSSpec{TTL: NewDuration("1h")}).GetTTL().AsDuration())
+	assert.Equal(t, defaultTLSRenewBefore, def(TLSSpec{}).GetRenewBefore())
+	assert.Equal(t, defaultTLSExpiryWarnings, def(TLSSpec{}).GetExpiryWarnings())
+	assert.Len(t, def(TLSSpec{ExpiryWarnings: []Duration{}}).GetExpiryWarnings(), 0)
+}
+
+func TestDeploymentSpecClientAuthRequired(t *testing.T) {
+	required := TLSClientAuthModeRequired
+	optional := TLSClientAuthModeOptional
+
+	spec := func(mode TLSClientAuthMode, metrics, sync bool) *DeploymentSpec {
+		s := DeploymentSpec{
+			Mode: NewMode(DeploymentModeCluster),
+			TLS: TLSSpec{
+				ClientAuth: &TLSClientAuthSpec{Mode: &mode},
+			},
+			Metrics: MetricsSpec{Enabled: util.NewBool(metrics)},
+			Sync:    SyncSpec{Enabled: util.NewBool(sync)},
+		}
+		s.SetDefaults("test")
+		return &s
+	}
+
+	assert.NoError(t, spec(required, false, false).Validate())
+	assert.NoError(t, spec(optional, true, true).Validate())
+	assert.Error(t, spec(required, true, false).Validate())
+	assert.Error(t, spec(required, false, true).Validate())
 }
@@ -29,4 +29,6 @@ type CollectionStatus struct {
 	// Replication status per shard.
 	// The list is ordered by shard index (0..noShards-1)
 	Shards []ShardStatus `json:"shards,omitempty"`
+	// Lag holds the replication progress of all shards of the collection.
+	Lag *LagStatus `json:"lag,omitempty"`
 }
@@ -33,6 +33,10 @@ type ConditionType string
 const (
 	// ConditionTypeConfigured indicates that the replication has been configured.
 	ConditionTypeConfigured ConditionType = "Configured"
+	// ConditionTypeLagHealthy indicates that the replication lag is within the configured threshold.
+	ConditionTypeLagHealthy ConditionType = "LagHealthy"
+	// ConditionTypePaused indicates that the synchronization is suspended on request.
+	ConditionTypePaused ConditionType = "Paused"
 )
 
 // Condition represents one current condition of a deployment or deployment member.
@@ -0,0 +1,56 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointSpecValidateAccessPackage(t *testing.T) {
+	s := EndpointSpec{AccessPackageSecretName: util.NewString("dc1-access"), AccessPackageCASecretName: util.NewString("dc1-client-auth-ca")}
+	assert.True(t, s.HasAccessPackage())
+	// Keyfile & TLS CA are provided by the access package
+	assert.NoError(t, s.Validate(true))
+	assert.NoError(t, s.Validate(false))
+
+	s.DeploymentName = util.NewString("dc1")
+	assert.Error(t, s.Validate(true))
+
+	s = EndpointSpec{AccessPackageSecretName: util.NewString("dc1-access"), AccessPackageCASecretName: util.NewString("dc1-client-auth-ca"), MasterEndpoint: []string{"https://dc1:8629"}}
+	assert.Error(t, s.Validate(true))
+
+	s = EndpointSpec{AccessPackageSecretName: util.NewString("Invalid_Name"), AccessPackageCASecretName: util.NewString("dc1-client-auth-ca")}
+	assert.Error(t, s.Validate(true))
+
+	// Access package cannot be trusted without an independent CA certificate
+	s = EndpointSpec{AccessPackageSecretName: util.NewString("dc1-access")}
+	assert.Error(t, s.Validate(true))
+
+	s = EndpointSpec{AccessPackageSecretName: util.NewString("dc1-access"), AccessPackageCASecretName: util.NewString("Invalid_Name")}
+	assert.Error(t, s.Validate(true))
+
+	// Without access package, source keyfile is required
+	s = EndpointSpec{MasterEndpoint: []string{"https://dc1:8629"}}
+	assert.Error(t, s.Validate(true))
+}
@@ -27,4 +27,6 @@ type EndpointStatus struct {
 	// Databases holds the replication status of all databases from the point of view of this endpoint.
 	// List is ordered by name of the database.
 	Databases []DatabaseStatus `json:"databases,omitempty"`
+	// Lag holds the replication progress of all databases.
+	Lag *LagStatus `json:"lag,omitempty"`
 }
@@ -0,0 +1,80 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LagStatus holds the replication progress of a group of shards.
+type LagStatus struct {
+	// ShardsNotInSync holds the number of shards which are not (yet) in sync.
+	ShardsNotInSync int `json:"shardsNotInSync,omitempty"`
+	// LastSyncTime holds the most recent time any of the shards received a message.
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	// Delay holds the largest estimated delay of any of the shards.
+	Delay *metav1.Duration `json:"delay,omitempty"`
+}
+
+// GetDelay returns the value of delay.
+func (l *LagStatus) GetDelay() time.Duration {
+	if l == nil || l.Delay == nil {
+		return 0
+	}
+	return l.Delay.Duration
+}
+
+// GetShardsNotInSync returns the value of shardsNotInSync.
+func (l *LagStatus) GetShardsNotInSync() int {
+	if l == nil {
+		return 0
+	}
+	return l.ShardsNotInSync
+}
+
+// Add merges the progress of a single shard into the lag status.
+func (l *LagStatus) Add(inSync bool, lastSyncTime time.Time, delay time.Duration) {
+	if !inSync {
+		l.ShardsNotInSync++
+	}
+	if !lastSyncTime.IsZero() && (l.LastSyncTime == nil || l.LastSyncTime.Time.Before(lastSyncTime)) {
+		t := metav1.NewTime(lastSyncTime)
+		l.LastSyncTime = &t
+	}
+	if delay > l.GetDelay() {
+		l.Delay = &metav1.Duration{Duration: delay}
+	}
+}
+
+// Merge merges the given lag status into the lag status.
+func (l *LagStatus) Merge(other *LagStatus) {
+	if other == nil {
+		return
+	}
+	l.ShardsNotInSync += other.ShardsNotInSync
+	var lastSyncTime time.Time
+	if other.LastSyncTime != nil {
+		lastSyncTime = other.LastSyncTime.Time
+	}
+	l.Add(true, lastSyncTime, other.GetDelay())
+}
@@ -22,13 +22,47 @@
 
 package v1
 
-import "github.com/arangodb/kube-arangodb/pkg/util/errors"
+import (
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 // DeploymentReplicationSpec contains the specification part of
 // an ArangoDeploymentReplication.
 type DeploymentReplicationSpec struct {
 	Source      EndpointSpec `json:"source"`
 	Destination EndpointSpec `json:"destination"`
+	// LagThreshold holds the maximum accepted replication delay.
+	// When exceeded, the LagHealthy condition is set to false.
+	LagThreshold *metav1.Duration `json:"lagThreshold,omitempty"`
+	// Paused cancels the synchronization (the syncmaster cannot suspend it) and keeps the
+	// destination deployment read-only until the synchronization is resumed.
+	// Resuming configures the synchronization again, which synchronizes all shards from scratch.
+	// Deleting the replication also switches the destination back to the default server mode.
+	Paused *bool `json:"paused,omitempty"`
+	// Switchover requests the operator to reverse the direction of the replication.
+	Switchover *SwitchoverSpec `json:"switchover,omitempty"`
+}
+
+// GetLagThreshold returns the value of lagThreshold.
+func (s DeploymentReplicationSpec) GetLagThreshold() time.Duration {
+	if s.LagThreshold == nil {
+		return 0
+	}
+	return s.LagThreshold.Duration
+}
+
+// IsPaused returns the value of paused.
+func (s DeploymentReplicationSpec) IsPaused() bool {
+	return util.BoolOrDefault(s.Paused)
+}
+
+// IsLagThresholdEnabled returns true when a positive lag threshold is configured.
+func (s DeploymentReplicationSpec) IsLagThresholdEnabled() bool {
+	return s.GetLagThreshold() > 0
 }
 
 // Validate the given spec, returning an error on validation
@@ -40,6 +74,21 @@ func (s DeploymentReplicationSpec) Validate() error {
 	if err := s.Destination.Validate(false); err != nil {
 		return errors.WithStack(err)
 	}
+	if s.GetLagThreshold() < 0 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "lagThreshold must not be negative"))
+	}
+	if err := s.Switchover.Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+	if s.IsPaused() && !s.Destination.HasDeploymentName() {
+		return errors.WithStack(errors.Wrapf(ValidationError, "Pausing requires destination.deploymentName, so writes in the destination can be stopped"))
+	}
+	if s.IsPaused() && s.Switchover != nil && !s.Switchover.IsFailover() {
+		return errors.WithStack(errors.Wrapf(ValidationError, "A planned switchover requires the replication not to be paused"))
+	}
+	if s.Switchover != nil && !s.Switchover.IsFailover() && !s.Source.HasDeploymentName() {
+		return errors.WithStack(errors.Wrapf(ValidationError, "A planned switchover requires source.deploymentName, so writes in the source can be stopped"))
+	}
 	return nil
 }
 
@@ -53,6 +102,9 @@ func (s *DeploymentReplicationSpec) SetDefaults() {
 func (s *DeploymentReplicationSpec) SetDefaultsFrom(source DeploymentReplicationSpec) {
 	s.Source.SetDefaultsFrom(source.Source)
 	s.Destination.SetDefaultsFrom(source.Destination)
+	if s.LagThreshold == nil && source.LagThreshold != nil {
+		s.LagThreshold = &metav1.Duration{Duration: source.LagThreshold.Duration}
+	}
 }
 
 // ResetImmutableFields replaces all immutable fields in the given target with values from the source spec.
@@ -68,3 +120,17 @@ func (s DeploymentReplicationSpec) ResetImmutableFields(target *DeploymentReplic
 	}
 	return result
 }
+
+// Reversed returns a copy of the spec with source and destination swapped,
+// as it should look like after a switchover.
+// A pause is not carried over to the reversed synchronization.
+func (s DeploymentReplicationSpec) Reversed() DeploymentReplicationSpec {
+	result := *s.DeepCopy()
+	result.Source, result.Destination = result.Destination, result.Source
+	if name := s.Switchover.GetKeyfileSecretName(); name != "" {
+		result.Source.Authentication.KeyfileSecretName = util.NewString(name)
+	}
+	result.Switchover = nil
+	result.Paused = nil
+	return result
+}
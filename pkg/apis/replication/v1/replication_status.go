@@ -38,6 +38,9 @@ type DeploymentReplicationStatus struct {
 	// Destination contains the detailed status of the destination endpoint
 	Destination EndpointStatus `json:"destination"`
 
+	// Switchover holds the progress of the most recent switchover
+	Switchover *SwitchoverStatus `json:"switchover,omitempty"`
+
 	// CancelFailures records the number of times that the configuration was canceled
 	// which resulted in an error.
 	CancelFailures int `json:"cancel-failures,omitempty"`
@@ -0,0 +1,130 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1
+
+import (
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SwitchoverMode is a strongly typed mode of a switchover.
+type SwitchoverMode string
+
+const (
+	// SwitchoverModePlanned waits until all shards are in sync and switches the source
+	// deployment to read-only before the direction of the replication is reversed.
+	// Requires the source to be an ArangoDeployment in the namespace of the replication.
+	SwitchoverModePlanned SwitchoverMode = "Planned"
+	// SwitchoverModeFailover stops the replication immediately (forcefully if needed)
+	// and reverses its direction. Data which is not yet replicated is lost.
+	SwitchoverModeFailover SwitchoverMode = "Failover"
+)
+
+const (
+	defaultSwitchoverTimeout = 10 * time.Minute
+)
+
+// Validate the mode.
+func (m SwitchoverMode) Validate() error {
+	switch m {
+	case SwitchoverModePlanned, SwitchoverModeFailover:
+		return nil
+	default:
+		return errors.WithStack(errors.Wrapf(ValidationError, "Unknown switchover mode: '%s'", string(m)))
+	}
+}
+
+// SwitchoverSpec requests the operator to reverse the direction of the replication.
+// The field is cleared by the operator once the switchover is finished.
+type SwitchoverSpec struct {
+	// Mode of the switchover, Planned (default) or Failover.
+	Mode *SwitchoverMode `json:"mode,omitempty"`
+	// AcceptDataLoss must be set to true for a Failover.
+	AcceptDataLoss *bool `json:"acceptDataLoss,omitempty"`
+	// Timeout holds the maximum time a Planned switchover waits for the shards to be in sync.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// KeyfileSecretName holds the name of a Secret containing a client authentication certificate
+	// used to authenticate at the current destination, once it becomes the source.
+	// When not set, destination.auth.keyfileSecretName is used.
+	KeyfileSecretName *string `json:"keyfileSecretName,omitempty"`
+}
+
+// GetMode returns the value of mode.
+func (s *SwitchoverSpec) GetMode() SwitchoverMode {
+	if s == nil || s.Mode == nil {
+		return SwitchoverModePlanned
+	}
+	return *s.Mode
+}
+
+// IsFailover returns true when the switchover is an emergency failover.
+func (s *SwitchoverSpec) IsFailover() bool {
+	return s.GetMode() == SwitchoverModeFailover
+}
+
+// GetAcceptDataLoss returns the value of acceptDataLoss.
+func (s *SwitchoverSpec) GetAcceptDataLoss() bool {
+	if s == nil {
+		return false
+	}
+	return util.BoolOrDefault(s.AcceptDataLoss)
+}
+
+// GetTimeout returns the value of timeout.
+func (s *SwitchoverSpec) GetTimeout() time.Duration {
+	if s == nil || s.Timeout == nil {
+		return defaultSwitchoverTimeout
+	}
+	return s.Timeout.Duration
+}
+
+// GetKeyfileSecretName returns the value of keyfileSecretName.
+func (s *SwitchoverSpec) GetKeyfileSecretName() string {
+	if s == nil {
+		return ""
+	}
+	return util.StringOrDefault(s.KeyfileSecretName)
+}
+
+// Validate the given spec, returning an error on validation
+// problems or nil if all ok.
+func (s *SwitchoverSpec) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if err := s.GetMode().Validate(); err != nil {
+		return errors.WithStack(err)
+	}
+	if s.IsFailover() && !s.GetAcceptDataLoss() {
+		return errors.WithStack(errors.Wrapf(ValidationError, "A failover requires acceptDataLoss to be set"))
+	}
+	if s.GetTimeout() <= 0 {
+		return errors.WithStack(errors.Wrapf(ValidationError, "timeout must be positive"))
+	}
+	if err := k8sutil.ValidateOptionalResourceName(s.GetKeyfileSecretName()); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
@@ -25,6 +25,7 @@
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -97,6 +98,11 @@ func (in *CollectionStatus) DeepCopyInto(out *CollectionStatus) {
 		*out = make([]ShardStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.Lag != nil {
+		in, out := &in.Lag, &out.Lag
+		*out = new(LagStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -160,6 +166,11 @@ func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Lag != nil {
+		in, out := &in.Lag, &out.Lag
+		*out = new(LagStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -178,6 +189,21 @@ func (in *DeploymentReplicationSpec) DeepCopyInto(out *DeploymentReplicationSpec
 	*out = *in
 	in.Source.DeepCopyInto(&out.Source)
 	in.Destination.DeepCopyInto(&out.Destination)
+	if in.LagThreshold != nil {
+		in, out := &in.LagThreshold, &out.LagThreshold
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Paused != nil {
+		in, out := &in.Paused, &out.Paused
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Switchover != nil {
+		in, out := &in.Switchover, &out.Switchover
+		*out = new(SwitchoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -203,6 +229,11 @@ func (in *DeploymentReplicationStatus) DeepCopyInto(out *DeploymentReplicationSt
 	}
 	in.Source.DeepCopyInto(&out.Source)
 	in.Destination.DeepCopyInto(&out.Destination)
+	if in.Switchover != nil {
+		in, out := &in.Switchover, &out.Switchover
+		*out = new(SwitchoverStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -255,6 +286,16 @@ func (in *EndpointSpec) DeepCopyInto(out *EndpointSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AccessPackageSecretName != nil {
+		in, out := &in.AccessPackageSecretName, &out.AccessPackageSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessPackageCASecretName != nil {
+		in, out := &in.AccessPackageCASecretName, &out.AccessPackageCASecretName
+		*out = new(string)
+		**out = **in
+	}
 	in.Authentication.DeepCopyInto(&out.Authentication)
 	in.TLS.DeepCopyInto(&out.TLS)
 	return
@@ -280,6 +321,11 @@ func (in *EndpointStatus) DeepCopyInto(out *EndpointStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Lag != nil {
+		in, out := &in.Lag, &out.Lag
+		*out = new(LagStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -314,6 +360,31 @@ func (in *EndpointTLSSpec) DeepCopy() *EndpointTLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LagStatus) DeepCopyInto(out *LagStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LagStatus.
+func (in *LagStatus) DeepCopy() *LagStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LagStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShardStatus) DeepCopyInto(out *ShardStatus) {
 	*out = *in
@@ -329,3 +400,80 @@ func (in *ShardStatus) DeepCopy() *ShardStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchoverSpec) DeepCopyInto(out *SwitchoverSpec) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(SwitchoverMode)
+		**out = **in
+	}
+	if in.AcceptDataLoss != nil {
+		in, out := &in.AcceptDataLoss, &out.AcceptDataLoss
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.KeyfileSecretName != nil {
+		in, out := &in.KeyfileSecretName, &out.KeyfileSecretName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchoverSpec.
+func (in *SwitchoverSpec) DeepCopy() *SwitchoverSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchoverSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchoverStatus) DeepCopyInto(out *SwitchoverStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]SwitchoverStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchoverStatus.
+func (in *SwitchoverStatus) DeepCopy() *SwitchoverStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchoverStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchoverStep) DeepCopyInto(out *SwitchoverStep) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchoverStep.
+func (in *SwitchoverStep) DeepCopy() *SwitchoverStep {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchoverStep)
+	in.DeepCopyInto(out)
+	return out
+}
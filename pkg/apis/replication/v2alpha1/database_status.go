@@ -29,4 +29,6 @@ type DatabaseStatus struct {
 	// Collections holds the replication status of each collection in the database.
 	// List is ordered by name of the collection.
 	Collections []CollectionStatus `json:"collections,omitempty"`
+	// Lag holds the replication progress of all collections in the database.
+	Lag *LagStatus `json:"lag,omitempty"`
 }
@@ -39,6 +39,16 @@ type EndpointSpec struct {
 	DeploymentName *string `json:"deploymentName,omitempty"`
 	// MasterEndpoint holds a list of URLs used to reach the syncmaster(s).
 	MasterEndpoint []string `json:"masterEndpoint,omitempty"`
+	// AccessPackageSecretName holds the name of a Secret containing a signed access package,
+	// exported by the operator managing the deployment at the endpoint.
+	// If set this provides values for masterEndpoint, auth.keyfileSecretName & tls.caSecretName.
+	// The Secret must be in the namespace of the ArangoDeploymentReplication.
+	AccessPackageSecretName *string `json:"accessPackageSecretName,omitempty"`
+	// AccessPackageCASecretName holds the name of a Secret containing (in `ca.crt`) the client authentication
+	// CA certificate of the deployment which exported the access package.
+	// The signature of the access package is verified against this certificate, so it must be obtained
+	// independently of the access package. Required when accessPackageSecretName is set.
+	AccessPackageCASecretName *string `json:"accessPackageCASecretName,omitempty"`
 	// Authentication holds settings needed to authentication at the syncmaster.
 	Authentication EndpointAuthenticationSpec `json:"auth"`
 	// TLS holds settings needed to verify the TLS connection to the syncmaster.
@@ -55,12 +65,45 @@ func (s EndpointSpec) HasDeploymentName() bool {
 	return s.GetDeploymentName() != ""
 }
 
+// GetAccessPackageSecretName returns the value of accessPackageSecretName.
+func (s EndpointSpec) GetAccessPackageSecretName() string {
+	return util.StringOrDefault(s.AccessPackageSecretName)
+}
+
+// HasAccessPackage returns the true when a non-empty access package secret name it set.
+func (s EndpointSpec) HasAccessPackage() bool {
+	return s.GetAccessPackageSecretName() != ""
+}
+
+// GetAccessPackageCASecretName returns the value of accessPackageCASecretName.
+func (s EndpointSpec) GetAccessPackageCASecretName() string {
+	return util.StringOrDefault(s.AccessPackageCASecretName)
+}
+
 // Validate the given spec, returning an error on validation
 // problems or nil if all ok.
 func (s EndpointSpec) Validate(isSourceEndpoint bool) error {
 	if err := k8sutil.ValidateOptionalResourceName(s.GetDeploymentName()); err != nil {
 		return errors.WithStack(err)
 	}
+	if err := k8sutil.ValidateOptionalResourceName(s.GetAccessPackageSecretName()); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := k8sutil.ValidateOptionalResourceName(s.GetAccessPackageCASecretName()); err != nil {
+		return errors.WithStack(err)
+	}
+	if s.HasAccessPackage() {
+		if s.HasDeploymentName() || len(s.MasterEndpoint) > 0 {
+			return errors.WithStack(errors.Wrapf(ValidationError, "Provide either an access package or a deploy name and master endpoints"))
+		}
+		if s.GetAccessPackageCASecretName() == "" {
+			return errors.WithStack(errors.Wrapf(ValidationError, "Provide the name of a Secret containing the CA certificate to verify the access package with"))
+		}
+		if err := s.Authentication.Validate(false); err != nil {
+			return errors.WithStack(err)
+		}
+		return nil
+	}
 	for _, ep := range s.MasterEndpoint {
 		if _, err := url.Parse(ep); err != nil {
 			return errors.WithStack(errors.Wrapf(ValidationError, "Invalid master endpoint '%s': %s", ep, err))
@@ -90,6 +133,12 @@ func (s *EndpointSpec) SetDefaultsFrom(source EndpointSpec) {
 	if s.DeploymentName == nil {
 		s.DeploymentName = util.NewStringOrNil(source.DeploymentName)
 	}
+	if s.AccessPackageSecretName == nil {
+		s.AccessPackageSecretName = util.NewStringOrNil(source.AccessPackageSecretName)
+	}
+	if s.AccessPackageCASecretName == nil {
+		s.AccessPackageCASecretName = util.NewStringOrNil(source.AccessPackageCASecretName)
+	}
 	s.Authentication.SetDefaultsFrom(source.Authentication)
 	s.TLS.SetDefaultsFrom(source.TLS)
 }
@@ -0,0 +1,125 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	"testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwitchoverSpecValidate(t *testing.T) {
+	var s *SwitchoverSpec
+	assert.NoError(t, s.Validate())
+	assert.Equal(t, SwitchoverModePlanned, s.GetMode())
+
+	s = &SwitchoverSpec{}
+	assert.NoError(t, s.Validate())
+
+	failover := SwitchoverModeFailover
+	s.Mode = &failover
+	assert.Error(t, s.Validate())
+	s.AcceptDataLoss = util.NewBool(true)
+	assert.NoError(t, s.Validate())
+
+	unknown := SwitchoverMode("Other")
+	s.Mode = &unknown
+	assert.Error(t, s.Validate())
+}
+
+func TestDeploymentReplicationSpecPaused(t *testing.T) {
+	s := DeploymentReplicationSpec{
+		Source: EndpointSpec{
+			DeploymentName: util.NewString("dc1"),
+			Authentication: EndpointAuthenticationSpec{KeyfileSecretName: util.NewString("dc1-client")},
+		},
+		Destination: EndpointSpec{
+			DeploymentName: util.NewString("dc2"),
+		},
+		Paused: util.NewBool(true),
+	}
+	assert.True(t, s.IsPaused())
+	assert.NoError(t, s.Validate())
+
+	s.Switchover = &SwitchoverSpec{}
+	assert.Error(t, s.Validate())
+
+	failover := SwitchoverModeFailover
+	s.Switchover = &SwitchoverSpec{Mode: &failover, AcceptDataLoss: util.NewBool(true)}
+	assert.NoError(t, s.Validate())
+	assert.False(t, s.Reversed().IsPaused())
+
+	s.Switchover = nil
+	s.Destination = EndpointSpec{
+		MasterEndpoint: []string{"https://dc2.example.com:8629"},
+		Authentication: EndpointAuthenticationSpec{KeyfileSecretName: util.NewString("dc2-client")},
+		TLS:            EndpointTLSSpec{CASecretName: util.NewString("dc2-ca")},
+	}
+	assert.Error(t, s.Validate())
+	s.Paused = nil
+	assert.NoError(t, s.Validate())
+}
+
+func TestDeploymentReplicationSpecPlannedSwitchoverRemoteSource(t *testing.T) {
+	s := DeploymentReplicationSpec{
+		Source: EndpointSpec{
+			MasterEndpoint: []string{"https://dc1.example.com:8629"},
+			Authentication: EndpointAuthenticationSpec{KeyfileSecretName: util.NewString("dc1-client")},
+			TLS:            EndpointTLSSpec{CASecretName: util.NewString("dc1-ca")},
+		},
+		Destination: EndpointSpec{
+			DeploymentName: util.NewString("dc2"),
+		},
+	}
+	assert.NoError(t, s.Validate())
+
+	s.Switchover = &SwitchoverSpec{}
+	assert.Error(t, s.Validate())
+
+	failover := SwitchoverModeFailover
+	s.Switchover = &SwitchoverSpec{Mode: &failover, AcceptDataLoss: util.NewBool(true)}
+	assert.NoError(t, s.Validate())
+}
+
+func TestDeploymentReplicationSpecReversed(t *testing.T) {
+	s := DeploymentReplicationSpec{
+		Source: EndpointSpec{
+			DeploymentName: util.NewString("dc1"),
+			Authentication: EndpointAuthenticationSpec{KeyfileSecretName: util.NewString("dc1-client")},
+		},
+		Destination: EndpointSpec{
+			DeploymentName: util.NewString("dc2"),
+		},
+		Switchover: &SwitchoverSpec{KeyfileSecretName: util.NewString("dc2-client")},
+	}
+
+	r := s.Reversed()
+	assert.Equal(t, "dc2", r.Source.GetDeploymentName())
+	assert.Equal(t, "dc2-client", r.Source.Authentication.GetKeyfileSecretName())
+	assert.Equal(t, "dc1", r.Destination.GetDeploymentName())
+	assert.Nil(t, r.Switchover)
+	assert.NoError(t, r.Validate())
+
+	// Original spec is not modified
+	assert.Equal(t, "dc1", s.Source.GetDeploymentName())
+	assert.NotNil(t, s.Switchover)
+}
@@ -0,0 +1,84 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v2alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SwitchoverPhase is a strongly typed phase of a switchover.
+type SwitchoverPhase string
+
+const (
+	// SwitchoverPhaseWaitingForSync indicates that the switchover waits for all shards to be in sync.
+	SwitchoverPhaseWaitingForSync SwitchoverPhase = "WaitingForSync"
+	// SwitchoverPhaseStoppingSync indicates that the replication is being stopped.
+	SwitchoverPhaseStoppingSync SwitchoverPhase = "StoppingSync"
+	// SwitchoverPhaseRestoring indicates that the switchover is given up and the source deployment
+	// is being switched back to the default server mode.
+	SwitchoverPhaseRestoring SwitchoverPhase = "Restoring"
+	// SwitchoverPhaseCompleted indicates that the direction of the replication has been reversed.
+	SwitchoverPhaseCompleted SwitchoverPhase = "Completed"
+	// SwitchoverPhaseFailed indicates that the switchover has been given up.
+	SwitchoverPhaseFailed SwitchoverPhase = "Failed"
+)
+
+// IsFinished returns true when the phase is final.
+func (p SwitchoverPhase) IsFinished() bool {
+	return p == SwitchoverPhaseCompleted || p == SwitchoverPhaseFailed
+}
+
+// SwitchoverStatus holds the progress of the most recent switchover.
+type SwitchoverStatus struct {
+	// Mode of the switchover
+	Mode SwitchoverMode `json:"mode"`
+	// Phase of the switchover
+	Phase SwitchoverPhase `json:"phase"`
+	// StartTime holds the time the switchover was started
+	StartTime metav1.Time `json:"startTime"`
+	// Steps holds the history of the switchover phases
+	Steps []SwitchoverStep `json:"steps,omitempty"`
+}
+
+// SwitchoverStep records a single phase transition of a switchover.
+type SwitchoverStep struct {
+	// Phase entered in this step
+	Phase SwitchoverPhase `json:"phase"`
+	// Time the phase was entered
+	Time metav1.Time `json:"time"`
+	// Message holds a human readable description of the step
+	Message string `json:"message,omitempty"`
+}
+
+// IsInProgress returns true when a switchover is started and not finished.
+func (s *SwitchoverStatus) IsInProgress() bool {
+	return s != nil && !s.Phase.IsFinished()
+}
+
+// SetPhase moves the switchover into the given phase, recording the step.
+func (s *SwitchoverStatus) SetPhase(phase SwitchoverPhase, message string) {
+	s.Phase = phase
+	s.Steps = append(s.Steps, SwitchoverStep{
+		Phase:   phase,
+		Time:    metav1.Now(),
+		Message: message,
+	})
+}
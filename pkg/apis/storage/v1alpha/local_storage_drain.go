@@ -0,0 +1,83 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1alpha
+
+import (
+	"strings"
+
+	core "k8s.io/api/core/v1"
+)
+
+const (
+	// ArangoLocalStorageDrainAnnotation marks nodes which volumes should be evacuated.
+	// On a Node the value has to be set to "true", on an ArangoLocalStorage it contains a comma separated list of node names.
+	ArangoLocalStorageDrainAnnotation = groupName + "/drain"
+)
+
+// IsNodeDrained returns true if the given node is marked for volume evacuation.
+func IsNodeDrained(node *core.Node) bool {
+	if node == nil {
+		return false
+	}
+
+	return strings.ToLower(node.GetAnnotations()[ArangoLocalStorageDrainAnnotation]) == "true"
+}
+
+// GetDrainNodes returns the names of the nodes marked for volume evacuation on this local storage.
+func (d *ArangoLocalStorage) GetDrainNodes() []string {
+	value, ok := d.GetAnnotations()[ArangoLocalStorageDrainAnnotation]
+	if !ok {
+		return nil
+	}
+
+	var nodes []string
+	for _, node := range strings.Split(value, ",") {
+		if node = strings.TrimSpace(node); node != "" {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+// LocalStorageDrainStatus contains the progress of volume evacuation from a single node.
+type LocalStorageDrainStatus struct {
+	// NodeName is the name of the node which is drained
+	NodeName string `json:"nodeName"`
+	// Volumes contains the names of PersistentVolumes which still exist on the node
+	Volumes []string `json:"volumes,omitempty"`
+	// Drained is set when no PersistentVolumes of the local storage are left on the node
+	Drained bool `json:"drained,omitempty"`
+}
+
+// LocalStorageDrainStatusList is a list of drain statuses.
+type LocalStorageDrainStatusList []LocalStorageDrainStatus
+
+// Get returns the drain status of the given node.
+func (l LocalStorageDrainStatusList) Get(nodeName string) (LocalStorageDrainStatus, bool) {
+	for _, s := range l {
+		if s.NodeName == nodeName {
+			return s, true
+		}
+	}
+
+	return LocalStorageDrainStatus{}, false
+}
@@ -0,0 +1,56 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package v1alpha
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_ArangoLocalStorage_GetDrainNodes(t *testing.T) {
+	var ls ArangoLocalStorage
+	require.Nil(t, ls.GetDrainNodes())
+
+	ls.Annotations = map[string]string{
+		ArangoLocalStorageDrainAnnotation: "node-a, node-b,,",
+	}
+	require.Equal(t, []string{"node-a", "node-b"}, ls.GetDrainNodes())
+}
+
+func Test_IsNodeDrained(t *testing.T) {
+	require.False(t, IsNodeDrained(nil))
+	require.False(t, IsNodeDrained(&core.Node{}))
+
+	node := &core.Node{
+		ObjectMeta: meta.ObjectMeta{
+			Annotations: map[string]string{
+				ArangoLocalStorageDrainAnnotation: "True",
+			},
+		},
+	}
+	require.True(t, IsNodeDrained(node))
+
+	node.Annotations[ArangoLocalStorageDrainAnnotation] = "false"
+	require.False(t, IsNodeDrained(node))
+}
@@ -29,4 +29,6 @@ type LocalStorageStatus struct {
 	State LocalStorageState `json:"state,omitempty"`
 	// Reason for the state this object is in.
 	Reason string `json:"reason,omitempty"`
+	// Drain holds the progress of volume evacuation from drained nodes
+	Drain LocalStorageDrainStatusList `json:"drain,omitempty"`
 }
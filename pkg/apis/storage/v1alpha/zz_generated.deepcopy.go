@@ -34,7 +34,7 @@ func (in *ArangoLocalStorage) DeepCopyInto(out *ArangoLocalStorage) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -89,6 +89,49 @@ func (in *ArangoLocalStorageList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalStorageDrainStatus) DeepCopyInto(out *LocalStorageDrainStatus) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStorageDrainStatus.
+func (in *LocalStorageDrainStatus) DeepCopy() *LocalStorageDrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStorageDrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in LocalStorageDrainStatusList) DeepCopyInto(out *LocalStorageDrainStatusList) {
+	{
+		in := &in
+		*out = make(LocalStorageDrainStatusList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStorageDrainStatusList.
+func (in LocalStorageDrainStatusList) DeepCopy() LocalStorageDrainStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStorageDrainStatusList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalStorageSpec) DeepCopyInto(out *LocalStorageSpec) {
 	*out = *in
@@ -126,6 +169,13 @@ func (in *LocalStorageSpec) DeepCopy() *LocalStorageSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalStorageStatus) DeepCopyInto(out *LocalStorageStatus) {
 	*out = *in
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = make(LocalStorageDrainStatusList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
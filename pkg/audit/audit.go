@@ -0,0 +1,146 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package audit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/arangodb/kube-arangodb/pkg/metrics"
+)
+
+var (
+	recordsWritten = metrics.MustRegisterCounter("audit", "records_written", "Number of audit records written to the sink")
+	recordsFailed  = metrics.MustRegisterCounter("audit", "records_failed", "Number of audit records which could not be written to the sink")
+)
+
+// Phase of the audited action
+type Phase string
+
+const (
+	// PhaseStarted is recorded when the action is started
+	PhaseStarted Phase = "Started"
+	// PhaseFinished is recorded when the action finished successfully
+	PhaseFinished Phase = "Finished"
+	// PhaseFailed is recorded when the action failed permanently, was aborted or timed out.
+	// Errors which are retried are not recorded.
+	PhaseFailed Phase = "Failed"
+)
+
+// Source of the audited action
+type Source string
+
+const (
+	SourceDeployment  Source = "deployment"
+	SourceBackup      Source = "backup"
+	SourceReplication Source = "replication"
+)
+
+// Record is a single entry of the audit stream
+type Record struct {
+	// Time when the record was created
+	Time time.Time `json:"time"`
+	// Source is the part of the operator which executed the action
+	Source Source `json:"source"`
+	// Phase of the action
+	Phase Phase `json:"phase"`
+	// Action is the type of the action
+	Action string `json:"action"`
+	// ActionID is the unique ID of the action
+	ActionID string `json:"actionID,omitempty"`
+	// Namespace of the resource
+	Namespace string `json:"namespace,omitempty"`
+	// Deployment is the name of the ArangoDeployment
+	Deployment string `json:"deployment,omitempty"`
+	// Resource is the name of the ArangoBackup or ArangoDeploymentReplication
+	Resource string `json:"resource,omitempty"`
+	// Group of the member
+	Group string `json:"group,omitempty"`
+	// Member is the ID of the member
+	Member string `json:"member,omitempty"`
+	// Reason of the action
+	Reason string `json:"reason,omitempty"`
+	// Error describes why the action failed
+	Error string `json:"error,omitempty"`
+	// Duration of the action in milliseconds
+	Duration int64 `json:"durationMs,omitempty"`
+	// SpecHash is the checksum of the spec the action was executed for
+	SpecHash string `json:"specHash,omitempty"`
+}
+
+// SetDuration sets duration of the record to the time elapsed since the given start time
+func (r *Record) SetDuration(start time.Time) {
+	if start.IsZero() {
+		return
+	}
+	r.Duration = r.Time.Sub(start).Milliseconds()
+}
+
+var (
+	lock sync.RWMutex
+	sink Sink
+)
+
+// SetSink sets the sink audit records are written to. Audit is disabled when sink is nil.
+func SetSink(s Sink) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if sink != nil {
+		if err := sink.Close(); err != nil {
+			log.Warn().Err(err).Msg("Unable to close audit sink")
+		}
+	}
+
+	sink = s
+}
+
+// Enabled returns true if audit records are written.
+func Enabled() bool {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	return sink != nil
+}
+
+// Emit writes the record to the configured sink. Time of the record is set if missing.
+func Emit(r Record) {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	if sink == nil {
+		return
+	}
+
+	if r.Time.IsZero() {
+		r.Time = time.Now().UTC()
+	}
+
+	if err := sink.Write(r); err != nil {
+		recordsFailed.Inc()
+		log.Warn().Err(err).Str("action", r.Action).Msg("Unable to write audit record")
+		return
+	}
+
+	recordsWritten.Inc()
+}
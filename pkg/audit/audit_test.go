@@ -0,0 +1,149 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Emit_Disabled(t *testing.T) {
+	SetSink(nil)
+	assert.False(t, Enabled())
+
+	// Does not panic without sink
+	Emit(Record{Action: "Test"})
+}
+
+func Test_Emit_Writer(t *testing.T) {
+	var buffer bytes.Buffer
+	SetSink(NewWriterSink(&buffer))
+	defer SetSink(nil)
+
+	assert.True(t, Enabled())
+
+	start := time.Now().Add(-2 * time.Second)
+	r := Record{
+		Time:       time.Now(),
+		Source:     SourceDeployment,
+		Phase:      PhaseFinished,
+		Action:     "RotateMember",
+		Deployment: "test",
+		Member:     "PRMR-1",
+	}
+	r.SetDuration(start)
+	Emit(r)
+	Emit(Record{Source: SourceBackup, Phase: PhaseStarted, Action: "Create"})
+
+	lines := bytes.Split(bytes.TrimSpace(buffer.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	var got Record
+	require.NoError(t, json.Unmarshal(lines[0], &got))
+	assert.Equal(t, PhaseFinished, got.Phase)
+	assert.Equal(t, "PRMR-1", got.Member)
+	assert.True(t, got.Duration >= 2000)
+
+	require.NoError(t, json.Unmarshal(lines[1], &got))
+	assert.Equal(t, SourceBackup, got.Source)
+	assert.False(t, got.Time.IsZero())
+}
+
+func Test_Emit_Webhook(t *testing.T) {
+	received := make(chan Record, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		data, err := ioutil.ReadAll(req.Body)
+		require.NoError(t, err)
+
+		var r Record
+		require.NoError(t, json.Unmarshal(data, &r))
+		received <- r
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(SinkTypeWebhook, server.URL)
+	require.NoError(t, err)
+	SetSink(sink)
+	defer SetSink(nil)
+
+	Emit(Record{Source: SourceReplication, Phase: PhaseFailed, Action: "Switchover", Error: "failed"})
+
+	select {
+	case r := <-received:
+		assert.Equal(t, "Switchover", r.Action)
+		assert.Equal(t, "failed", r.Error)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "Webhook not called")
+	}
+}
+
+func Test_WebhookSink_Retry(t *testing.T) {
+	testCases := map[string]struct {
+		codes    []int
+		expected int32
+	}{
+		"temporary failure": {codes: []int{http.StatusServiceUnavailable, http.StatusOK}, expected: 2},
+		"rejected":          {codes: []int{http.StatusBadRequest, http.StatusOK}, expected: 1},
+		"unavailable":       {codes: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK}, expected: webhookAttempts},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				call := atomic.AddInt32(&calls, 1)
+				w.WriteHeader(testCase.codes[call-1])
+			}))
+			defer server.Close()
+
+			sink := NewWebhookSink(server.URL, server.Client())
+			require.NoError(t, sink.Write(Record{Source: SourceDeployment, Phase: PhaseStarted, Action: "AddMember"}))
+
+			// Close waits until queued records are delivered or given up
+			require.NoError(t, sink.Close())
+			assert.Equal(t, testCase.expected, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func Test_NewSink(t *testing.T) {
+	s, err := NewSink(SinkTypeNone, "")
+	require.NoError(t, err)
+	assert.Nil(t, s)
+
+	_, err = NewSink(SinkTypeFile, "")
+	require.Error(t, err)
+
+	_, err = NewSink(SinkTypeWebhook, "")
+	require.Error(t, err)
+
+	_, err = NewSink("unknown", "")
+	require.Error(t, err)
+}
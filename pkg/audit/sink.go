@@ -0,0 +1,194 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/retry"
+)
+
+// SinkType defines where audit records are written to
+type SinkType string
+
+const (
+	SinkTypeNone    SinkType = "none"
+	SinkTypeStdout  SinkType = "stdout"
+	SinkTypeFile    SinkType = "file"
+	SinkTypeWebhook SinkType = "webhook"
+
+	// webhookQueueSize is the number of records buffered before records are dropped
+	webhookQueueSize = 1024
+	// webhookTimeout is the timeout of a single webhook request
+	webhookTimeout = 10 * time.Second
+	// webhookRetryTimeout limits the time spent on delivery of a single record, so the queue is not blocked
+	webhookRetryTimeout = 30 * time.Second
+	// webhookAttempts is the maximum number of deliveries of a single record
+	webhookAttempts = 3
+)
+
+// Sink receives audit records
+type Sink interface {
+	Write(r Record) error
+	Close() error
+}
+
+// NewSink creates a sink of the given type. Target is the path of the file or the URL of the webhook.
+func NewSink(t SinkType, target string) (Sink, error) {
+	switch t {
+	case "", SinkTypeNone:
+		return nil, nil
+	case SinkTypeStdout:
+		return NewWriterSink(os.Stdout), nil
+	case SinkTypeFile:
+		if target == "" {
+			return nil, errors.Newf("file path is required for %s audit sink", t)
+		}
+		f, err := os.OpenFile(target, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return NewWriterSink(f), nil
+	case SinkTypeWebhook:
+		if target == "" {
+			return nil, errors.Newf("URL is required for %s audit sink", t)
+		}
+		return NewWebhookSink(target, &http.Client{Timeout: webhookTimeout}), nil
+	default:
+		return nil, errors.Newf("unknown audit sink %s", t)
+	}
+}
+
+// writerSink writes one JSON record per line
+type writerSink struct {
+	lock    sync.Mutex
+	writer  io.Writer
+	encoder *json.Encoder
+}
+
+// NewWriterSink creates a sink which writes one JSON record per line to the given writer.
+func NewWriterSink(w io.Writer) Sink {
+	return &writerSink{
+		writer:  w,
+		encoder: json.NewEncoder(w),
+	}
+}
+
+func (w *writerSink) Write(r Record) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return errors.WithStack(w.encoder.Encode(r))
+}
+
+func (w *writerSink) Close() error {
+	if c, ok := w.writer.(io.Closer); ok && w.writer != os.Stdout {
+		return c.Close()
+	}
+	return nil
+}
+
+// webhookSink posts records to the HTTP endpoint in background, so the reconciliation is not blocked
+type webhookSink struct {
+	url    string
+	client *http.Client
+
+	queue chan Record
+	done  chan struct{}
+	once  sync.Once
+}
+
+// NewWebhookSink creates a sink which posts every record as JSON to the given URL.
+func NewWebhookSink(url string, client *http.Client) Sink {
+	w := &webhookSink{
+		url:    url,
+		client: client,
+		queue:  make(chan Record, webhookQueueSize),
+		done:   make(chan struct{}),
+	}
+
+	go w.run()
+
+	return w
+}
+
+func (w *webhookSink) Write(r Record) error {
+	select {
+	case w.queue <- r:
+		return nil
+	default:
+		return errors.Newf("audit webhook queue is full, record dropped")
+	}
+}
+
+func (w *webhookSink) Close() error {
+	w.once.Do(func() {
+		close(w.queue)
+		<-w.done
+	})
+	return nil
+}
+
+func (w *webhookSink) run() {
+	defer close(w.done)
+
+	for r := range w.queue {
+		if err := retry.RetryAttempts(func() error {
+			return w.post(r)
+		}, webhookRetryTimeout, webhookAttempts); err != nil {
+			recordsFailed.Inc()
+			log.Warn().Err(err).Str("action", r.Action).Str("phase", string(r.Phase)).Msg("Unable to deliver audit record to webhook, record dropped")
+		}
+	}
+}
+
+func (w *webhookSink) post(r Record) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		err := errors.Newf("audit webhook returned status %d", resp.StatusCode)
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+			// Request is rejected, sending it again does not help
+			return retry.Permanent(err)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"encoding/json"
+	"time"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/audit"
+	"github.com/arangodb/kube-arangodb/pkg/backup/state"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+const (
+	auditActionCreate   = "BackupCreate"
+	auditActionDownload = "BackupDownload"
+	auditActionUpload   = "BackupUpload"
+	auditActionBackup   = "Backup"
+)
+
+// auditStateAction returns the action started in the given state
+func auditStateAction(s state.State) (string, bool) {
+	switch s {
+	case backupApi.ArangoBackupStateCreate:
+		return auditActionCreate, true
+	case backupApi.ArangoBackupStateDownload, backupApi.ArangoBackupStateDownloading:
+		return auditActionDownload, true
+	case backupApi.ArangoBackupStateUpload, backupApi.ArangoBackupStateUploading:
+		return auditActionUpload, true
+	default:
+		return "", false
+	}
+}
+
+// auditTransition returns the audited action and its phase for the transition between backup states
+func auditTransition(from, to state.State) (string, audit.Phase, bool) {
+	switch to {
+	case backupApi.ArangoBackupStateCreate, backupApi.ArangoBackupStateDownload, backupApi.ArangoBackupStateUpload:
+		action, _ := auditStateAction(to)
+		return action, audit.PhaseStarted, true
+	case backupApi.ArangoBackupStateReady:
+		if action, ok := auditStateAction(from); ok {
+			return action, audit.PhaseFinished, true
+		}
+	case backupApi.ArangoBackupStateFailed, backupApi.ArangoBackupStateDownloadError, backupApi.ArangoBackupStateUploadError:
+		if action, ok := auditStateAction(from); ok {
+			return action, audit.PhaseFailed, true
+		}
+		return auditActionBackup, audit.PhaseFailed, true
+	}
+
+	return "", "", false
+}
+
+// auditStateChange writes the audit record for the state transition of the backup
+func auditStateChange(b *backupApi.ArangoBackup, status *backupApi.ArangoBackupStatus) {
+	if !audit.Enabled() {
+		return
+	}
+
+	action, phase, ok := auditTransition(b.Status.State, status.State)
+	if !ok {
+		return
+	}
+
+	r := audit.Record{
+		Time:       time.Now().UTC(),
+		Source:     audit.SourceBackup,
+		Phase:      phase,
+		Action:     action,
+		Namespace:  b.GetNamespace(),
+		Deployment: b.Spec.Deployment.Name,
+		Resource:   b.GetName(),
+		Reason:     string(status.State),
+	}
+
+	if phase == audit.PhaseFailed {
+		r.Error = status.Message
+	}
+
+	if phase != audit.PhaseStarted {
+		r.SetDuration(b.Status.Time.Time)
+	}
+
+	if data, err := json.Marshal(b.Spec); err == nil {
+		r.SpecHash = util.SHA256(data)
+	}
+
+	audit.Emit(r)
+}
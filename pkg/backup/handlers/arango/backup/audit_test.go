@@ -0,0 +1,61 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"testing"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/audit"
+	"github.com/arangodb/kube-arangodb/pkg/backup/state"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Audit_Transition(t *testing.T) {
+	type testCase struct {
+		from, to state.State
+		action   string
+		phase    audit.Phase
+		audited  bool
+	}
+
+	cases := map[string]testCase{
+		"create started":       {backupApi.ArangoBackupStateScheduled, backupApi.ArangoBackupStateCreate, auditActionCreate, audit.PhaseStarted, true},
+		"create finished":      {backupApi.ArangoBackupStateCreate, backupApi.ArangoBackupStateReady, auditActionCreate, audit.PhaseFinished, true},
+		"create failed":        {backupApi.ArangoBackupStateCreate, backupApi.ArangoBackupStateFailed, auditActionCreate, audit.PhaseFailed, true},
+		"download finished":    {backupApi.ArangoBackupStateDownloading, backupApi.ArangoBackupStateReady, auditActionDownload, audit.PhaseFinished, true},
+		"download error":       {backupApi.ArangoBackupStateDownloading, backupApi.ArangoBackupStateDownloadError, auditActionDownload, audit.PhaseFailed, true},
+		"upload started":       {backupApi.ArangoBackupStateReady, backupApi.ArangoBackupStateUpload, auditActionUpload, audit.PhaseStarted, true},
+		"upload error":         {backupApi.ArangoBackupStateUploading, backupApi.ArangoBackupStateUploadError, auditActionUpload, audit.PhaseFailed, true},
+		"pending failed":       {backupApi.ArangoBackupStatePending, backupApi.ArangoBackupStateFailed, auditActionBackup, audit.PhaseFailed, true},
+		"scheduled":            {backupApi.ArangoBackupStatePending, backupApi.ArangoBackupStateScheduled, "", "", false},
+		"upload error recover": {backupApi.ArangoBackupStateUploadError, backupApi.ArangoBackupStateReady, "", "", false},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			action, phase, ok := auditTransition(c.from, c.to)
+			require.Equal(t, c.audited, ok)
+			require.Equal(t, c.action, action)
+			require.Equal(t, c.phase, phase)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/arangodb/go-driver"
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	database "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/backup/logical"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/rs/zerolog/log"
+	core "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+// catalogJobName returns the name of the job which lists backups of the repository used by the deployment
+func catalogJobName(deployment *database.ArangoDeployment, repositoryURL string) string {
+	return fmt.Sprintf("%s-catalog-%s", deployment.GetName(), util.SHA256FromString(repositoryURL)[:8])
+}
+
+// deploymentRepositories returns upload repositories used by backups of the deployment
+func deploymentRepositories(deployment *database.ArangoDeployment, backups []backupApi.ArangoBackup) []backupApi.ArangoBackupSpecOperation {
+	repositories := map[string]backupApi.ArangoBackupSpecOperation{}
+
+	for _, backup := range backups {
+		if backup.Spec.Deployment.Name != deployment.GetName() {
+			continue
+		}
+
+		upload := backup.Spec.Upload
+		if upload == nil || upload.RepositoryURL == "" || upload.CredentialsSecretName == "" {
+			continue
+		}
+
+		if _, ok := repositories[upload.RepositoryURL]; !ok {
+			repositories[upload.RepositoryURL] = *upload
+		}
+	}
+
+	result := make([]backupApi.ArangoBackupSpecOperation, 0, len(repositories))
+	for _, repository := range repositories {
+		result = append(result, repository)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].RepositoryURL < result[j].RepositoryURL
+	})
+
+	return result
+}
+
+// isBackupKnown returns true if backup with given ID is already represented by an ArangoBackup of the deployment
+func isBackupKnown(deployment *database.ArangoDeployment, id string, backups []backupApi.ArangoBackup) bool {
+	for _, backup := range backups {
+		if backup.Spec.Deployment.Name != deployment.GetName() {
+			continue
+		}
+
+		if download := backup.Spec.Download; download != nil && download.ID == id {
+			return true
+		}
+
+		if backup.Status.Backup != nil && backup.Status.Backup.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// refreshDeploymentRepositories discovers backups stored in the upload repositories of the deployment
+func (h *handler) refreshDeploymentRepositories(deployment *database.ArangoDeployment, backups []backupApi.ArangoBackup,
+	existingBackups map[driver.BackupID]driver.BackupMeta) error {
+	for _, repository := range deploymentRepositories(deployment, backups) {
+		if err := h.refreshDeploymentRepository(deployment, repository, backups, existingBackups); err != nil {
+			log.Warn().Err(err).Str("repository", repository.RepositoryURL).Msgf("Unable to refresh repository backups")
+		}
+	}
+
+	return nil
+}
+
+func (h *handler) refreshDeploymentRepository(deployment *database.ArangoDeployment, repository backupApi.ArangoBackupSpecOperation,
+	backups []backupApi.ArangoBackup, existingBackups map[driver.BackupID]driver.BackupMeta) error {
+	jobs := h.kubeClient.BatchV1().Jobs(deployment.Namespace)
+	name := catalogJobName(deployment, repository.RepositoryURL)
+
+	job, err := jobs.Get(name, meta.GetOptions{})
+	if err != nil {
+		if !apiErrors.IsNotFound(err) {
+			return err
+		}
+
+		if err := logical.EnsureRCloneConfigSecret(h.kubeClient.CoreV1().Secrets(deployment.Namespace),
+			logical.RCloneConfigSecretName(name), repository.CredentialsSecretName, deployment.AsOwner()); err != nil {
+			return err
+		}
+
+		_, err = jobs.Create(logical.NewListJob(name, deployment, &repository))
+		return err
+	}
+
+	switch state, message := logical.GetJobState(job); state {
+	case logical.JobStateRunning:
+		return nil
+	case logical.JobStateFailed:
+		log.Warn().Str("message", message).Msgf("Listing of repository %s failed", repository.RepositoryURL)
+		return h.deleteJob(deployment.Namespace, name)
+	}
+
+	output, err := h.getJobOutput(deployment.Namespace, name)
+	if err != nil {
+		return err
+	}
+
+	entries, err := logical.ParseListOutput(output)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		// Backups present in the database are imported from there
+		if _, ok := existingBackups[driver.BackupID(entry.Name)]; ok {
+			continue
+		}
+
+		if isBackupKnown(deployment, entry.Name, backups) {
+			continue
+		}
+
+		if err := h.createRemoteBackup(deployment, repository, entry); err != nil {
+			return err
+		}
+	}
+
+	// Job is recreated during next refresh
+	return h.deleteJob(deployment.Namespace, name)
+}
+
+func (h *handler) createRemoteBackup(deployment *database.ArangoDeployment, repository backupApi.ArangoBackupSpecOperation, entry logical.RepositoryEntry) error {
+	backup := &backupApi.ArangoBackup{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      fmt.Sprintf("backup-%s", uuid.NewUUID()),
+			Namespace: deployment.Namespace,
+		},
+		Spec: backupApi.ArangoBackupSpec{
+			Deployment: backupApi.ArangoBackupSpecDeployment{
+				Name: deployment.Name,
+			},
+		},
+	}
+
+	if _, err := h.client.BackupV1().ArangoBackups(backup.Namespace).Create(backup); err != nil {
+		return err
+	}
+
+	backup.Status = *updateStatus(backup,
+		updateStatusState(backupApi.ArangoBackupStateRemote, "Backup found in repository %s", repository.RepositoryURL),
+		updateStatusAvailable(false),
+		updateStatusRemoteBackup(entry, repository))
+
+	return h.updateBackupStatus(backup)
+}
+
+func (h *handler) getJobOutput(namespace, name string) ([]byte, error) {
+	pods, err := h.kubeClient.CoreV1().Pods(namespace).List(meta.ListOptions{
+		LabelSelector: fmt.Sprintf("job-name=%s", name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != core.PodSucceeded {
+			continue
+		}
+
+		return h.kubeClient.CoreV1().Pods(namespace).GetLogs(pod.Name, &core.PodLogOptions{
+			Container: logical.TransferContainerName,
+		}).DoRaw()
+	}
+
+	return nil, errors.Newf("succeeded pod of job %s not found", name)
+}
+
+func (h *handler) deleteJob(namespace, name string) error {
+	propagation := meta.DeletePropagationBackground
+	err := h.kubeClient.BatchV1().Jobs(namespace).Delete(name, &meta.DeleteOptions{
+		PropagationPolicy: &propagation,
+	})
+	if err != nil && !apiErrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/backup/logical"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_Catalog_DeploymentRepositories(t *testing.T) {
+	// Arrange
+	a, deployment := newObjectSet(backupApi.ArangoBackupStateReady)
+	a.Spec.Upload = &backupApi.ArangoBackupSpecOperation{RepositoryURL: "s3:/b", CredentialsSecretName: "creds"}
+
+	b := newArangoBackup(deployment.Name, deployment.Namespace, "b", backupApi.ArangoBackupStateReady)
+	b.Spec.Upload = &backupApi.ArangoBackupSpecOperation{RepositoryURL: "s3:/a", CredentialsSecretName: "creds"}
+
+	c := newArangoBackup(deployment.Name, deployment.Namespace, "c", backupApi.ArangoBackupStateReady)
+	c.Spec.Upload = &backupApi.ArangoBackupSpecOperation{RepositoryURL: "s3:/a", CredentialsSecretName: "creds"}
+
+	other := newArangoBackup("other", deployment.Namespace, "other", backupApi.ArangoBackupStateReady)
+	other.Spec.Upload = &backupApi.ArangoBackupSpecOperation{RepositoryURL: "s3:/c", CredentialsSecretName: "creds"}
+
+	noCredentials := newArangoBackup(deployment.Name, deployment.Namespace, "d", backupApi.ArangoBackupStateReady)
+	noCredentials.Spec.Upload = &backupApi.ArangoBackupSpecOperation{RepositoryURL: "s3:/d"}
+
+	// Act
+	repositories := deploymentRepositories(deployment, []backupApi.ArangoBackup{*a, *b, *c, *other, *noCredentials})
+
+	// Assert
+	require.Len(t, repositories, 2)
+	require.Equal(t, "s3:/a", repositories[0].RepositoryURL)
+	require.Equal(t, "s3:/b", repositories[1].RepositoryURL)
+}
+
+func Test_Catalog_IsBackupKnown(t *testing.T) {
+	// Arrange
+	a, deployment := newObjectSet(backupApi.ArangoBackupStateReady)
+	a.Status.Backup = &backupApi.ArangoBackupDetails{ID: "a"}
+
+	b := newArangoBackup(deployment.Name, deployment.Namespace, "b", backupApi.ArangoBackupStateScheduled)
+	b.Spec.Download = &backupApi.ArangoBackupSpecDownload{ID: "b"}
+
+	backups := []backupApi.ArangoBackup{*a, *b}
+
+	// Assert
+	require.True(t, isBackupKnown(deployment, "a", backups))
+	require.True(t, isBackupKnown(deployment, "b", backups))
+	require.False(t, isBackupKnown(deployment, "c", backups))
+}
+
+func Test_Catalog_RefreshRepository_CreatesJob(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateReady)
+	repository := backupApi.ArangoBackupSpecOperation{RepositoryURL: "s3:/backups", CredentialsSecretName: "creds"}
+	obj.Spec.Upload = &repository
+
+	_, err := handler.kubeClient.CoreV1().Secrets(deployment.Namespace).Create(&core.Secret{
+		ObjectMeta: meta.ObjectMeta{Name: "creds"},
+		Data: map[string][]byte{
+			constants.SecretKeyToken: []byte(`{"s3": {"type": "s3"}}`),
+		},
+	})
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, handler.refreshDeploymentRepository(deployment, repository, []backupApi.ArangoBackup{*obj}, nil))
+
+	// Assert
+	name := catalogJobName(deployment, repository.RepositoryURL)
+
+	_, err = handler.kubeClient.BatchV1().Jobs(deployment.Namespace).Get(name, meta.GetOptions{})
+	require.NoError(t, err)
+
+	secret, err := handler.kubeClient.CoreV1().Secrets(deployment.Namespace).Get(logical.RCloneConfigSecretName(name), meta.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "[s3]\ntype = s3\n\n", string(secret.Data[logical.RCloneConfigFileName]))
+}
+
+func Test_Catalog_CreateRemoteBackup(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	_, deployment := newObjectSet(backupApi.ArangoBackupStateReady)
+	repository := backupApi.ArangoBackupSpecOperation{RepositoryURL: "s3:/backups", CredentialsSecretName: "creds"}
+	entry := logical.RepositoryEntry{Name: "dump-1", IsDir: true, ModTime: time.Now()}
+
+	// Act
+	require.NoError(t, handler.createRemoteBackup(deployment, repository, entry))
+
+	// Assert
+	backups, err := handler.client.BackupV1().ArangoBackups(deployment.Namespace).List(meta.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, backups.Items, 1)
+
+	status := backups.Items[0].Status
+	require.Equal(t, backupApi.ArangoBackupStateRemote, status.State)
+	require.False(t, status.Available)
+	require.NotNil(t, status.Backup)
+	require.Equal(t, "dump-1", status.Backup.ID)
+	require.Equal(t, "s3:/backups", status.Backup.RepositoryURL)
+	require.NotNil(t, status.Backup.Uploaded)
+	require.True(t, *status.Backup.Uploaded)
+	// Backup is imported only once it is downloaded
+	require.Nil(t, status.Backup.Imported)
+}
@@ -73,11 +73,20 @@ func (h *handler) finalizeBackup(backup *backupApi.ArangoBackup) error {
 	lock.Lock()
 	defer lock.Unlock()
 
+	if backup.Spec.IsLogical() {
+		return h.finalizeLogicalBackup(backup)
+	}
+
 	if backup.Status.Backup == nil {
 		// No details passed, object can be removed
 		return nil
 	}
 
+	if backup.Status.State == backupApi.ArangoBackupStateRemote {
+		// Backup exists only in the repository
+		return nil
+	}
+
 	deployment, err := h.getArangoDeploymentObject(backup)
 	if err != nil {
 		// If deployment is not found we do not have to delete backup in database
@@ -31,6 +31,7 @@ import (
 
 	"github.com/arangodb/kube-arangodb/pkg/apis/backup"
 	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 
 	"github.com/arangodb/go-driver"
 	"github.com/arangodb/kube-arangodb/pkg/backup/utils"
@@ -40,6 +41,7 @@ import (
 
 	"github.com/arangodb/kube-arangodb/pkg/backup/operator/event"
 	"github.com/arangodb/kube-arangodb/pkg/backup/operator/operation"
+	"github.com/arangodb/kube-arangodb/pkg/notification"
 
 	"k8s.io/client-go/kubernetes"
 
@@ -77,6 +79,8 @@ type handler struct {
 	arangoClientFactory ArangoClientFactory
 	arangoClientTimeout time.Duration
 
+	podExecutor k8sutil.PodExecutor
+
 	operator operator.Operator
 }
 
@@ -143,7 +147,7 @@ func (h *handler) refreshDeployment(deployment *database.ArangoDeployment) error
 		}
 	}
 
-	return nil
+	return h.refreshDeploymentRepositories(deployment, backups.Items, existingBackups)
 }
 
 func (h *handler) refreshDeploymentBackup(deployment *database.ArangoDeployment, backupMeta driver.BackupMeta, backups []backupApi.ArangoBackup) error {
@@ -331,6 +335,13 @@ func (h *handler) Handle(item operation.Item) error {
 
 	// Log message about state change
 	if b.Status.State != status.State {
+		auditStateChange(b, status)
+
+		switch status.State {
+		case backupApi.ArangoBackupStateFailed, backupApi.ArangoBackupStateDownloadError, backupApi.ArangoBackupStateUploadError:
+			notification.Notify(k8sutil.NewBackupFailedEvent(b, string(status.State), status.Message))
+		}
+
 		if status.State == backupApi.ArangoBackupStateFailed {
 			h.eventRecorder.Warning(b, StateChange, "Transiting from %s to %s with error: %s",
 				b.Status.State,
@@ -0,0 +1,283 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/backup/logical"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// hookJobRole is the role of the jobs which run backup hooks
+	hookJobRole = "backup-hook"
+
+	// hookMessageLimit limits the size of the hook output kept in the status
+	hookMessageLimit = 256
+)
+
+// hooksResult describes the progress of the hooks of a single phase
+type hooksResult struct {
+	// Finished is true when all hooks of the phase are done
+	Finished bool
+	// Failed keeps the status of the hook which failed with the Fail policy
+	Failed *backupApi.ArangoBackupHookStatus
+}
+
+func hookJobName(backup *backupApi.ArangoBackup, phase backupApi.ArangoBackupHookPhase, hook backupApi.ArangoBackupHook) string {
+	return k8sutil.FixupResourceName(fmt.Sprintf("%s-%s-%s", backup.GetName(), phase, hook.Name))
+}
+
+func updateStatusHooks(hooks backupApi.ArangoBackupHookStatusList) updateStatusFunc {
+	return func(status *backupApi.ArangoBackupStatus) {
+		status.Hooks = hooks
+	}
+}
+
+// runBackupHooks executes hooks of the phase one after another. Exec hooks are finished
+// within a single call, job hooks are started and checked in the next calls.
+func (h *handler) runBackupHooks(backup *backupApi.ArangoBackup, phase backupApi.ArangoBackupHookPhase) (backupApi.ArangoBackupHookStatusList, hooksResult, error) {
+	statuses := backup.Status.Hooks.DeepCopy()
+
+	for _, hook := range backup.Spec.Hooks.Get(phase) {
+		status, ok := statuses.Get(phase, hook.Name)
+		if !ok {
+			s, err := h.startBackupHook(backup, phase, hook)
+			if err != nil {
+				return nil, hooksResult{}, err
+			}
+			status = s
+		} else if status.State == backupApi.ArangoBackupHookStateRunning {
+			s, err := h.checkBackupHook(backup, phase, hook, status)
+			if err != nil {
+				return nil, hooksResult{}, err
+			}
+			status = s
+		}
+
+		statuses = statuses.Update(status)
+
+		switch status.State {
+		case backupApi.ArangoBackupHookStateRunning:
+			return statuses, hooksResult{}, nil
+		case backupApi.ArangoBackupHookStateFailed:
+			if hook.GetFailurePolicy() == backupApi.ArangoBackupHookFailurePolicyFail {
+				return statuses, hooksResult{Finished: true, Failed: &status}, nil
+			}
+		}
+	}
+
+	return statuses, hooksResult{Finished: true}, nil
+}
+
+func (h *handler) startBackupHook(backup *backupApi.ArangoBackup, phase backupApi.ArangoBackupHookPhase, hook backupApi.ArangoBackupHook) (backupApi.ArangoBackupHookStatus, error) {
+	status := backupApi.ArangoBackupHookStatus{
+		Name:      hook.Name,
+		Phase:     phase,
+		State:     backupApi.ArangoBackupHookStateRunning,
+		StartTime: meta.Now(),
+	}
+
+	if hook.Exec != nil {
+		output, err := h.execBackupHook(backup, hook)
+		if err != nil {
+			return finishBackupHook(status, backupApi.ArangoBackupHookStateFailed, err.Error()), nil
+		}
+
+		return finishBackupHook(status, backupApi.ArangoBackupHookStateSucceeded, output), nil
+	}
+
+	job := hookJob(backup, phase, hook)
+
+	if _, err := h.kubeClient.BatchV1().Jobs(backup.Namespace).Create(job); err != nil {
+		if !apiErrors.IsAlreadyExists(err) {
+			return status, newTemporaryError(err)
+		}
+	}
+
+	status.Message = fmt.Sprintf("job %s started", job.GetName())
+
+	return status, nil
+}
+
+func (h *handler) checkBackupHook(backup *backupApi.ArangoBackup, phase backupApi.ArangoBackupHookPhase, hook backupApi.ArangoBackupHook,
+	status backupApi.ArangoBackupHookStatus) (backupApi.ArangoBackupHookStatus, error) {
+	if hook.Job == nil {
+		// Exec hooks are never left running, it can happen only when hook definition changed
+		return finishBackupHook(status, backupApi.ArangoBackupHookStateFailed, "hook definition changed during execution"), nil
+	}
+
+	name := hookJobName(backup, phase, hook)
+
+	job, err := h.kubeClient.BatchV1().Jobs(backup.Namespace).Get(name, meta.GetOptions{})
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return finishBackupHook(status, backupApi.ArangoBackupHookStateFailed, fmt.Sprintf("job %s does not exist", name)), nil
+		}
+
+		return status, newTemporaryError(err)
+	}
+
+	switch state, message := logical.GetJobState(job); state {
+	case logical.JobStateSucceeded:
+		return finishBackupHook(status, backupApi.ArangoBackupHookStateSucceeded, message), nil
+	case logical.JobStateFailed:
+		return finishBackupHook(status, backupApi.ArangoBackupHookStateFailed, fmt.Sprintf("job %s failed: %s", name, message)), nil
+	}
+
+	if time.Since(status.StartTime.Time) > hook.GetTimeout() {
+		if err := h.deleteJob(backup.Namespace, name); err != nil {
+			return status, newTemporaryError(err)
+		}
+
+		return finishBackupHook(status, backupApi.ArangoBackupHookStateFailed, fmt.Sprintf("job %s timed out after %s", name, hook.GetTimeout())), nil
+	}
+
+	return status, nil
+}
+
+// execBackupHook runs command in all running pods selected by the hook
+func (h *handler) execBackupHook(backup *backupApi.ArangoBackup, hook backupApi.ArangoBackupHook) (string, error) {
+	if h.podExecutor == nil {
+		return "", errors.Newf("exec hooks are not supported")
+	}
+
+	selector, err := meta.LabelSelectorAsSelector(hook.Exec.Selector)
+	if err != nil {
+		return "", err
+	}
+
+	pods, err := h.kubeClient.CoreV1().Pods(backup.Namespace).List(meta.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(pods.Items) == 0 {
+		return "", errors.Newf("no pods match the selector %s", selector.String())
+	}
+
+	var running []core.Pod
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != core.PodRunning || len(pod.Spec.Containers) == 0 {
+			continue
+		}
+
+		running = append(running, pod)
+	}
+
+	if len(running) == 0 {
+		return "", errors.Newf("no running pods match the selector %s", selector.String())
+	}
+
+	// Pods are executed in parallel, so the hook blocks the worker at most for a single timeout
+	outputs := make([]string, len(running))
+	errs := make([]error, len(running))
+
+	var wg sync.WaitGroup
+
+	for id := range running {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+
+			pod := running[id]
+
+			container := hook.Exec.Container
+			if container == "" {
+				container = pod.Spec.Containers[0].Name
+			}
+
+			output, err := h.podExecutor.Exec(pod.Namespace, pod.Name, container, hook.Exec.Command, hook.GetTimeout())
+			if err != nil {
+				errs[id] = errors.Wrapf(err, "pod %s", pod.Name)
+				return
+			}
+
+			outputs[id] = strings.TrimSpace(output)
+		}(id)
+	}
+
+	wg.Wait()
+
+	var result []string
+
+	for id := range running {
+		if errs[id] != nil {
+			return "", errs[id]
+		}
+
+		if outputs[id] != "" {
+			result = append(result, outputs[id])
+		}
+	}
+
+	return strings.Join(result, "\n"), nil
+}
+
+func finishBackupHook(status backupApi.ArangoBackupHookStatus, state backupApi.ArangoBackupHookState, message string) backupApi.ArangoBackupHookStatus {
+	now := meta.Now()
+
+	if len(message) > hookMessageLimit {
+		message = message[:hookMessageLimit]
+	}
+
+	status.State = state
+	status.Message = message
+	status.CompletionTime = &now
+
+	return status
+}
+
+func hookJob(backup *backupApi.ArangoBackup, phase backupApi.ArangoBackupHookPhase, hook backupApi.ArangoBackupHook) *batch.Job {
+	labels := k8sutil.LabelsForDeployment(backup.Spec.Deployment.Name, hookJobRole)
+
+	spec := hook.Job.DeepCopy()
+	if spec.Template.Spec.RestartPolicy == "" {
+		spec.Template.Spec.RestartPolicy = core.RestartPolicyNever
+	}
+
+	if d := int64(hook.GetTimeout().Seconds()); spec.ActiveDeadlineSeconds == nil && d > 0 {
+		spec.ActiveDeadlineSeconds = &d
+	}
+
+	return &batch.Job{
+		ObjectMeta: meta.ObjectMeta{
+			Name:            hookJobName(backup, phase, hook),
+			Namespace:       backup.Namespace,
+			Labels:          labels,
+			OwnerReferences: []meta.OwnerReference{backup.AsOwner()},
+		},
+		Spec: *spec,
+	}
+}
@@ -0,0 +1,378 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/backup/operator/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/stretchr/testify/require"
+	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type mockPodExecutor struct {
+	lock  sync.Mutex
+	calls []string
+	err   error
+}
+
+func (m *mockPodExecutor) Exec(namespace, pod, container string, command []string, timeout time.Duration) (string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.calls = append(m.calls, pod)
+	if m.err != nil {
+		return "", m.err
+	}
+	return "ok", nil
+}
+
+func newHookFakeHandler(err error) (*handler, *mockPodExecutor) {
+	handler, _ := newErrorsFakeHandler(mockErrorsArangoClientBackup{})
+	executor := &mockPodExecutor{err: err}
+	handler.podExecutor = executor
+	return handler, executor
+}
+
+func createHookPod(t *testing.T, h *handler, namespace string) {
+	createHookPodWithPhase(t, h, namespace, "app", core.PodRunning)
+}
+
+func createHookPodWithPhase(t *testing.T, h *handler, namespace, name string, phase core.PodPhase) {
+	_, err := h.kubeClient.CoreV1().Pods(namespace).Create(&core.Pod{
+		ObjectMeta: meta.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"app": "writer"},
+		},
+		Spec: core.PodSpec{
+			Containers: []core.Container{{Name: "main"}},
+		},
+		Status: core.PodStatus{
+			Phase: phase,
+		},
+	})
+	require.NoError(t, err)
+}
+
+func newExecHook(name string, policy backupApi.ArangoBackupHookFailurePolicy) backupApi.ArangoBackupHook {
+	return backupApi.ArangoBackupHook{
+		Name: name,
+		Exec: &backupApi.ArangoBackupHookExec{
+			Selector: &meta.LabelSelector{MatchLabels: map[string]string{"app": "writer"}},
+			Command:  []string{"quiesce"},
+		},
+		FailurePolicy: &policy,
+	}
+}
+
+func Test_Hooks_Pre_Exec_Success(t *testing.T) {
+	// Arrange
+	handler, executor := newHookFakeHandler(nil)
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateCreate)
+	obj.Spec.Hooks = &backupApi.ArangoBackupSpecHooks{
+		Pre: []backupApi.ArangoBackupHook{newExecHook("quiesce", backupApi.ArangoBackupHookFailurePolicyFail)},
+	}
+
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+	createHookPod(t, handler, obj.Namespace)
+
+	// Act
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj := refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateCreate, false)
+	require.Equal(t, []string{"app"}, executor.calls)
+
+	status, ok := newObj.Status.Hooks.Get(backupApi.ArangoBackupHookPhasePre, "quiesce")
+	require.True(t, ok)
+	require.Equal(t, backupApi.ArangoBackupHookStateSucceeded, status.State)
+	require.Equal(t, "ok", status.Message)
+	require.NotNil(t, status.CompletionTime)
+
+	// Act
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj = refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateReady, true)
+	require.Len(t, executor.calls, 1)
+}
+
+func Test_Hooks_Pre_Exec_Failed(t *testing.T) {
+	// Arrange
+	handler, _ := newHookFakeHandler(errors.Newf("connection refused"))
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateCreate)
+	obj.Spec.Hooks = &backupApi.ArangoBackupSpecHooks{
+		Pre:  []backupApi.ArangoBackupHook{newExecHook("quiesce", backupApi.ArangoBackupHookFailurePolicyFail)},
+		Post: []backupApi.ArangoBackupHook{newExecHook("resume", backupApi.ArangoBackupHookFailurePolicyFail)},
+	}
+
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+	createHookPod(t, handler, obj.Namespace)
+
+	// Act
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj := refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateFailed, false)
+
+	status, ok := newObj.Status.Hooks.Get(backupApi.ArangoBackupHookPhasePre, "quiesce")
+	require.True(t, ok)
+	require.Equal(t, backupApi.ArangoBackupHookStateFailed, status.State)
+
+	// Act
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj = refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateFailed, false)
+
+	_, ok = newObj.Status.Hooks.Get(backupApi.ArangoBackupHookPhasePost, "resume")
+	require.True(t, ok)
+}
+
+func Test_Hooks_Pre_Exec_Ignored(t *testing.T) {
+	// Arrange
+	handler, _ := newHookFakeHandler(errors.Newf("connection refused"))
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateCreate)
+	obj.Spec.Hooks = &backupApi.ArangoBackupSpecHooks{
+		Pre: []backupApi.ArangoBackupHook{newExecHook("quiesce", backupApi.ArangoBackupHookFailurePolicyIgnore)},
+	}
+
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+	createHookPod(t, handler, obj.Namespace)
+
+	// Act
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj := refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateReady, true)
+
+	status, ok := newObj.Status.Hooks.Get(backupApi.ArangoBackupHookPhasePre, "quiesce")
+	require.True(t, ok)
+	require.Equal(t, backupApi.ArangoBackupHookStateFailed, status.State)
+}
+
+func Test_Hooks_Pre_Exec_AllPods(t *testing.T) {
+	// Arrange
+	handler, executor := newHookFakeHandler(nil)
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateCreate)
+	obj.Spec.Hooks = &backupApi.ArangoBackupSpecHooks{
+		Pre: []backupApi.ArangoBackupHook{newExecHook("quiesce", backupApi.ArangoBackupHookFailurePolicyFail)},
+	}
+
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+	createHookPodWithPhase(t, handler, obj.Namespace, "app-1", core.PodRunning)
+	createHookPodWithPhase(t, handler, obj.Namespace, "app-2", core.PodRunning)
+	createHookPodWithPhase(t, handler, obj.Namespace, "app-3", core.PodPending)
+
+	// Act
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj := refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateCreate, false)
+
+	sort.Strings(executor.calls)
+	require.Equal(t, []string{"app-1", "app-2"}, executor.calls)
+
+	status, ok := newObj.Status.Hooks.Get(backupApi.ArangoBackupHookPhasePre, "quiesce")
+	require.True(t, ok)
+	require.Equal(t, backupApi.ArangoBackupHookStateSucceeded, status.State)
+	require.Equal(t, "ok\nok", status.Message)
+}
+
+func Test_Hooks_Pre_Exec_NoRunningPods(t *testing.T) {
+	// Arrange
+	handler, executor := newHookFakeHandler(nil)
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateCreate)
+	obj.Spec.Hooks = &backupApi.ArangoBackupSpecHooks{
+		Pre: []backupApi.ArangoBackupHook{newExecHook("quiesce", backupApi.ArangoBackupHookFailurePolicyFail)},
+	}
+
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+	createHookPodWithPhase(t, handler, obj.Namespace, "app", core.PodPending)
+
+	// Act
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj := refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateFailed, false)
+	require.Empty(t, executor.calls)
+
+	status, ok := newObj.Status.Hooks.Get(backupApi.ArangoBackupHookPhasePre, "quiesce")
+	require.True(t, ok)
+	require.Equal(t, backupApi.ArangoBackupHookStateFailed, status.State)
+}
+
+func Test_Hooks_Post_Exec_Failed(t *testing.T) {
+	// Arrange
+	handler, _ := newHookFakeHandler(errors.Newf("connection refused"))
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateCreate)
+	obj.Spec.Hooks = &backupApi.ArangoBackupSpecHooks{
+		Post: []backupApi.ArangoBackupHook{newExecHook("resume", backupApi.ArangoBackupHookFailurePolicyFail)},
+	}
+
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+	createHookPod(t, handler, obj.Namespace)
+
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	newObj := refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateReady, true)
+
+	// Act
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj = refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateFailed, false)
+
+	status, ok := newObj.Status.Hooks.Get(backupApi.ArangoBackupHookPhasePost, "resume")
+	require.True(t, ok)
+	require.Equal(t, backupApi.ArangoBackupHookStateFailed, status.State)
+}
+
+func Test_Hooks_Post_Job(t *testing.T) {
+	// Arrange
+	handler, _ := newHookFakeHandler(nil)
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateCreate)
+	hook := backupApi.ArangoBackupHook{
+		Name: "resume",
+		Job: &batch.JobSpec{
+			Template: core.PodTemplateSpec{
+				Spec: core.PodSpec{
+					Containers: []core.Container{{Name: "resume", Image: "busybox"}},
+				},
+			},
+		},
+	}
+	obj.Spec.Hooks = &backupApi.ArangoBackupSpecHooks{
+		Post: []backupApi.ArangoBackupHook{hook},
+	}
+
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	newObj := refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateReady, true)
+
+	// Act
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj = refreshArangoBackup(t, handler, obj)
+	status, ok := newObj.Status.Hooks.Get(backupApi.ArangoBackupHookPhasePost, "resume")
+	require.True(t, ok)
+	require.Equal(t, backupApi.ArangoBackupHookStateRunning, status.State)
+
+	jobs := handler.kubeClient.BatchV1().Jobs(obj.Namespace)
+	job, err := jobs.Get(hookJobName(obj, backupApi.ArangoBackupHookPhasePost, hook), meta.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, core.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
+
+	// Act
+	job.Status.Conditions = append(job.Status.Conditions, batch.JobCondition{
+		Type:   batch.JobComplete,
+		Status: core.ConditionTrue,
+	})
+	_, err = jobs.UpdateStatus(job)
+	require.NoError(t, err)
+
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj = refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateReady, true)
+	status, ok = newObj.Status.Hooks.Get(backupApi.ArangoBackupHookPhasePost, "resume")
+	require.True(t, ok)
+	require.Equal(t, backupApi.ArangoBackupHookStateSucceeded, status.State)
+}
+
+func Test_Hooks_Job_Timeout(t *testing.T) {
+	// Arrange
+	handler, _ := newHookFakeHandler(nil)
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateCreate)
+	hook := backupApi.ArangoBackupHook{
+		Name:    "quiesce",
+		Job:     &batch.JobSpec{},
+		Timeout: &meta.Duration{Duration: time.Minute},
+	}
+	obj.Spec.Hooks = &backupApi.ArangoBackupSpecHooks{
+		Pre: []backupApi.ArangoBackupHook{hook},
+	}
+	obj.Status.Hooks = backupApi.ArangoBackupHookStatusList{
+		{
+			Name:      hook.Name,
+			Phase:     backupApi.ArangoBackupHookPhasePre,
+			State:     backupApi.ArangoBackupHookStateRunning,
+			StartTime: meta.NewTime(time.Now().Add(-2 * time.Minute)),
+		},
+	}
+
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+
+	_, err := handler.kubeClient.BatchV1().Jobs(obj.Namespace).Create(hookJob(obj, backupApi.ArangoBackupHookPhasePre, hook))
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj := refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateFailed, false)
+
+	status, ok := newObj.Status.Hooks.Get(backupApi.ArangoBackupHookPhasePre, hook.Name)
+	require.True(t, ok)
+	require.Equal(t, backupApi.ArangoBackupHookStateFailed, status.State)
+
+	_, err = handler.kubeClient.BatchV1().Jobs(obj.Namespace).Get(hookJobName(obj, backupApi.ArangoBackupHookPhasePre, hook), meta.GetOptions{})
+	require.Error(t, err)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/backup/operator/event"
 	arangoClientSet "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned"
 	arangoInformer "github.com/arangodb/kube-arangodb/pkg/generated/informers/externalversions"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -39,7 +40,7 @@ func newEventInstance(recorder event.Recorder) event.RecorderInstance {
 }
 
 // RegisterInformer into operator
-func RegisterInformer(operator operator.Operator, recorder event.Recorder, client arangoClientSet.Interface, kubeClient kubernetes.Interface, informer arangoInformer.SharedInformerFactory) error {
+func RegisterInformer(operator operator.Operator, recorder event.Recorder, client arangoClientSet.Interface, kubeClient kubernetes.Interface, podExecutor k8sutil.PodExecutor, informer arangoInformer.SharedInformerFactory) error {
 	if err := operator.RegisterInformer(informer.Backup().V1().ArangoBackups().Informer(),
 		backupApi.SchemeGroupVersion.Group,
 		backupApi.SchemeGroupVersion.Version,
@@ -56,6 +57,8 @@ func RegisterInformer(operator operator.Operator, recorder event.Recorder, clien
 		operator: operator,
 
 		arangoClientTimeout: defaultArangoClientTimeout,
+
+		podExecutor: podExecutor,
 	}
 	h.arangoClientFactory = newArangoClientBackupFactory(h)
 
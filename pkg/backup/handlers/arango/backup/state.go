@@ -45,5 +45,6 @@ var (
 		backupApi.ArangoBackupStateDeleted:       stateDeletedHandler,
 		backupApi.ArangoBackupStateFailed:        stateFailedHandler,
 		backupApi.ArangoBackupStateUnavailable:   stateUnavailableHandler,
+		backupApi.ArangoBackupStateRemote:        stateRemoteHandler,
 	}
 )
@@ -33,6 +33,30 @@ func stateCreateHandler(h *handler, backup *backupApi.ArangoBackup) (*backupApi.
 		return nil, err
 	}
 
+	hooks, result, err := h.runBackupHooks(backup, backupApi.ArangoBackupHookPhasePre)
+	if err != nil {
+		return nil, err
+	}
+
+	if failed := result.Failed; failed != nil {
+		return wrapUpdateStatus(backup,
+			updateStatusHooks(hooks),
+			updateStatusState(backupApi.ArangoBackupStateFailed, "pre hook %s failed: %s", failed.Name, failed.Message),
+			updateStatusAvailable(false),
+		)
+	}
+
+	if !result.Finished || !hooks.Equal(backup.Status.Hooks) {
+		// Save progress of the hooks before backup is created
+		return wrapUpdateStatus(backup,
+			updateStatusHooks(hooks),
+		)
+	}
+
+	if backup.Spec.IsLogical() {
+		return stateCreateLogicalHandler(h, backup, deployment)
+	}
+
 	client, err := h.arangoClientFactory(deployment, backup)
 	if err != nil {
 		return nil, newTemporaryError(err)
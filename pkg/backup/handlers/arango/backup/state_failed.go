@@ -27,5 +27,17 @@ import (
 )
 
 func stateFailedHandler(h *handler, backup *backupApi.ArangoBackup) (*backupApi.ArangoBackupStatus, error) {
+	if backup.Status.Hooks.HasPhase(backupApi.ArangoBackupHookPhasePre) {
+		// Pre hooks were started, post hooks need to revert their effect
+		hooks, _, err := h.runBackupHooks(backup, backupApi.ArangoBackupHookPhasePost)
+		if err != nil {
+			return nil, err
+		}
+
+		return wrapUpdateStatus(backup,
+			updateStatusHooks(hooks),
+		)
+	}
+
 	return wrapUpdateStatus(backup)
 }
@@ -0,0 +1,112 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	database "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/backup/logical"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func stateCreateLogicalHandler(h *handler, backup *backupApi.ArangoBackup, deployment *database.ArangoDeployment) (*backupApi.ArangoBackupStatus, error) {
+	jobs := h.kubeClient.BatchV1().Jobs(backup.Namespace)
+	name := logical.DumpJobName(backup)
+
+	job, err := jobs.Get(name, meta.GetOptions{})
+	if err != nil {
+		if !apiErrors.IsNotFound(err) {
+			return nil, newTemporaryError(err)
+		}
+
+		if err := logical.ValidateDeployment(deployment); err != nil {
+			return wrapUpdateStatus(backup,
+				updateStatusState(backupApi.ArangoBackupStateFailed, "%s", err.Error()),
+				updateStatusAvailable(false),
+			)
+		}
+
+		if storage := backup.Spec.Logical.ObjectStorage; storage != nil {
+			if err := logical.EnsureRCloneConfigSecret(h.kubeClient.CoreV1().Secrets(backup.Namespace),
+				logical.RCloneConfigSecretName(backup.Name), storage.CredentialsSecretName, backup.AsOwner()); err != nil {
+				return nil, newTemporaryError(err)
+			}
+		}
+
+		if _, err := jobs.Create(logical.NewDumpJob(deployment, backup)); err != nil {
+			return nil, newTemporaryError(err)
+		}
+
+		return wrapUpdateStatus(backup,
+			updateStatusState(backupApi.ArangoBackupStateCreate, ""),
+			updateStatusJob(name, string(logical.JobStateRunning)),
+		)
+	}
+
+	switch state, message := logical.GetJobState(job); state {
+	case logical.JobStateSucceeded:
+		return wrapUpdateStatus(backup,
+			updateStatusState(backupApi.ArangoBackupStateReady, ""),
+			updateStatusAvailable(true),
+			updateStatusLogicalBackup(deployment, backup, job.Status.CompletionTime),
+			cleanStatusJob(),
+		)
+	case logical.JobStateFailed:
+		return wrapUpdateStatus(backup,
+			updateStatusState(backupApi.ArangoBackupStateFailed, "dump job %s failed: %s", name, message),
+			updateStatusAvailable(false),
+			cleanStatusJob(),
+		)
+	default:
+		return wrapUpdateStatus(backup,
+			updateStatusJob(name, string(state)),
+		)
+	}
+}
+
+func stateReadyLogicalHandler(h *handler, backup *backupApi.ArangoBackup) (*backupApi.ArangoBackupStatus, error) {
+	if backup.Status.Backup == nil || backup.Status.Backup.Logical == nil {
+		return nil, newFatalErrorf("missing field .status.backup.logical")
+	}
+
+	if claim := backup.Status.Backup.Logical.PersistentVolumeClaimName; claim != "" {
+		if _, err := h.kubeClient.CoreV1().PersistentVolumeClaims(backup.Namespace).Get(claim, meta.GetOptions{}); err != nil {
+			if apiErrors.IsNotFound(err) {
+				return wrapUpdateStatus(backup,
+					updateStatusState(backupApi.ArangoBackupStateDeleted, "persistent volume claim %s does not exist anymore", claim),
+					updateStatusAvailable(false),
+				)
+			}
+
+			return nil, newTemporaryError(err)
+		}
+	}
+
+	return wrapUpdateStatus(backup,
+		updateStatusAvailable(true),
+	)
+}
+
+func (h *handler) finalizeLogicalBackup(backup *backupApi.ArangoBackup) error {
+	// Dump itself is kept in the claim or repository, only the job is removed
+	return h.deleteJob(backup.Namespace, logical.DumpJobName(backup))
+}
@@ -0,0 +1,152 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	"testing"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/backup/logical"
+	"github.com/arangodb/kube-arangodb/pkg/backup/operator/operation"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/stretchr/testify/require"
+	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func setLogical(obj *backupApi.ArangoBackup) {
+	obj.Spec.Type = backupApi.ArangoBackupTypeLogical.New()
+	obj.Spec.Logical = &backupApi.ArangoBackupSpecLogical{
+		PersistentVolumeClaimName: util.NewString("dumps"),
+	}
+}
+
+func setJobCondition(t *testing.T, h *handler, obj *backupApi.ArangoBackup, condition batch.JobConditionType) {
+	jobs := h.kubeClient.BatchV1().Jobs(obj.Namespace)
+
+	job, err := jobs.Get(logical.DumpJobName(obj), meta.GetOptions{})
+	require.NoError(t, err)
+
+	job.Status.Conditions = append(job.Status.Conditions, batch.JobCondition{
+		Type:    condition,
+		Status:  core.ConditionTrue,
+		Message: errorString,
+	})
+
+	_, err = jobs.UpdateStatus(job)
+	require.NoError(t, err)
+}
+
+func Test_State_Create_Logical_JobCreated(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateCreate)
+	setLogical(obj)
+
+	// Act
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj := refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateCreate, false)
+	require.NotNil(t, newObj.Status.Progress)
+	require.Equal(t, logical.DumpJobName(obj), newObj.Status.Progress.JobID)
+
+	job, err := handler.kubeClient.BatchV1().Jobs(obj.Namespace).Get(logical.DumpJobName(obj), meta.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, job.Spec.Template.Spec.Containers, 1)
+	require.Equal(t, logical.DumpContainerName, job.Spec.Template.Spec.Containers[0].Name)
+}
+
+func Test_State_Create_Logical_Success(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateCreate)
+	setLogical(obj)
+
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Act
+	setJobCondition(t, handler, obj, batch.JobComplete)
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj := refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateReady, true)
+	require.Nil(t, newObj.Status.Progress)
+	require.NotNil(t, newObj.Status.Backup)
+	require.NotNil(t, newObj.Status.Backup.Logical)
+	require.Equal(t, "dumps", newObj.Status.Backup.Logical.PersistentVolumeClaimName)
+	require.Equal(t, logical.DumpPath(obj), newObj.Status.Backup.Logical.Path)
+}
+
+func Test_State_Create_Logical_Failed(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateCreate)
+	setLogical(obj)
+
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Act
+	setJobCondition(t, handler, obj, batch.JobFailed)
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj := refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateFailed, false)
+	require.Contains(t, newObj.Status.Message, errorString)
+}
+
+func Test_State_Ready_Logical_MissingClaim(t *testing.T) {
+	// Arrange
+	handler := newFakeHandler()
+
+	obj, deployment := newObjectSet(backupApi.ArangoBackupStateReady)
+	setLogical(obj)
+	obj.Status.Backup = &backupApi.ArangoBackupDetails{
+		ID:      logical.DumpPath(obj),
+		Logical: logical.Details(obj),
+	}
+
+	// Act
+	createArangoDeployment(t, handler, deployment)
+	createArangoBackup(t, handler, obj)
+
+	require.NoError(t, handler.Handle(newItemFromBackup(operation.Update, obj)))
+
+	// Assert
+	newObj := refreshArangoBackup(t, handler, obj)
+	checkBackup(t, newObj, backupApi.ArangoBackupStateDeleted, false)
+}
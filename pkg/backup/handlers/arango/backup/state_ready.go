@@ -33,6 +33,31 @@ func stateReadyHandler(h *handler, backup *backupApi.ArangoBackup) (*backupApi.A
 		return nil, err
 	}
 
+	if backup.Spec.Download == nil {
+		hooks, result, err := h.runBackupHooks(backup, backupApi.ArangoBackupHookPhasePost)
+		if err != nil {
+			return nil, err
+		}
+
+		if failed := result.Failed; failed != nil {
+			return wrapUpdateStatus(backup,
+				updateStatusHooks(hooks),
+				updateStatusState(backupApi.ArangoBackupStateFailed, "post hook %s failed: %s", failed.Name, failed.Message),
+				updateStatusAvailable(false),
+			)
+		}
+
+		if !result.Finished || !hooks.Equal(backup.Status.Hooks) {
+			return wrapUpdateStatus(backup,
+				updateStatusHooks(hooks),
+			)
+		}
+	}
+
+	if backup.Spec.IsLogical() {
+		return stateReadyLogicalHandler(h, backup)
+	}
+
 	client, err := h.arangoClientFactory(deployment, backup)
 	if err != nil {
 		return nil, newTemporaryError(err)
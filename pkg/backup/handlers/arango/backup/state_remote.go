@@ -0,0 +1,39 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package backup
+
+import (
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+)
+
+// stateRemoteHandler keeps backups which exist only in the upload repository until download is requested
+func stateRemoteHandler(h *handler, backup *backupApi.ArangoBackup) (*backupApi.ArangoBackupStatus, error) {
+	if backup.Spec.Download == nil {
+		return wrapUpdateStatus(backup,
+			updateStatusAvailable(false),
+		)
+	}
+
+	return wrapUpdateStatus(backup,
+		updateStatusState(backupApi.ArangoBackupStatePending, ""),
+		updateStatusAvailable(false),
+	)
+}
@@ -77,6 +77,7 @@ func stateUploadingHandler(h *handler, backup *backupApi.ArangoBackup) (*backupA
 			updateStatusState(backupApi.ArangoBackupStateReady, ""),
 			cleanStatusJob(),
 			updateStatusBackupUpload(util.NewBool(true)),
+			updateStatusBackupRepository(backup.Spec.Upload),
 			updateStatusAvailable(true),
 		)
 	}
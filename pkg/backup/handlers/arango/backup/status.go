@@ -30,6 +30,8 @@ import (
 
 	"github.com/arangodb/go-driver"
 	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	database "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/backup/logical"
 	"github.com/arangodb/kube-arangodb/pkg/backup/state"
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -84,6 +86,14 @@ func updateStatusBackupUpload(uploaded *bool) updateStatusFunc {
 	}
 }
 
+func updateStatusBackupRepository(repository *backupApi.ArangoBackupSpecOperation) updateStatusFunc {
+	return func(status *backupApi.ArangoBackupStatus) {
+		if status.Backup != nil && repository != nil {
+			status.Backup.RepositoryURL = repository.RepositoryURL
+		}
+	}
+}
+
 func updateStatusBackupImported(imported *bool) updateStatusFunc {
 	return func(status *backupApi.ArangoBackupStatus) {
 		if status.Backup != nil {
@@ -106,6 +116,38 @@ func updateStatusBackup(backupMeta driver.BackupMeta) updateStatusFunc {
 	}
 }
 
+func updateStatusLogicalBackup(deployment *database.ArangoDeployment, backup *backupApi.ArangoBackup, completed *v1.Time) updateStatusFunc {
+	return func(status *backupApi.ArangoBackupStatus) {
+		obj := &backupApi.ArangoBackupDetails{
+			ID:      logical.DumpPath(backup),
+			Logical: logical.Details(backup),
+		}
+
+		if i := deployment.Status.CurrentImage; i != nil {
+			obj.Version = string(i.ArangoDBVersion)
+		}
+
+		if completed != nil {
+			obj.CreationTimestamp = *completed
+		} else {
+			obj.CreationTimestamp = v1.Now()
+		}
+
+		status.Backup = obj
+	}
+}
+
+func updateStatusRemoteBackup(entry logical.RepositoryEntry, repository backupApi.ArangoBackupSpecOperation) updateStatusFunc {
+	return func(status *backupApi.ArangoBackupStatus) {
+		status.Backup = &backupApi.ArangoBackupDetails{
+			ID:                entry.Name,
+			Uploaded:          util.NewBool(true),
+			CreationTimestamp: v1.Time{Time: entry.ModTime},
+			RepositoryURL:     repository.RepositoryURL,
+		}
+	}
+}
+
 func cleanStatusJob() updateStatusFunc {
 	return func(status *backupApi.ArangoBackupStatus) {
 		status.Progress = nil
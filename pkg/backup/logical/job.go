@@ -0,0 +1,294 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package logical
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	database "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DumpContainerName is the name of the container which runs arangodump
+	DumpContainerName = "arangodump"
+	// RestoreContainerName is the name of the container which runs arangorestore
+	RestoreContainerName = "arangorestore"
+	// TransferContainerName is the name of the container which copies the dump from or to the object storage
+	TransferContainerName = "transfer"
+
+	// RoleLogicalBackup is the role of the logical backup jobs
+	RoleLogicalBackup = "logical-backup"
+
+	dumpVolumeName       = "dump"
+	dumpVolumeMountDir   = "/dump"
+	rcloneVolumeName     = "rclone-config"
+	rcloneVolumeMountDir = "/secrets/rclone"
+
+	jobBackoffLimit int32 = 2
+)
+
+// DumpJobName returns the name of the job which creates the dump for the given backup
+func DumpJobName(backup *backupApi.ArangoBackup) string {
+	return fmt.Sprintf("%s-dump", backup.GetName())
+}
+
+// RestoreJobName returns the name of the job which restores the dump into the given deployment
+func RestoreJobName(deployment *database.ArangoDeployment) string {
+	return fmt.Sprintf("%s-restore", deployment.GetName())
+}
+
+// DumpPath returns the path of the dump inside of the claim or repository
+func DumpPath(backup *backupApi.ArangoBackup) string {
+	return string(backup.GetUID())
+}
+
+// ValidateDeployment returns an error when the given deployment can not be dumped or restored by a job.
+// arangodump and arangorestore connect to the coordinators without client certificate.
+func ValidateDeployment(deployment *database.ArangoDeployment) error {
+	if deployment.Spec.IsSecure() && deployment.Spec.TLS.ClientAuth.IsRequired() {
+		return errors.Newf("logical backups are not supported with TLS client authentication mode %s", database.TLSClientAuthModeRequired)
+	}
+
+	return nil
+}
+
+// Details returns logical details of the backup created by the dump job
+func Details(backup *backupApi.ArangoBackup) *backupApi.ArangoBackupLogicalDetails {
+	d := &backupApi.ArangoBackupLogicalDetails{
+		JobName: DumpJobName(backup),
+		Path:    DumpPath(backup),
+	}
+
+	if logical := backup.Spec.Logical; logical != nil {
+		d.PersistentVolumeClaimName = logical.GetPersistentVolumeClaimName()
+		if logical.ObjectStorage != nil {
+			d.RepositoryURL = logical.ObjectStorage.RepositoryURL
+		}
+	}
+
+	return d
+}
+
+// NewDumpJob creates a job which dumps the deployment with arangodump into the location defined by the backup
+func NewDumpJob(deployment *database.ArangoDeployment, backup *backupApi.ArangoBackup) *batch.Job {
+	logical := backup.Spec.Logical
+	path := filepath.Join(dumpVolumeMountDir, DumpPath(backup))
+	image := getImage(deployment, logical)
+
+	var dumps []core.Container
+
+	if len(logical.Databases) == 0 {
+		args := append(connectionArgs(deployment),
+			"--all-databases=true",
+			fmt.Sprintf("--output-directory=%s", path))
+		dumps = append(dumps, newContainer(DumpContainerName, image, "arangodump", append(args, threadArgs(logical)...)))
+	} else {
+		// Layout is the same as the one created by --all-databases, so arangorestore can read it in the same way
+		for id, db := range logical.Databases {
+			args := append(connectionArgs(deployment),
+				fmt.Sprintf("--server.database=%s", db),
+				fmt.Sprintf("--output-directory=%s", filepath.Join(path, db)))
+			dumps = append(dumps, newContainer(fmt.Sprintf("%s-%d", DumpContainerName, id), image, "arangodump", append(args, threadArgs(logical)...)))
+		}
+	}
+
+	var init, containers []core.Container
+
+	if storage := logical.ObjectStorage; storage != nil {
+		init = dumps
+		containers = []core.Container{newTransferContainer(logical, path, repositoryPath(storage, backup))}
+	} else {
+		init = dumps[:len(dumps)-1]
+		containers = dumps[len(dumps)-1:]
+	}
+
+	return newJob(DumpJobName(backup), deployment, logical, backup.GetName(), init, containers, backup.AsOwner())
+}
+
+// NewRestoreJob creates a job which restores the dump of the backup into the deployment with arangorestore.
+// Deployment does not need to have the same shape as the dumped one.
+func NewRestoreJob(deployment *database.ArangoDeployment, backup *backupApi.ArangoBackup) *batch.Job {
+	logical := backup.Spec.Logical
+	path := filepath.Join(dumpVolumeMountDir, DumpPath(backup))
+
+	args := append(connectionArgs(deployment),
+		"--all-databases=true",
+		"--create-database=true",
+		fmt.Sprintf("--input-directory=%s", path))
+	restore := newContainer(RestoreContainerName, getImage(deployment, logical), "arangorestore", append(args, threadArgs(logical)...))
+
+	var init []core.Container
+
+	if storage := logical.ObjectStorage; storage != nil {
+		init = []core.Container{newTransferContainer(logical, repositoryPath(storage, backup), path)}
+	}
+
+	return newJob(RestoreJobName(deployment), deployment, logical, backup.GetName(), init, []core.Container{restore}, deployment.AsOwner())
+}
+
+func newJob(name string, deployment *database.ArangoDeployment, logical *backupApi.ArangoBackupSpecLogical, backupName string,
+	init, containers []core.Container, owner meta.OwnerReference) *batch.Job {
+	backoffLimit := jobBackoffLimit
+	labels := k8sutil.LabelsForDeployment(deployment.GetName(), RoleLogicalBackup)
+
+	volumes := []core.Volume{getDumpVolume(logical)}
+
+	if deployment.Spec.IsAuthenticated() {
+		volumes = append(volumes, k8sutil.CreateVolumeWithSecret(k8sutil.ClusterJWTSecretVolumeName, deployment.Spec.Authentication.GetJWTSecretName()))
+	}
+
+	if logical.ObjectStorage != nil {
+		volumes = append(volumes, k8sutil.CreateVolumeWithSecret(rcloneVolumeName, RCloneConfigSecretName(backupName)))
+	}
+
+	return &batch.Job{
+		ObjectMeta: meta.ObjectMeta{
+			Name:            name,
+			Namespace:       deployment.GetNamespace(),
+			Labels:          labels,
+			OwnerReferences: []meta.OwnerReference{owner},
+		},
+		Spec: batch.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: core.PodTemplateSpec{
+				ObjectMeta: meta.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: core.PodSpec{
+					RestartPolicy:    core.RestartPolicyNever,
+					InitContainers:   withMounts(deployment, logical, init),
+					Containers:       withMounts(deployment, logical, containers),
+					Volumes:          volumes,
+					ImagePullSecrets: imagePullSecrets(deployment),
+				},
+			},
+		},
+	}
+}
+
+func withMounts(deployment *database.ArangoDeployment, logical *backupApi.ArangoBackupSpecLogical, containers []core.Container) []core.Container {
+	if len(containers) == 0 {
+		return nil
+	}
+
+	for id := range containers {
+		mounts := []core.VolumeMount{{Name: dumpVolumeName, MountPath: dumpVolumeMountDir}}
+
+		if deployment.Spec.IsAuthenticated() {
+			mounts = append(mounts, k8sutil.ClusterJWTVolumeMount())
+		}
+
+		if logical.ObjectStorage != nil {
+			mounts = append(mounts, core.VolumeMount{Name: rcloneVolumeName, MountPath: rcloneVolumeMountDir, ReadOnly: true})
+		}
+
+		containers[id].VolumeMounts = mounts
+	}
+
+	return containers
+}
+
+func newContainer(name, image, command string, args []string) core.Container {
+	return core.Container{
+		Name:            name,
+		Image:           image,
+		ImagePullPolicy: core.PullIfNotPresent,
+		Command:         append([]string{command}, args...),
+	}
+}
+
+func newTransferContainer(logical *backupApi.ArangoBackupSpecLogical, from, to string) core.Container {
+	return newContainer(TransferContainerName, logical.GetUploaderImage(), "rclone", []string{
+		"--config", filepath.Join(rcloneVolumeMountDir, RCloneConfigFileName),
+		"copy", from, to,
+	})
+}
+
+func connectionArgs(deployment *database.ArangoDeployment) []string {
+	scheme := "tcp"
+	if deployment.Spec.IsSecure() {
+		scheme = "ssl"
+	}
+
+	args := []string{
+		fmt.Sprintf("--server.endpoint=%s://%s:%s", scheme, k8sutil.CreateDatabaseClientServiceDNSName(deployment), strconv.Itoa(k8sutil.ArangoPort)),
+	}
+
+	if deployment.Spec.IsAuthenticated() {
+		args = append(args, fmt.Sprintf("--server.jwt-secret-keyfile=%s", filepath.Join(k8sutil.ClusterJWTSecretVolumeMountDir, constants.SecretKeyToken)))
+	} else {
+		args = append(args, "--server.authentication=false")
+	}
+
+	return args
+}
+
+func threadArgs(logical *backupApi.ArangoBackupSpecLogical) []string {
+	if threads := logical.GetThreads(); threads > 0 {
+		return []string{fmt.Sprintf("--threads=%d", threads)}
+	}
+
+	return nil
+}
+
+func getDumpVolume(logical *backupApi.ArangoBackupSpecLogical) core.Volume {
+	if name := logical.GetPersistentVolumeClaimName(); name != "" {
+		return k8sutil.CreateVolumeWithPersitantVolumeClaim(dumpVolumeName, name)
+	}
+
+	return k8sutil.CreateVolumeEmptyDir(dumpVolumeName)
+}
+
+func getImage(deployment *database.ArangoDeployment, logical *backupApi.ArangoBackupSpecLogical) string {
+	if image := logical.GetImage(); image != "" {
+		return image
+	}
+
+	if i := deployment.Status.CurrentImage; i != nil && i.Image != "" {
+		return i.Image
+	}
+
+	return deployment.Spec.GetImage()
+}
+
+func imagePullSecrets(deployment *database.ArangoDeployment) []core.LocalObjectReference {
+	var secrets []core.LocalObjectReference
+
+	for _, name := range deployment.Spec.ImagePullSecrets {
+		secrets = append(secrets, core.LocalObjectReference{Name: name})
+	}
+
+	return secrets
+}
+
+func repositoryPath(storage *backupApi.ArangoBackupSpecOperation, backup *backupApi.ArangoBackup) string {
+	return fmt.Sprintf("%s/%s", strings.TrimSuffix(storage.RepositoryURL, "/"), DumpPath(backup))
+}
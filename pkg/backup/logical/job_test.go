@@ -0,0 +1,165 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package logical
+
+import (
+	"testing"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	database "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestObjects(logical backupApi.ArangoBackupSpecLogical) (*database.ArangoDeployment, *backupApi.ArangoBackup) {
+	deployment := &database.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "depl",
+			Namespace: "ns",
+			UID:       "depl-uid",
+		},
+		Spec: database.DeploymentSpec{
+			Image: util.NewString("arangodb/arangodb:3.7.10"),
+		},
+	}
+
+	backup := &backupApi.ArangoBackup{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      "backup",
+			Namespace: "ns",
+			UID:       "backup-uid",
+		},
+		Spec: backupApi.ArangoBackupSpec{
+			Deployment: backupApi.ArangoBackupSpecDeployment{
+				Name: "depl",
+			},
+			Type:    backupApi.ArangoBackupTypeLogical.New(),
+			Logical: &logical,
+		},
+	}
+
+	return deployment, backup
+}
+
+func Test_NewDumpJob_PersistentVolumeClaim(t *testing.T) {
+	deployment, backup := newTestObjects(backupApi.ArangoBackupSpecLogical{
+		PersistentVolumeClaimName: util.NewString("dumps"),
+		Threads:                   util.NewInt(4),
+	})
+
+	job := NewDumpJob(deployment, backup)
+
+	require.Equal(t, "backup-dump", job.GetName())
+	require.Equal(t, "ns", job.GetNamespace())
+	require.Len(t, job.OwnerReferences, 1)
+	require.Equal(t, backup.GetUID(), job.OwnerReferences[0].UID)
+
+	spec := job.Spec.Template.Spec
+	require.Len(t, spec.InitContainers, 0)
+	require.Len(t, spec.Containers, 1)
+
+	c := spec.Containers[0]
+	require.Equal(t, DumpContainerName, c.Name)
+	require.Equal(t, "arangodb/arangodb:3.7.10", c.Image)
+	require.Contains(t, c.Command, "--all-databases=true")
+	require.Contains(t, c.Command, "--output-directory=/dump/backup-uid")
+	require.Contains(t, c.Command, "--threads=4")
+
+	require.Equal(t, "dumps", spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+}
+
+func Test_NewDumpJob_Databases(t *testing.T) {
+	deployment, backup := newTestObjects(backupApi.ArangoBackupSpecLogical{
+		PersistentVolumeClaimName: util.NewString("dumps"),
+		Databases:                 []string{"a", "b"},
+	})
+
+	spec := NewDumpJob(deployment, backup).Spec.Template.Spec
+
+	require.Len(t, spec.InitContainers, 1)
+	require.Len(t, spec.Containers, 1)
+	require.Contains(t, spec.InitContainers[0].Command, "--server.database=a")
+	require.Contains(t, spec.InitContainers[0].Command, "--output-directory=/dump/backup-uid/a")
+	require.Contains(t, spec.Containers[0].Command, "--server.database=b")
+	require.Contains(t, spec.Containers[0].Command, "--output-directory=/dump/backup-uid/b")
+}
+
+func Test_NewDumpJob_ObjectStorage(t *testing.T) {
+	deployment, backup := newTestObjects(backupApi.ArangoBackupSpecLogical{
+		ObjectStorage: &backupApi.ArangoBackupSpecOperation{
+			RepositoryURL:         "s3:bucket/dumps/",
+			CredentialsSecretName: "creds",
+		},
+	})
+
+	spec := NewDumpJob(deployment, backup).Spec.Template.Spec
+
+	require.Len(t, spec.InitContainers, 1)
+	require.Equal(t, DumpContainerName, spec.InitContainers[0].Name)
+	require.Len(t, spec.Containers, 1)
+	require.Equal(t, TransferContainerName, spec.Containers[0].Name)
+	require.Equal(t, backupApi.DefaultLogicalUploaderImage, spec.Containers[0].Image)
+	require.Equal(t, []string{"rclone", "--config", "/secrets/rclone/rclone.conf", "copy", "/dump/backup-uid", "s3:bucket/dumps/backup-uid"},
+		spec.Containers[0].Command)
+	require.NotNil(t, spec.Volumes[0].EmptyDir)
+}
+
+func Test_NewRestoreJob(t *testing.T) {
+	deployment, backup := newTestObjects(backupApi.ArangoBackupSpecLogical{
+		ObjectStorage: &backupApi.ArangoBackupSpecOperation{
+			RepositoryURL:         "s3:bucket/dumps",
+			CredentialsSecretName: "creds",
+		},
+	})
+	deployment.Spec.TLS.CASecretName = util.NewString("None")
+
+	job := NewRestoreJob(deployment, backup)
+
+	require.Equal(t, "depl-restore", job.GetName())
+	require.Equal(t, deployment.GetUID(), job.OwnerReferences[0].UID)
+
+	spec := job.Spec.Template.Spec
+	require.Len(t, spec.InitContainers, 1)
+	require.Equal(t, []string{"rclone", "--config", "/secrets/rclone/rclone.conf", "copy", "s3:bucket/dumps/backup-uid", "/dump/backup-uid"},
+		spec.InitContainers[0].Command)
+
+	require.Len(t, spec.Containers, 1)
+	c := spec.Containers[0]
+	require.Equal(t, RestoreContainerName, c.Name)
+	require.Equal(t, "arangorestore", c.Command[0])
+	require.Contains(t, c.Command, "--server.endpoint=tcp://depl.ns.svc:8529")
+	require.Contains(t, c.Command, "--create-database=true")
+	require.Contains(t, c.Command, "--input-directory=/dump/backup-uid")
+}
+
+func Test_ValidateDeployment(t *testing.T) {
+	deployment, _ := newTestObjects(backupApi.ArangoBackupSpecLogical{})
+	require.NoError(t, ValidateDeployment(deployment))
+
+	optional := database.TLSClientAuthModeOptional
+	deployment.Spec.TLS.ClientAuth = &database.TLSClientAuthSpec{Mode: &optional}
+	require.NoError(t, ValidateDeployment(deployment))
+
+	required := database.TLSClientAuthModeRequired
+	deployment.Spec.TLS.ClientAuth.Mode = &required
+	require.Error(t, ValidateDeployment(deployment))
+}
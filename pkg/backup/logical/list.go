@@ -0,0 +1,107 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package logical
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"time"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	database "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RoleBackupCatalog is the role of the jobs which list content of the upload repositories
+const RoleBackupCatalog = "backup-catalog"
+
+// RepositoryEntry describes backup directory found in the upload repository
+type RepositoryEntry struct {
+	Name    string    `json:"Name"`
+	IsDir   bool      `json:"IsDir"`
+	ModTime time.Time `json:"ModTime"`
+}
+
+// NewListJob creates a job which lists backups stored in the upload repository with rclone lsjson.
+// Rclone config is read from the secret returned by RCloneConfigSecretName for the job name.
+func NewListJob(name string, deployment *database.ArangoDeployment, repository *backupApi.ArangoBackupSpecOperation) *batch.Job {
+	backoffLimit := jobBackoffLimit
+	labels := k8sutil.LabelsForDeployment(deployment.GetName(), RoleBackupCatalog)
+
+	return &batch.Job{
+		ObjectMeta: meta.ObjectMeta{
+			Name:            name,
+			Namespace:       deployment.GetNamespace(),
+			Labels:          labels,
+			OwnerReferences: []meta.OwnerReference{deployment.AsOwner()},
+		},
+		Spec: batch.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: core.PodTemplateSpec{
+				ObjectMeta: meta.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: core.PodSpec{
+					RestartPolicy: core.RestartPolicyNever,
+					Containers: []core.Container{
+						{
+							Name:            TransferContainerName,
+							Image:           backupApi.DefaultLogicalUploaderImage,
+							ImagePullPolicy: core.PullIfNotPresent,
+							Command: []string{"rclone",
+								"--config", filepath.Join(rcloneVolumeMountDir, RCloneConfigFileName),
+								"lsjson", "--dirs-only", repository.RepositoryURL,
+							},
+							VolumeMounts: []core.VolumeMount{
+								{Name: rcloneVolumeName, MountPath: rcloneVolumeMountDir, ReadOnly: true},
+							},
+						},
+					},
+					Volumes: []core.Volume{
+						k8sutil.CreateVolumeWithSecret(rcloneVolumeName, RCloneConfigSecretName(name)),
+					},
+					ImagePullSecrets: imagePullSecrets(deployment),
+				},
+			},
+		},
+	}
+}
+
+// ParseListOutput parses output of the list job and returns backup directories
+func ParseListOutput(output []byte) ([]RepositoryEntry, error) {
+	var entries []RepositoryEntry
+	if err := json.Unmarshal(output, &entries); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal repository list")
+	}
+
+	dirs := make([]RepositoryEntry, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir && e.Name != "" {
+			dirs = append(dirs, e)
+		}
+	}
+
+	return dirs, nil
+}
@@ -0,0 +1,41 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package logical
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseListOutput(t *testing.T) {
+	entries, err := ParseListOutput([]byte(`[
+{"Path":"2021-05-01T10.00.00Z_0b1c","Name":"2021-05-01T10.00.00Z_0b1c","Size":-1,"ModTime":"2021-05-01T10:00:05Z","IsDir":true},
+{"Path":"README","Name":"README","Size":12,"ModTime":"2021-05-01T09:00:00Z","IsDir":false}
+]`))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "2021-05-01T10.00.00Z_0b1c", entries[0].Name)
+	require.Equal(t, int64(1619863205), entries[0].ModTime.Unix())
+
+	_, err = ParseListOutput([]byte("not json"))
+	require.Error(t, err)
+}
@@ -0,0 +1,120 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package logical
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RCloneConfigFileName is the key of the rclone config inside of the secret
+const RCloneConfigFileName = "rclone.conf"
+
+// RCloneConfigSecretName returns the name of the secret which keeps rclone config for the given backup
+func RCloneConfigSecretName(backupName string) string {
+	return fmt.Sprintf("%s-rclone", backupName)
+}
+
+// RenderRCloneConfig converts credentials used by the hot backup upload (JSON object with remote name as a key
+// and remote options as a value) into the rclone config file
+func RenderRCloneConfig(credentials []byte) ([]byte, error) {
+	var remotes map[string]map[string]interface{}
+	if err := json.Unmarshal(credentials, &remotes); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal credentials")
+	}
+
+	if len(remotes) == 0 {
+		return nil, errors.Newf("credentials do not contain any remote")
+	}
+
+	var b bytes.Buffer
+
+	for _, remote := range sortedKeys(remotes) {
+		options := remotes[remote]
+
+		b.WriteString(fmt.Sprintf("[%s]\n", remote))
+
+		keys := make([]string, 0, len(options))
+		for key := range options {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			b.WriteString(fmt.Sprintf("%s = %v\n", key, options[key]))
+		}
+
+		b.WriteString("\n")
+	}
+
+	return b.Bytes(), nil
+}
+
+// EnsureRCloneConfigSecret creates the secret with rclone config rendered from the credentials secret if it does not exist
+func EnsureRCloneConfigSecret(secrets k8sutil.SecretInterface, name, credentialsSecretName string, owner meta.OwnerReference) error {
+	if _, err := secrets.Get(name, meta.GetOptions{}); err == nil {
+		return nil
+	} else if !k8sutil.IsNotFound(err) {
+		return errors.WithStack(err)
+	}
+
+	token, err := k8sutil.GetTokenSecret(secrets, credentialsSecretName)
+	if err != nil {
+		return err
+	}
+
+	config, err := RenderRCloneConfig([]byte(token))
+	if err != nil {
+		return err
+	}
+
+	secret := &core.Secret{
+		ObjectMeta: meta.ObjectMeta{
+			Name:            name,
+			OwnerReferences: []meta.OwnerReference{owner},
+		},
+		Data: map[string][]byte{
+			RCloneConfigFileName: config,
+		},
+	}
+
+	if _, err := secrets.Create(secret); err != nil && !k8sutil.IsAlreadyExists(err) {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func sortedKeys(m map[string]map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,42 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package logical
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RenderRCloneConfig(t *testing.T) {
+	config, err := RenderRCloneConfig([]byte(`{"s3": {"type": "s3", "provider": "AWS", "env_auth": false}}`))
+	require.NoError(t, err)
+
+	require.Equal(t, "[s3]\nenv_auth = false\nprovider = AWS\ntype = s3\n\n", string(config))
+}
+
+func Test_RenderRCloneConfig_Invalid(t *testing.T) {
+	_, err := RenderRCloneConfig([]byte(`{}`))
+	require.Error(t, err)
+
+	_, err = RenderRCloneConfig([]byte(`not json`))
+	require.Error(t, err)
+}
@@ -0,0 +1,56 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package logical
+
+import (
+	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+)
+
+// JobState describes the state of the logical backup job
+type JobState string
+
+const (
+	// JobStateRunning job is still in progress
+	JobStateRunning JobState = "Running"
+	// JobStateSucceeded job finished successfully
+	JobStateSucceeded JobState = "Succeeded"
+	// JobStateFailed job failed and will not be retried anymore
+	JobStateFailed JobState = "Failed"
+)
+
+// GetJobState returns the state of the job and the message of the final condition
+func GetJobState(job *batch.Job) (JobState, string) {
+	for _, c := range job.Status.Conditions {
+		if c.Status != core.ConditionTrue {
+			continue
+		}
+
+		switch c.Type {
+		case batch.JobComplete:
+			return JobStateSucceeded, c.Message
+		case batch.JobFailed:
+			return JobStateFailed, c.Message
+		}
+	}
+
+	return JobStateRunning, ""
+}
@@ -23,6 +23,7 @@
 package deployment
 
 import (
+	"crypto"
 	"strings"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/arangodb/kube-arangodb/pkg/util/arangosync/accesspackage"
 	"github.com/arangodb/kube-arangodb/pkg/util/constants"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
@@ -103,9 +105,12 @@ func (d *Deployment) ensureAccessPackage(apSecretName string) error {
 	secrets := d.deps.KubeCli.CoreV1().Secrets(ns)
 	spec := d.apiObject.Spec
 
-	if _, err := secrets.Get(apSecretName, metav1.GetOptions{}); err == nil {
+	if existing, err := secrets.Get(apSecretName, metav1.GetOptions{}); err == nil {
 		// Secret already exists
-		return nil
+		if accesspackage.IsSigned(existing.Data) || !d.isOwnerOf(existing) {
+			return nil
+		}
+		return d.signAccessPackage(existing)
 	}
 
 	// Fetch client authentication CA
@@ -189,18 +194,46 @@ func (d *Deployment) ensureAccessPackage(apSecretName string) error {
 		log.Debug().Err(err).Msg("Failed to encode TLS CA Secret")
 		return errors.WithStack(err)
 	}
-	allYaml := strings.TrimSpace(string(keyfileYaml)) + "\n---\n" + strings.TrimSpace(string(tlsCAYaml))
+
+	// Create signed bundle, which can be imported by reference in an ArangoDeploymentReplication
+	bundleData, err := d.createAccessPackageBundle(keyfile, tlsCACert, clientAuthCert, clientAuthKey)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to create signed access package bundle")
+		return errors.WithStack(err)
+	}
+	bundleSecret := v1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: apSecretName,
+			Labels: map[string]string{
+				labelKeyOriginalDeployment: d.apiObject.GetName(),
+			},
+		},
+		Data: bundleData,
+		Type: "Opaque",
+	}
+	bundleYaml, err := yaml.Marshal(bundleSecret)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to encode access package bundle Secret")
+		return errors.WithStack(err)
+	}
+	allYaml := strings.TrimSpace(string(keyfileYaml)) + "\n---\n" + strings.TrimSpace(string(tlsCAYaml)) + "\n---\n" + strings.TrimSpace(string(bundleYaml))
 
 	// Create secret containing access package
+	data := map[string][]byte{
+		constants.SecretAccessPackageYaml: []byte(allYaml),
+	}
+	for k, v := range bundleData {
+		data[k] = v
+	}
 	secret := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: apSecretName,
 		},
-		Data: map[string][]byte{
-			constants.SecretAccessPackageYaml: []byte(allYaml),
-			constants.SecretCACertificate:     []byte(tlsCACert),
-			constants.SecretTLSKeyfile:        []byte(keyfile),
-		},
+		Data: data,
 	}
 	// Attach secret to owner
 	secret.SetOwnerReferences(append(secret.GetOwnerReferences(), d.apiObject.AsOwner()))
@@ -216,3 +249,60 @@ func (d *Deployment) ensureAccessPackage(apSecretName string) error {
 
 	return nil
 }
+
+// createAccessPackageBundle creates the Secret data of a signed access package bundle, holding the syncmaster
+// endpoints, TLS CA certificate and client authentication keyfile.
+// The bundle is signed with the client authentication CA.
+func (d *Deployment) createAccessPackageBundle(keyfile, tlsCACert, clientAuthCert, clientAuthKey string) (map[string][]byte, error) {
+	spec := d.apiObject.Spec
+
+	ca, err := certificates.LoadCAFromPEM(clientAuthCert, clientAuthKey)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	signer, ok := ca.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.WithStack(errors.Newf("Client authentication CA key cannot be used for signing"))
+	}
+
+	bundle := accesspackage.Bundle{
+		MasterEndpoint:      spec.Sync.ExternalAccess.ResolveMasterEndpoint(k8sutil.CreateSyncMasterClientServiceDNSNameWithDomain(d.apiObject, spec.ClusterDomain), k8sutil.ArangoSyncMasterPort),
+		CACertificate:       tlsCACert,
+		Keyfile:             keyfile,
+		ClientCACertificate: clientAuthCert,
+	}
+	signature, err := bundle.Sign(signer)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return bundle.ToSecretData(signature), nil
+}
+
+// signAccessPackage adds a signed bundle to an access package created before bundles were supported.
+func (d *Deployment) signAccessPackage(secret *v1.Secret) error {
+	log := d.deps.Log
+	secrets := d.deps.KubeCli.CoreV1().Secrets(d.GetNamespace())
+
+	clientAuthCert, clientAuthKey, _, err := k8sutil.GetCASecret(secrets, d.apiObject.Spec.Sync.Authentication.GetClientCASecretName(), nil)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to get client-auth CA secret")
+		return errors.WithStack(err)
+	}
+
+	bundleData, err := d.createAccessPackageBundle(string(secret.Data[constants.SecretTLSKeyfile]), string(secret.Data[constants.SecretCACertificate]), clientAuthCert, clientAuthKey)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to create signed access package bundle")
+		return errors.WithStack(err)
+	}
+
+	secret = secret.DeepCopy()
+	for k, v := range bundleData {
+		secret.Data[k] = v
+	}
+	if _, err := secrets.Update(secret); err != nil {
+		log.Debug().Err(err).Str("secret-name", secret.GetName()).Msg("Failed to update access package Secret")
+		return errors.WithStack(err)
+	}
+	log.Info().Str("secret-name", secret.GetName()).Msg("Signed access package Secret")
+	return nil
+}
@@ -0,0 +1,155 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"encoding/json"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/resources"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+)
+
+// inspectCompatibility stores the compatibility level of the deployment in the status together with
+// the members which are rotated at the current level and the members which would be rotated
+// after switching to the latest level.
+// Rendering pods of all members is expensive, so the report is refreshed only when the spec, images,
+// member pods or the operator version change.
+func (d *Deployment) inspectCompatibility(cachedStatus inspectorInterface.Inspector) error {
+	spec := d.apiObject.Spec
+	status, _ := d.GetStatus()
+
+	level := api.GetCompatibilityLevel(spec, status)
+
+	checksum, err := d.compatibilityInputChecksum(spec, status, level)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if checksum == d.compatibilityChecksum {
+		return nil
+	}
+
+	compatibility := api.DeploymentCompatibilityStatus{
+		Level:       level,
+		LatestLevel: api.DeploymentCompatibilityLevelLatest,
+		Rotation:    d.compatibilityRotation(cachedStatus, spec, status, level),
+	}
+
+	if level < api.DeploymentCompatibilityLevelLatest {
+		compatibility.LatestRotation = d.compatibilityRotation(cachedStatus, spec, status, api.DeploymentCompatibilityLevelLatest)
+	}
+
+	if err := d.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+		if c := s.Compatibility; c != nil {
+			compatibility.OperatorVersion = c.OperatorVersion
+		}
+
+		if s.Compatibility.Equal(&compatibility) {
+			return false
+		}
+
+		s.Compatibility = &compatibility
+		return true
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	d.compatibilityChecksum = checksum
+	return nil
+}
+
+// compatibilityInputChecksum returns the checksum of everything the compatibility report depends on.
+func (d *Deployment) compatibilityInputChecksum(spec api.DeploymentSpec, status api.DeploymentStatus, level int) (string, error) {
+	members := map[string]string{}
+	status.Members.ForeachServerGroup(func(group api.ServerGroup, list api.MemberStatusList) error {
+		for _, m := range list {
+			members[m.ID] = m.PodName + "/" + m.PodSpecVersion
+		}
+		return nil
+	})
+
+	data, err := json.Marshal(struct {
+		Spec            api.DeploymentSpec
+		Images          api.ImageInfoList
+		Members         map[string]string
+		Level           int
+		OperatorVersion string
+	}{
+		Spec:            spec,
+		Images:          status.Images,
+		Members:         members,
+		Level:           level,
+		OperatorVersion: d.GetOperatorVersion(),
+	})
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return util.SHA256(data), nil
+}
+
+// compatibilityRotation returns IDs of members which pods differ from pods rendered at the given compatibility level.
+func (d *Deployment) compatibilityRotation(cachedStatus inspectorInterface.Inspector, spec api.DeploymentSpec, status api.DeploymentStatus, level int) []string {
+	log := d.deps.Log
+
+	spec = *spec.DeepCopy()
+	spec.CompatibilityLevel = &level
+
+	imageInfo, imageFound := d.SelectImage(spec, status)
+	if !imageFound {
+		return nil
+	}
+
+	var ids []string
+	status.Members.ForeachServerGroup(func(group api.ServerGroup, members api.MemberStatusList) error {
+		for _, m := range members {
+			if m.PodSpecVersion == "" || m.PodName == "" {
+				continue
+			}
+
+			memberImage := imageInfo
+			if m.Image != nil {
+				memberImage = *m.Image
+			}
+
+			pod, err := d.RenderPodForMember(cachedStatus, spec, status, m.ID, memberImage)
+			if err != nil {
+				log.Debug().Err(err).Str("id", m.ID).Msg("Unable to render pod for compatibility report")
+				continue
+			}
+
+			checksum, err := resources.ChecksumArangoPod(spec.GetServerGroupSpec(group), pod)
+			if err != nil {
+				log.Debug().Err(err).Str("id", m.ID).Msg("Unable to get pod checksum for compatibility report")
+				continue
+			}
+
+			if checksum != m.PodSpecVersion {
+				ids = append(ids, m.ID)
+			}
+		}
+		return nil
+	})
+
+	return ids
+}
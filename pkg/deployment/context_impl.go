@@ -50,6 +50,7 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/util/constants"
 
 	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	batchv1 "k8s.io/client-go/kubernetes/typed/batch/v1"
 
 	"github.com/arangodb/arangosync-client/client"
 	"github.com/arangodb/arangosync-client/tasks"
@@ -63,6 +64,7 @@ import (
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/resources"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/tracing"
 	v1 "k8s.io/api/core/v1"
 )
 
@@ -103,6 +105,11 @@ func (d *Deployment) GetLifecycleImage() string {
 	return d.config.LifecycleImage
 }
 
+// GetOperatorVersion returns the version of the operator
+func (d *Deployment) GetOperatorVersion() string {
+	return d.config.OperatorVersion
+}
+
 // GetOperatorUUIDImage returns the image name containing the uuid helper (== name of operator image)
 func (d *Deployment) GetOperatorUUIDImage() string {
 	return d.config.OperatorUUIDInitImage
@@ -164,10 +171,14 @@ func (d *Deployment) updateStatus(status api.DeploymentStatus, lastVersion int32
 		return errors.WithStack(errors.Newf("Status conflict error. Expected version %d, got %d", lastVersion, d.status.version))
 	}
 	d.status.version++
+	previous := d.status.last
 	d.status.last = *status.DeepCopy()
 	if err := d.updateCRStatus(force...); err != nil {
 		return errors.WithStack(err)
 	}
+	for _, evt := range statusChangeEvents(d.apiObject, previous, status) {
+		d.CreateEvent(evt)
+	}
 	return nil
 }
 
@@ -254,16 +265,56 @@ func (d *Deployment) getConnConfig() (http.ConnectionConfig, error) {
 		transport.TLSClientConfig = &tls.Config{
 			InsecureSkipVerify: true,
 		}
+
+		if d.apiObject.Spec.TLS.ClientAuth.IsEnabled() {
+			cert, err := d.getTLSClientCertificate()
+			if err != nil {
+				return http.ConnectionConfig{}, errors.WithStack(err)
+			}
+
+			if cert != nil {
+				transport.TLSClientConfig.Certificates = []tls.Certificate{*cert}
+			}
+		}
 	}
 
 	connConfig := http.ConnectionConfig{
-		Transport:          transport,
+		Transport:          tracing.NewTransport(transport),
 		DontFollowRedirect: true,
 	}
 
 	return connConfig, nil
 }
 
+// getTLSClientCertificate loads the client certificate used by the operator when client authentication is enabled.
+// Returns nil when the certificate is not yet created.
+func (d *Deployment) getTLSClientCertificate() (*tls.Certificate, error) {
+	var secrets secret.ReadInterface = d.GetKubeCli().CoreV1().Secrets(d.apiObject.GetNamespace())
+	if currentState := d.currentState; currentState != nil {
+		secrets = currentState.SecretReadInterface()
+	}
+
+	s, err := secrets.Get(k8sutil.CreateTLSClientKeyfileSecretName(d.apiObject.GetName()), meta.GetOptions{})
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	keyfile, err := k8sutil.GetTLSKeyfileFromSecret(s)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	cert, err := tls.X509KeyPair([]byte(keyfile), []byte(keyfile))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &cert, nil
+}
+
 func (d *Deployment) getAuth() (driver.Authentication, error) {
 	if !d.apiObject.Spec.Authentication.IsAuthenticated() {
 		return nil, nil
@@ -576,6 +627,10 @@ func (d *Deployment) SecretsInterface() k8sutil.SecretInterface {
 	return d.GetKubeCli().CoreV1().Secrets(d.GetNamespace())
 }
 
+func (d *Deployment) JobsInterface() batchv1.JobInterface {
+	return d.GetKubeCli().BatchV1().Jobs(d.GetNamespace())
+}
+
 func (d *Deployment) GetName() string {
 	return d.apiObject.GetName()
 }
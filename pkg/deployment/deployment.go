@@ -36,6 +36,7 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/arangodb/kube-arangodb/pkg/notification"
 	"github.com/arangodb/kube-arangodb/pkg/operator/scope"
 
 	monitoringClient "github.com/coreos/prometheus-operator/pkg/client/versioned/typed/monitoring/v1"
@@ -72,6 +73,7 @@ type Config struct {
 	OperatorUUIDInitImage string
 	MetricsExporterImage  string
 	ArangoImage           string
+	OperatorVersion       string
 	Scope                 scope.Scope
 }
 
@@ -132,6 +134,7 @@ type Deployment struct {
 	chaosMonkey               *chaos.Monkey
 	syncClientCache           client.ClientCache
 	haveServiceMonitorCRD     bool
+	compatibilityChecksum     string // Checksum of the inputs of the last compatibility report
 }
 
 // New creates a new Deployment from the given API object.
@@ -374,6 +377,7 @@ func (d *Deployment) handleArangoDeploymentUpdatedEvent() error {
 // On error, the error is logged.
 func (d *Deployment) CreateEvent(evt *k8sutil.Event) {
 	d.deps.EventRecorder.Event(evt.InvolvedObject, evt.Type, evt.Reason, evt.Message)
+	notification.Notify(evt)
 }
 
 // Update the status of the API object from the internal status
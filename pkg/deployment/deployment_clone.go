@@ -0,0 +1,311 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	cloneScrubTimeout = time.Minute
+)
+
+// CloneBackupName returns the name of the ArangoBackup which downloads the clone source into the deployment
+func CloneBackupName(deploymentName string) string {
+	return k8sutil.FixupResourceName(deploymentName + "-clone")
+}
+
+// ApplyCloneDefaults fills in the shape of a deployment which is cloned from an ArangoBackup.
+// Image, mode, storage engine and the number of DBServers are taken from the deployment
+// of the source backup, unless they are set explicitly.
+func ApplyCloneDefaults(crcli versioned.Interface, apiObject *api.ArangoDeployment) error {
+	if apiObject.Status.AcceptedSpec != nil {
+		// Shape was already accepted
+		return nil
+	}
+
+	name := apiObject.Spec.Clone.GetBackup()
+	if name == "" {
+		// Shape of the backup in the repository is not known before download
+		return nil
+	}
+
+	ns := apiObject.GetNamespace()
+	backup, err := crcli.BackupV1().ArangoBackups(ns).Get(name, meta.GetOptions{})
+	if err != nil {
+		return errors.WithStack(errors.Wrapf(err, "unable to get clone source backup %s", name))
+	}
+
+	spec := &apiObject.Spec
+
+	if !backup.Spec.IsLogical() && spec.DBServers.Count == nil {
+		if details := backup.Status.Backup; details != nil && details.NumberOfDBServers > 0 {
+			spec.DBServers.Count = util.NewInt(int(details.NumberOfDBServers))
+		}
+	}
+
+	source, err := crcli.DatabaseV1().ArangoDeployments(ns).Get(backup.Spec.Deployment.Name, meta.GetOptions{})
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			// Source deployment is gone, shape needs to be provided in the spec
+			return nil
+		}
+		return errors.WithStack(err)
+	}
+
+	if spec.Image == nil {
+		spec.Image = util.NewStringOrNil(source.Spec.Image)
+	}
+	if spec.Mode == nil {
+		spec.Mode = api.NewModeOrNil(source.Spec.Mode)
+	}
+	if spec.StorageEngine == nil {
+		spec.StorageEngine = api.NewStorageEngineOrNil(source.Spec.StorageEngine)
+	}
+
+	return nil
+}
+
+// inspectClone drives the clone of the deployment from a backup:
+// download of the backup, restore and removal of the users.
+func (d *Deployment) inspectClone(ctx context.Context, cachedStatus inspectorInterface.Inspector) error {
+	spec := d.apiObject.Spec
+	if spec.Clone == nil {
+		return nil
+	}
+
+	status, _ := d.GetStatus()
+	if status.Clone.GetPhase().IsFinal() {
+		return nil
+	}
+
+	if !status.Conditions.IsTrue(api.ConditionTypeReady) {
+		// Wait for the deployment to be ready before data is restored
+		return nil
+	}
+
+	switch status.Clone.GetPhase() {
+	case "", api.DeploymentClonePhaseDownloading:
+		return d.inspectCloneDownload(spec)
+	case api.DeploymentClonePhaseRestoring:
+		return d.inspectCloneRestore(spec, status)
+	case api.DeploymentClonePhaseScrubbing:
+		return d.inspectCloneScrub(ctx, spec, status, cachedStatus)
+	}
+
+	return nil
+}
+
+func (d *Deployment) inspectCloneDownload(spec api.DeploymentSpec) error {
+	backups := d.deps.DatabaseCRCli.BackupV1().ArangoBackups(d.Namespace())
+	clone := spec.Clone
+
+	var download *backupApi.ArangoBackupSpecDownload
+
+	if name := clone.GetBackup(); name != "" {
+		source, err := backups.Get(name, meta.GetOptions{})
+		if err != nil {
+			if k8sutil.IsNotFound(err) {
+				return d.updateCloneStatus(api.DeploymentClonePhaseFailed, name, fmt.Sprintf("Backup %s not found", name))
+			}
+			return errors.WithStack(err)
+		}
+
+		if source.Spec.IsLogical() || source.Spec.Deployment.Name == d.apiObject.GetName() {
+			// Backup is restored without download
+			return d.startCloneRestore(spec, name)
+		}
+
+		details := source.Status.Backup
+		if details == nil {
+			// Backup not yet created
+			return nil
+		}
+
+		if !util.BoolOrDefault(details.Uploaded, false) || source.Spec.Upload == nil {
+			return d.updateCloneStatus(api.DeploymentClonePhaseFailed, name, fmt.Sprintf("Backup %s is not uploaded to the repository", name))
+		}
+
+		download = &backupApi.ArangoBackupSpecDownload{
+			ArangoBackupSpecOperation: *source.Spec.Upload.DeepCopy(),
+			ID:                        details.ID,
+		}
+	} else {
+		download = &backupApi.ArangoBackupSpecDownload{
+			ArangoBackupSpecOperation: backupApi.ArangoBackupSpecOperation{
+				RepositoryURL:         clone.GetRepositoryURL(),
+				CredentialsSecretName: clone.GetCredentialsSecretName(),
+			},
+			ID: clone.GetID(),
+		}
+	}
+
+	name := CloneBackupName(d.apiObject.GetName())
+
+	backup, err := backups.Get(name, meta.GetOptions{})
+	if err != nil {
+		if !k8sutil.IsNotFound(err) {
+			return errors.WithStack(err)
+		}
+
+		backup = &backupApi.ArangoBackup{
+			ObjectMeta: meta.ObjectMeta{
+				Name:            name,
+				OwnerReferences: []meta.OwnerReference{d.apiObject.AsOwner()},
+			},
+			Spec: backupApi.ArangoBackupSpec{
+				Deployment: backupApi.ArangoBackupSpecDeployment{
+					Name: d.apiObject.GetName(),
+				},
+				Download: download,
+			},
+		}
+
+		if _, err := backups.Create(backup); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return d.updateCloneStatus(api.DeploymentClonePhaseDownloading, name, fmt.Sprintf("Downloading backup %s", download.ID))
+	}
+
+	switch backup.Status.State {
+	case backupApi.ArangoBackupStateReady:
+		return d.startCloneRestore(spec, name)
+	case backupApi.ArangoBackupStateFailed:
+		return d.updateCloneStatus(api.DeploymentClonePhaseFailed, name, fmt.Sprintf("Download failed: %s", backup.Status.Message))
+	}
+
+	return nil
+}
+
+func (d *Deployment) startCloneRestore(spec api.DeploymentSpec, backup string) error {
+	if spec.GetRestoreFrom() != backup {
+		newSpec := *spec.DeepCopy()
+		newSpec.RestoreFrom = util.NewString(backup)
+		if err := d.updateCRSpec(newSpec); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return d.updateCloneStatus(api.DeploymentClonePhaseRestoring, backup, fmt.Sprintf("Restoring backup %s", backup))
+}
+
+func (d *Deployment) inspectCloneRestore(spec api.DeploymentSpec, status api.DeploymentStatus) error {
+	restore := status.Restore
+	if restore == nil {
+		return nil
+	}
+
+	backup := status.Clone.Backup
+
+	switch restore.State {
+	case api.DeploymentRestoreStateRestoreFailed:
+		return d.updateCloneStatus(api.DeploymentClonePhaseFailed, backup, fmt.Sprintf("Restore failed: %s", restore.Message))
+	case api.DeploymentRestoreStateRestored:
+		if spec.Clone.IsScrubUsers() {
+			return d.updateCloneStatus(api.DeploymentClonePhaseScrubbing, backup, "Removing users")
+		}
+		return d.updateCloneStatus(api.DeploymentClonePhaseCompleted, backup, fmt.Sprintf("Backup %s restored", backup))
+	}
+
+	return nil
+}
+
+// inspectCloneScrub removes all users except root from the restored data
+// and resets the root password to the one of the deployment.
+func (d *Deployment) inspectCloneScrub(ctx context.Context, spec api.DeploymentSpec, status api.DeploymentStatus, cachedStatus inspectorInterface.Inspector) error {
+	password := ""
+	if secret := spec.Bootstrap.PasswordSecretNames.GetSecretName(api.UserNameRoot); !secret.IsNone() {
+		s, ok := cachedStatus.Secret(secret.Get())
+		if !ok {
+			// Secret is created by the bootstrap
+			return nil
+		}
+
+		_, pass, err := k8sutil.GetSecretAuthCredentials(s)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		password = pass
+	}
+
+	ctxChild, cancel := context.WithTimeout(ctx, cloneScrubTimeout)
+	defer cancel()
+
+	c, err := d.GetDatabaseClient(ctxChild)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	users, err := c.Users(ctxChild)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, u := range users {
+		if u.Name() == api.UserNameRoot {
+			if err := u.Update(ctxChild, driver.UserOptions{Password: password}); err != nil {
+				return errors.WithStack(err)
+			}
+			continue
+		}
+
+		if err := u.Remove(ctxChild); err != nil && !driver.IsNotFound(err) {
+			return errors.WithStack(err)
+		}
+	}
+
+	return d.updateCloneStatus(api.DeploymentClonePhaseCompleted, status.Clone.Backup, "Backup restored and users removed")
+}
+
+func (d *Deployment) updateCloneStatus(phase api.DeploymentClonePhase, backup, message string) error {
+	if err := d.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+		n := &api.DeploymentCloneStatus{
+			Phase:   phase,
+			Backup:  backup,
+			Message: message,
+		}
+
+		if s.Clone.Equal(n) {
+			return false
+		}
+
+		s.Clone = n
+		return true
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	d.CreateEvent(k8sutil.NewCloneEvent(d.apiObject, string(phase), message, phase == api.DeploymentClonePhaseFailed))
+	return nil
+}
@@ -0,0 +1,131 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"testing"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	arangofake "github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/fake"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newCloneTestObjects() (*api.ArangoDeployment, *backupApi.ArangoBackup) {
+	source := &api.ArangoDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "source",
+			Namespace: testNamespace,
+		},
+		Spec: api.DeploymentSpec{
+			Image: util.NewString(testImage),
+			Mode:  api.NewMode(api.DeploymentModeCluster),
+		},
+	}
+
+	backup := &backupApi.ArangoBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup",
+			Namespace: testNamespace,
+		},
+		Spec: backupApi.ArangoBackupSpec{
+			Deployment: backupApi.ArangoBackupSpecDeployment{
+				Name: source.GetName(),
+			},
+		},
+		Status: backupApi.ArangoBackupStatus{
+			Backup: &backupApi.ArangoBackupDetails{
+				ID:                "id",
+				NumberOfDBServers: 5,
+			},
+		},
+	}
+
+	return source, backup
+}
+
+func newCloneTestDeployment(clone *api.DeploymentCloneSpec) *api.ArangoDeployment {
+	return &api.ArangoDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testDeploymentName,
+			Namespace: testNamespace,
+		},
+		Spec: api.DeploymentSpec{
+			Clone: clone,
+		},
+	}
+}
+
+func TestApplyCloneDefaults(t *testing.T) {
+	t.Run("Shape from source", func(t *testing.T) {
+		source, backup := newCloneTestObjects()
+		crcli := arangofake.NewSimpleClientset(source, backup)
+
+		depl := newCloneTestDeployment(&api.DeploymentCloneSpec{Backup: util.NewString(backup.GetName())})
+		require.NoError(t, ApplyCloneDefaults(crcli, depl))
+
+		require.Equal(t, testImage, depl.Spec.GetImage())
+		require.Equal(t, api.DeploymentModeCluster, depl.Spec.GetMode())
+		require.NotNil(t, depl.Spec.DBServers.Count)
+		require.Equal(t, 5, *depl.Spec.DBServers.Count)
+	})
+
+	t.Run("Explicit values are kept", func(t *testing.T) {
+		source, backup := newCloneTestObjects()
+		crcli := arangofake.NewSimpleClientset(source, backup)
+
+		depl := newCloneTestDeployment(&api.DeploymentCloneSpec{Backup: util.NewString(backup.GetName())})
+		depl.Spec.Image = util.NewString("custom")
+		depl.Spec.DBServers.Count = util.NewInt(3)
+		require.NoError(t, ApplyCloneDefaults(crcli, depl))
+
+		require.Equal(t, "custom", depl.Spec.GetImage())
+		require.Equal(t, 3, *depl.Spec.DBServers.Count)
+	})
+
+	t.Run("Source deployment removed", func(t *testing.T) {
+		_, backup := newCloneTestObjects()
+		crcli := arangofake.NewSimpleClientset(backup)
+
+		depl := newCloneTestDeployment(&api.DeploymentCloneSpec{Backup: util.NewString(backup.GetName())})
+		require.NoError(t, ApplyCloneDefaults(crcli, depl))
+
+		require.Nil(t, depl.Spec.Image)
+		require.Equal(t, 5, *depl.Spec.DBServers.Count)
+	})
+
+	t.Run("Missing backup", func(t *testing.T) {
+		crcli := arangofake.NewSimpleClientset()
+
+		depl := newCloneTestDeployment(&api.DeploymentCloneSpec{Backup: util.NewString("backup")})
+		require.Error(t, ApplyCloneDefaults(crcli, depl))
+	})
+
+	t.Run("Repository", func(t *testing.T) {
+		crcli := arangofake.NewSimpleClientset()
+
+		depl := newCloneTestDeployment(&api.DeploymentCloneSpec{RepositoryURL: util.NewString("s3://bucket"), ID: util.NewString("id")})
+		require.NoError(t, ApplyCloneDefaults(crcli, depl))
+		require.Nil(t, depl.Spec.Image)
+	})
+}
@@ -42,6 +42,7 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/metrics"
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -65,12 +66,20 @@ func (d *Deployment) inspectDeployment(lastInterval util.Interval) util.Interval
 
 	nextInterval := lastInterval
 	hasError := false
-	ctx := context.Background()
 	deploymentName := d.apiObject.GetName()
 	defer metrics.SetDuration(inspectDeploymentDurationGauges.WithLabelValues(deploymentName), start)
 
+	ctx, span := tracing.Start(context.Background(), "InspectDeployment",
+		tracing.String("deployment.namespace", d.GetNamespace()),
+		tracing.String("deployment.name", deploymentName))
+	defer span.End()
+
+	_, refreshSpan := tracing.Start(ctx, "InspectorRefresh")
 	cachedStatus, err := inspector.NewInspector(d.GetKubeCli(), d.GetMonitoringV1Cli(), d.GetArangoCli(), d.GetNamespace())
+	refreshSpan.SetError(err)
+	refreshSpan.End()
 	if err != nil {
+		span.SetError(err)
 		log.Error().Err(err).Msg("Unable to get resources")
 		return minInspectionInterval // Retry ASAP
 	}
@@ -106,6 +115,7 @@ func (d *Deployment) inspectDeployment(lastInterval util.Interval) util.Interval
 		d.apiObject = updated
 
 		if inspectNextInterval, err := d.inspectDeploymentWithError(ctx, nextInterval, cachedStatus); err != nil {
+			span.SetError(err)
 			if !operatorErrors.IsReconcile(err) {
 				nextInterval = inspectNextInterval
 				hasError = true
@@ -189,6 +199,16 @@ func (d *Deployment) inspectDeploymentWithError(ctx context.Context, lastInterva
 		return minInspectionInterval, errors.Wrapf(err, "License Key Secret invalid")
 	}
 
+	// Issue client certificates
+	if err := d.resources.EnsureClientCertificates(cachedStatus); err != nil {
+		return minInspectionInterval, errors.Wrapf(err, "Client certificate creation failed")
+	}
+
+	// Inspect expiry of certificates
+	if err := d.resources.InspectCertificates(cachedStatus); err != nil {
+		return minInspectionInterval, errors.Wrapf(err, "Certificate inspection failed")
+	}
+
 	// Is the deployment in a good state?
 	if status.Conditions.IsTrue(api.ConditionTypeSecretsChanged) {
 		return minInspectionInterval, errors.Newf("Secrets changed")
@@ -201,6 +221,26 @@ func (d *Deployment) inspectDeploymentWithError(ctx context.Context, lastInterva
 		return minInspectionInterval, nil
 	}
 
+	// Report upgrade to the candidate image. Report is informational only, so its failure does not stop the inspection
+	if retrySoon, err := d.inspectUpgradeReport(ctx, d.apiObject); err != nil {
+		d.deps.Log.Warn().Err(err).Msg("Upgrade report failed")
+		if err := d.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+			return s.Conditions.Update(api.ConditionTypeUpgradeReportFailed, true, "Upgrade report failed", err.Error())
+		}); err != nil {
+			return minInspectionInterval, errors.Wrapf(err, "Unable to update condition")
+		}
+		nextInterval = nextInterval.ReduceTo(minInspectionInterval)
+	} else {
+		if err := d.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+			return s.Conditions.Remove(api.ConditionTypeUpgradeReportFailed)
+		}); err != nil {
+			return minInspectionInterval, errors.Wrapf(err, "Unable to update condition")
+		}
+		if retrySoon {
+			nextInterval = nextInterval.ReduceTo(minInspectionInterval)
+		}
+	}
+
 	// Inspection of generated resources needed
 	if x, err := d.resources.InspectPods(ctx, cachedStatus); err != nil {
 		return minInspectionInterval, errors.Wrapf(err, "Pod inspection failed")
@@ -214,6 +254,11 @@ func (d *Deployment) inspectDeploymentWithError(ctx context.Context, lastInterva
 		nextInterval = nextInterval.ReduceTo(x)
 	}
 
+	// Report compatibility of the pod rendering
+	if err := d.inspectCompatibility(cachedStatus); err != nil {
+		return minInspectionInterval, errors.Wrapf(err, "Compatibility inspection failed")
+	}
+
 	// Check members for resilience
 	if err := d.resilience.CheckMemberFailure(); err != nil {
 		return minInspectionInterval, errors.Wrapf(err, "Member failure detection failed")
@@ -289,6 +334,11 @@ func (d *Deployment) inspectDeploymentWithError(ctx context.Context, lastInterva
 		return minInspectionInterval, errors.Wrapf(err, "AccessPackage creation failed")
 	}
 
+	// Clone deployment from backup
+	if err := d.inspectClone(ctx, cachedStatus); err != nil {
+		return minInspectionInterval, errors.Wrapf(err, "Clone inspection failed")
+	}
+
 	// Inspect deployment for obsolete members
 	if err := d.resources.CleanupRemovedMembers(); err != nil {
 		return minInspectionInterval, errors.Wrapf(err, "Removed member cleanup failed")
@@ -0,0 +1,69 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// statusChangeEvents returns events for the lifecycle changes between the previous and the new status
+// of the deployment: phase change, failed members and start and end of the upgrade.
+func statusChangeEvents(apiObject k8sutil.APIObject, previous, current api.DeploymentStatus) []*k8sutil.Event {
+	var events []*k8sutil.Event
+
+	if previous.Phase != current.Phase {
+		events = append(events, k8sutil.NewDeploymentPhaseChangedEvent(apiObject, string(previous.Phase), string(current.Phase)))
+	}
+
+	current.Members.ForeachServerGroup(func(group api.ServerGroup, list api.MemberStatusList) error {
+		for _, m := range list {
+			if !m.Phase.IsFailed() {
+				continue
+			}
+
+			if p, _, ok := previous.Members.ElementByID(m.ID); ok && p.Phase.IsFailed() {
+				continue
+			}
+
+			events = append(events, k8sutil.NewMemberFailedEvent(apiObject, m.ID, group.AsRole()))
+		}
+		return nil
+	})
+
+	if u := current.Upgrade; u != nil && !u.RolledBack {
+		var fromImage string
+		if u.FromImage != nil {
+			fromImage = u.FromImage.Image
+		}
+
+		if previous.Upgrade == nil || previous.Upgrade.ToImage != u.ToImage {
+			events = append(events, k8sutil.NewUpgradeStartedEvent(apiObject, fromImage, u.ToImage))
+		}
+
+		if current.CurrentImage != nil && current.CurrentImage.Image == u.ToImage &&
+			(previous.CurrentImage == nil || previous.CurrentImage.Image != u.ToImage) {
+			events = append(events, k8sutil.NewUpgradeFinishedEvent(apiObject, fromImage, u.ToImage))
+		}
+	}
+
+	return events
+}
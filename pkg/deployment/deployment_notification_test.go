@@ -0,0 +1,85 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"testing"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/stretchr/testify/require"
+)
+
+func notificationTypes(events []*k8sutil.Event) []k8sutil.EventNotification {
+	var r []k8sutil.EventNotification
+	for _, e := range events {
+		r = append(r, e.Notification)
+	}
+	return r
+}
+
+func TestStatusChangeEvents(t *testing.T) {
+	apiObject := &api.ArangoDeployment{}
+
+	var previous api.DeploymentStatus
+	previous.Phase = api.DeploymentPhaseRunning
+	previous.CurrentImage = &api.ImageInfo{Image: "arangodb:3.7.1"}
+	previous.Members.DBServers = api.MemberStatusList{{ID: "prmr1", Phase: api.MemberPhaseCreated}, {ID: "prmr2", Phase: api.MemberPhaseFailed}}
+
+	t.Run("No changes", func(t *testing.T) {
+		require.Empty(t, statusChangeEvents(apiObject, previous, *previous.DeepCopy()))
+	})
+
+	t.Run("Phase changed", func(t *testing.T) {
+		current := *previous.DeepCopy()
+		current.Phase = api.DeploymentPhaseFailed
+
+		require.Equal(t, []k8sutil.EventNotification{k8sutil.EventNotificationDeploymentPhase},
+			notificationTypes(statusChangeEvents(apiObject, previous, current)))
+	})
+
+	t.Run("Member failed", func(t *testing.T) {
+		current := *previous.DeepCopy()
+		current.Members.DBServers[0].Phase = api.MemberPhaseFailed
+
+		events := statusChangeEvents(apiObject, previous, current)
+		require.Equal(t, []k8sutil.EventNotification{k8sutil.EventNotificationMemberFailed}, notificationTypes(events))
+		require.Contains(t, events[0].Message, "prmr1")
+	})
+
+	t.Run("Upgrade", func(t *testing.T) {
+		started := *previous.DeepCopy()
+		started.Upgrade = &api.DeploymentUpgradeStatus{FromImage: previous.CurrentImage.DeepCopy(), ToImage: "arangodb:3.7.2"}
+
+		require.Equal(t, []k8sutil.EventNotification{k8sutil.EventNotificationUpgradeStarted},
+			notificationTypes(statusChangeEvents(apiObject, previous, started)))
+
+		finished := *started.DeepCopy()
+		finished.CurrentImage = &api.ImageInfo{Image: "arangodb:3.7.2"}
+
+		require.Equal(t, []k8sutil.EventNotification{k8sutil.EventNotificationUpgradeFinished},
+			notificationTypes(statusChangeEvents(apiObject, started, finished)))
+
+		rolledBack := *started.DeepCopy()
+		rolledBack.Upgrade.RolledBack = true
+		require.Empty(t, statusChangeEvents(apiObject, started, rolledBack))
+	})
+}
@@ -34,6 +34,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -369,6 +370,8 @@ func TestEnsurePod_Sync_Master(t *testing.T) {
 }
 
 func TestEnsurePod_Sync_Worker(t *testing.T) {
+	bandwidth := resource.MustParse("10M")
+
 	testCases := []testCaseStruct{
 		{
 			Name: "Sync Worker Pod with monitoring, service account, node selector, lifecycle, license " +
@@ -462,6 +465,104 @@ func TestEnsurePod_Sync_Worker(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Sync Worker Pod with bandwidth limit",
+			config: Config{
+				LifecycleImage: testImageLifecycle,
+			},
+			ArangoDeployment: &api.ArangoDeployment{
+				Spec: api.DeploymentSpec{
+					Image:          util.NewString(testImage),
+					Authentication: noAuthentication,
+					Sync: api.SyncSpec{
+						Enabled: util.NewBool(true),
+						RateLimit: &api.SyncRateLimitSpec{
+							MaxBandwidth: &bandwidth,
+						},
+					},
+					SyncWorkers: api.ServerGroupSpec{
+						ServiceAccountName: util.NewString(testServiceAccountName),
+						NodeSelector:       nodeSelectorTest,
+						PriorityClassName:  testPriorityClassName,
+						Resources:          resourcesUnfiltered,
+					},
+					License: api.LicenseSpec{
+						SecretName: util.NewString(testLicense),
+					},
+				},
+			},
+			Helper: func(t *testing.T, deployment *Deployment, testCase *testCaseStruct) {
+				deployment.status.last = api.DeploymentStatus{
+					Members: api.DeploymentStatusMembers{
+						SyncWorkers: api.MemberStatusList{
+							firstSyncWorker,
+						},
+					},
+					Images: createTestImages(true),
+				}
+
+				testCase.createTestPodData(deployment, api.ServerGroupSyncWorkers, firstSyncWorker)
+				testCase.ExpectedPod.ObjectMeta.Annotations = map[string]string{
+					"kubernetes.io/ingress-bandwidth": "80M",
+					"kubernetes.io/egress-bandwidth":  "80M",
+				}
+
+				name := testCase.ArangoDeployment.Spec.Sync.Monitoring.GetTokenSecretName()
+				auth, err := k8sutil.GetTokenSecret(deployment.GetKubeCli().CoreV1().Secrets(testNamespace), name)
+				require.NoError(t, err)
+
+				testCase.ExpectedPod.Spec.Containers[0].LivenessProbe = createTestLivenessProbe(
+					"", true, "bearer "+auth, k8sutil.ArangoSyncWorkerPort)
+			},
+			ExpectedEvent: "member syncworker is created",
+			ExpectedPod: core.Pod{
+				Spec: core.PodSpec{
+					Volumes: []core.Volume{
+						k8sutil.LifecycleVolume(),
+						k8sutil.CreateVolumeWithSecret(k8sutil.MasterJWTSecretVolumeName, testDeploymentName+"-sync-jwt"),
+					},
+					InitContainers: []core.Container{
+						createTestLifecycleContainer(emptyResources),
+					},
+					Containers: []core.Container{
+						{
+							Name:    k8sutil.ServerContainerName,
+							Image:   testImage,
+							Command: createTestCommandForSyncWorker(firstSyncWorker.ID, true, true),
+							Ports:   createTestPorts(),
+							Env: []core.EnvVar{
+								k8sutil.CreateEnvSecretKeySelector(constants.EnvArangoSyncMonitoringToken,
+									testDeploymentName+"-sync-mt", constants.SecretKeyToken),
+								k8sutil.CreateEnvSecretKeySelector(constants.EnvArangoLicenseKey,
+									testLicense, constants.SecretKeyToken),
+								k8sutil.CreateEnvFieldPath(constants.EnvOperatorPodName, "metadata.name"),
+								k8sutil.CreateEnvFieldPath(constants.EnvOperatorPodNamespace, "metadata.namespace"),
+								k8sutil.CreateEnvFieldPath(constants.EnvOperatorNodeName, "spec.nodeName"),
+								k8sutil.CreateEnvFieldPath(constants.EnvOperatorNodeNameArango, "spec.nodeName"),
+							},
+							Lifecycle:       createTestLifecycle(),
+							ImagePullPolicy: core.PullIfNotPresent,
+							Resources:       k8sutil.ExtractPodResourceRequirement(resourcesUnfiltered),
+							SecurityContext: securityContext.NewSecurityContext(),
+							VolumeMounts: []core.VolumeMount{
+								k8sutil.LifecycleVolumeMount(),
+								k8sutil.MasterJWTVolumeMount(),
+							},
+						},
+					},
+					PriorityClassName:             testPriorityClassName,
+					RestartPolicy:                 core.RestartPolicyNever,
+					ServiceAccountName:            testServiceAccountName,
+					NodeSelector:                  nodeSelectorTest,
+					TerminationGracePeriodSeconds: &defaultSyncWorkerTerminationTimeout,
+					Hostname: testDeploymentName + "-" + api.ServerGroupSyncWorkersString + "-" +
+						firstSyncWorker.ID,
+					Subdomain: testDeploymentName + "-int",
+					Affinity: k8sutil.CreateAffinity(testDeploymentName, api.ServerGroupSyncWorkersString,
+						false, api.ServerGroupDBServersString),
+				},
+			},
+		},
 	}
 
 	runTestCases(t, testCases...)
@@ -0,0 +1,59 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package encryption
+
+import (
+	"context"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// KMS wraps and unwraps encryption keys with the key kept by the key management service
+type KMS interface {
+	// Encrypt wraps the given plain key.
+	Encrypt(ctx context.Context, plaintext []byte) ([]byte, error)
+	// Decrypt unwraps the given key.
+	Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error)
+}
+
+// NewKMS returns the KMS client configured in the given spec.
+func NewKMS(spec *api.RocksDBEncryptionKMSSpec, secrets k8sutil.SecretInterface) (KMS, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch spec.GetType() {
+	case api.RocksDBEncryptionKMSTypeVault:
+		token, err := k8sutil.GetTokenSecret(secrets, spec.GetTokenSecretName())
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to get Vault token")
+		}
+
+		return NewVaultKMS(spec.GetEndpoint(), spec.GetMountPath(), spec.GetKeyName(), token), nil
+	case api.RocksDBEncryptionKMSTypeLocal:
+		return NewLocalKMSFromFile(spec.GetKeyFile())
+	default:
+		return nil, errors.Newf("Unknown KMS type %s", spec.GetType())
+	}
+}
@@ -0,0 +1,111 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"io/ioutil"
+	"strings"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	localKMSPrefix = "local:v1:"
+)
+
+// NewLocalKMSFromFile returns KMS which wraps keys with the master key read from the given file.
+// It is a stand-in for an external KMS, e.g. for development and testing.
+func NewLocalKMSFromFile(path string) (KMS, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to read KMS key file")
+	}
+
+	return NewLocalKMS(data)
+}
+
+// NewLocalKMS returns KMS which wraps keys with the given master key.
+func NewLocalKMS(masterKey []byte) (KMS, error) {
+	if len(masterKey) == 0 {
+		return nil, errors.Newf("KMS master key is empty")
+	}
+
+	// Master key of any size is accepted
+	key := sha256.Sum256(masterKey)
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &localKMS{gcm: gcm}, nil
+}
+
+type localKMS struct {
+	gcm cipher.AEAD
+}
+
+func (l *localKMS) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, l.gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	sealed := l.gcm.Seal(nonce, nonce, plaintext, nil)
+
+	return []byte(localKMSPrefix + base64.StdEncoding.EncodeToString(sealed)), nil
+}
+
+func (l *localKMS) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	s := string(ciphertext)
+	if !strings.HasPrefix(s, localKMSPrefix) {
+		return nil, errors.Newf("Ciphertext was not created by local KMS")
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(s, localKMSPrefix))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(sealed) < l.gcm.NonceSize() {
+		return nil, errors.Newf("Ciphertext is too short")
+	}
+
+	nonce, sealed := sealed[:l.gcm.NonceSize()], sealed[l.gcm.NonceSize():]
+
+	plaintext, err := l.gcm.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to decrypt ciphertext")
+	}
+
+	return plaintext, nil
+}
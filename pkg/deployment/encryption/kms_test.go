@@ -0,0 +1,187 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+)
+
+// newVaultMock starts a server which implements encrypt and decrypt calls of the Vault transit engine.
+func newVaultMock(t *testing.T, token, keyName string) *httptest.Server {
+	backend, err := NewLocalKMS([]byte("vault-mock"))
+	require.NoError(t, err)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(vaultTokenHeader) != token {
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(vaultResponse{Errors: []string{"permission denied"}})
+			return
+		}
+
+		var req vaultRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var resp vaultResponse
+
+		switch r.URL.Path {
+		case "/v1/transit/encrypt/" + keyName:
+			plaintext, err := base64.StdEncoding.DecodeString(req.Plaintext)
+			require.NoError(t, err)
+			ciphertext, err := backend.Encrypt(r.Context(), plaintext)
+			require.NoError(t, err)
+			resp.Data.Ciphertext = "vault:v1:" + strings.TrimPrefix(string(ciphertext), localKMSPrefix)
+		case "/v1/transit/decrypt/" + keyName:
+			plaintext, err := backend.Decrypt(r.Context(), []byte(localKMSPrefix+strings.TrimPrefix(req.Ciphertext, "vault:v1:")))
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(vaultResponse{Errors: []string{err.Error()}})
+				return
+			}
+			resp.Data.Plaintext = base64.StdEncoding.EncodeToString(plaintext)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestVaultKMS(t *testing.T) {
+	server := newVaultMock(t, "secret-token", "arangodb")
+	defer server.Close()
+
+	ctx := context.Background()
+	plaintext := []byte("0123456789abcdef0123456789abcdef")
+
+	t.Run("Encrypt and decrypt", func(t *testing.T) {
+		kms := NewVaultKMS(server.URL+"/", "transit", "arangodb", "secret-token")
+
+		ciphertext, err := kms.Encrypt(ctx, plaintext)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(ciphertext), "vault:v1:"))
+
+		decrypted, err := kms.Decrypt(ctx, ciphertext)
+		require.NoError(t, err)
+		require.Equal(t, plaintext, decrypted)
+	})
+
+	t.Run("Invalid token", func(t *testing.T) {
+		kms := NewVaultKMS(server.URL, "transit", "arangodb", "invalid")
+
+		_, err := kms.Encrypt(ctx, plaintext)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "permission denied")
+	})
+
+	t.Run("Unknown key", func(t *testing.T) {
+		kms := NewVaultKMS(server.URL, "transit", "unknown", "secret-token")
+
+		_, err := kms.Encrypt(ctx, plaintext)
+		require.Error(t, err)
+	})
+}
+
+func TestLocalKMS(t *testing.T) {
+	ctx := context.Background()
+	plaintext := []byte("0123456789abcdef0123456789abcdef")
+
+	kms, err := NewLocalKMS([]byte("master"))
+	require.NoError(t, err)
+
+	ciphertext, err := kms.Encrypt(ctx, plaintext)
+	require.NoError(t, err)
+	require.NotContains(t, string(ciphertext), string(plaintext))
+
+	decrypted, err := kms.Decrypt(ctx, ciphertext)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, decrypted)
+
+	other, err := NewLocalKMS([]byte("other"))
+	require.NoError(t, err)
+
+	_, err = other.Decrypt(ctx, ciphertext)
+	require.Error(t, err)
+
+	_, err = NewLocalKMS(nil)
+	require.Error(t, err)
+}
+
+func TestKMSProvider(t *testing.T) {
+	ctx := context.Background()
+
+	kms, err := NewLocalKMS([]byte("master"))
+	require.NoError(t, err)
+
+	p := kmsProvider{kms: kms}
+
+	id, data, err := p.Generate(ctx)
+	require.NoError(t, err)
+
+	secret := &core.Secret{Data: data}
+
+	keyID, err := p.KeyID(secret)
+	require.NoError(t, err)
+	require.Equal(t, id, keyID)
+
+	keyID, key, err := p.Key(ctx, secret)
+	require.NoError(t, err)
+	require.Equal(t, id, keyID)
+	require.Len(t, key, keySize)
+	require.Equal(t, id, GetKeyID(key))
+
+	// Key ID has to match wrapped key
+	secret.Data[constants.SecretEncryptionKeyID] = []byte("invalid")
+	_, _, err = p.Key(ctx, secret)
+	require.Error(t, err)
+
+	_, err = p.KeyID(&core.Secret{})
+	require.Error(t, err)
+}
+
+func TestSecretProvider(t *testing.T) {
+	ctx := context.Background()
+
+	p := secretProvider{}
+
+	id, data, err := p.Generate(ctx)
+	require.NoError(t, err)
+
+	secret := &core.Secret{Data: data}
+
+	keyID, key, err := p.Key(ctx, secret)
+	require.NoError(t, err)
+	require.Equal(t, id, keyID)
+	require.Equal(t, data[constants.SecretEncryptionKey], key)
+}
@@ -0,0 +1,140 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	vaultTokenHeader = "X-Vault-Token"
+	vaultTimeout     = 10 * time.Second
+)
+
+// NewVaultKMS returns KMS which uses the transit secrets engine of a Vault compatible server.
+func NewVaultKMS(endpoint, mountPath, keyName, token string) KMS {
+	return &vaultKMS{
+		endpoint:  strings.TrimSuffix(endpoint, "/"),
+		mountPath: strings.Trim(mountPath, "/"),
+		keyName:   keyName,
+		token:     token,
+		client: &http.Client{
+			Timeout: vaultTimeout,
+		},
+	}
+}
+
+type vaultKMS struct {
+	endpoint, mountPath, keyName, token string
+
+	client *http.Client
+}
+
+type vaultRequest struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+}
+
+type vaultResponse struct {
+	Data   vaultRequest `json:"data"`
+	Errors []string     `json:"errors,omitempty"`
+}
+
+func (v *vaultKMS) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	resp, err := v.do(ctx, "encrypt", vaultRequest{Plaintext: base64.StdEncoding.EncodeToString(plaintext)})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Data.Ciphertext == "" {
+		return nil, errors.Newf("Vault returned empty ciphertext")
+	}
+
+	return []byte(resp.Data.Ciphertext), nil
+}
+
+func (v *vaultKMS) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	resp, err := v.do(ctx, "decrypt", vaultRequest{Ciphertext: string(ciphertext)})
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(resp.Data.Plaintext)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Vault returned invalid plaintext")
+	}
+
+	return plaintext, nil
+}
+
+func (v *vaultKMS) do(ctx context.Context, operation string, body vaultRequest) (*vaultResponse, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	url := fmt.Sprintf("%s/v1/%s/%s/%s", v.endpoint, v.mountPath, operation, v.keyName)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Set(vaultTokenHeader, v.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	r, err := v.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Vault %s request failed", operation)
+	}
+	defer r.Body.Close()
+
+	respData, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var resp vaultResponse
+	if len(respData) > 0 {
+		if err := json.Unmarshal(respData, &resp); err != nil {
+			return nil, errors.Wrapf(err, "Unable to parse Vault response")
+		}
+	}
+
+	if r.StatusCode != http.StatusOK {
+		if len(resp.Errors) > 0 {
+			return nil, errors.Newf("Vault %s request failed with code %d: %s", operation, r.StatusCode, strings.Join(resp.Errors, ", "))
+		}
+		return nil, errors.Newf("Vault %s request failed with code %d", operation, r.StatusCode)
+	}
+
+	return &resp, nil
+}
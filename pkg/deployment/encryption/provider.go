@@ -0,0 +1,93 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	core "k8s.io/api/core/v1"
+)
+
+const (
+	// keySize is the size of the RocksDB encryption key
+	keySize = 32
+)
+
+// KeyProvider gives access to the RocksDB encryption key kept in the key secret
+type KeyProvider interface {
+	// KeyID returns the ID of the key stored in the secret without revealing the key.
+	KeyID(secret *core.Secret) (string, error)
+	// Key returns the ID and the plain key stored in the secret.
+	Key(ctx context.Context, secret *core.Secret) (string, []byte, error)
+	// Generate creates a new key and returns its ID together with the secret data which keeps it.
+	Generate(ctx context.Context) (string, map[string][]byte, error)
+}
+
+// NewKeyProvider returns the key provider configured in the given spec.
+func NewKeyProvider(spec api.RocksDBEncryptionSpec, secrets k8sutil.SecretInterface) (KeyProvider, error) {
+	switch spec.GetProvider() {
+	case api.RocksDBEncryptionProviderSecret:
+		return secretProvider{}, nil
+	case api.RocksDBEncryptionProviderKMS:
+		kms, err := NewKMS(spec.KMS, secrets)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		return kmsProvider{kms: kms}, nil
+	default:
+		return nil, errors.Newf("Unknown encryption key provider %s", spec.GetProvider())
+	}
+}
+
+// GetKeyIDFromSecret returns the ID of the key stored in the secret by the provider configured in the given spec.
+// It does not require access to the KMS.
+func GetKeyIDFromSecret(spec api.RocksDBEncryptionSpec, secret *core.Secret) (string, error) {
+	switch spec.GetProvider() {
+	case api.RocksDBEncryptionProviderSecret:
+		return secretProvider{}.KeyID(secret)
+	case api.RocksDBEncryptionProviderKMS:
+		return kmsProvider{}.KeyID(secret)
+	default:
+		return "", errors.Newf("Unknown encryption key provider %s", spec.GetProvider())
+	}
+}
+
+// GetKeyID returns the ID of the given plain key. It is also the name of the key in the keyfolder.
+func GetKeyID(key []byte) string {
+	return fmt.Sprintf("%0x", sha256.Sum256(key))
+}
+
+func generateKey() ([]byte, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,89 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package encryption
+
+import (
+	"context"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	core "k8s.io/api/core/v1"
+)
+
+// kmsProvider keeps the key in the secret wrapped by the key of the KMS (envelope encryption).
+// ID of the key is stored next to it, so it is known without calling the KMS.
+type kmsProvider struct {
+	kms KMS
+}
+
+func (k kmsProvider) KeyID(secret *core.Secret) (string, error) {
+	id, ok := secret.Data[constants.SecretEncryptionKeyID]
+	if !ok || len(id) == 0 {
+		return "", errors.Newf("Current encryption key is not valid - missing key ID")
+	}
+
+	if _, ok := secret.Data[constants.SecretEncryptionWrappedKey]; !ok {
+		return "", errors.Newf("Current encryption key is not valid - missing wrapped key")
+	}
+
+	return string(id), nil
+}
+
+func (k kmsProvider) Key(ctx context.Context, secret *core.Secret) (string, []byte, error) {
+	id, err := k.KeyID(secret)
+	if err != nil {
+		return "", nil, err
+	}
+
+	key, err := k.kms.Decrypt(ctx, secret.Data[constants.SecretEncryptionWrappedKey])
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "Unable to unwrap encryption key")
+	}
+
+	if len(key) != keySize {
+		return "", nil, errors.Newf("Current encryption key is not valid")
+	}
+
+	if GetKeyID(key) != id {
+		return "", nil, errors.Newf("Current encryption key does not match its ID")
+	}
+
+	return id, key, nil
+}
+
+func (k kmsProvider) Generate(ctx context.Context) (string, map[string][]byte, error) {
+	key, err := generateKey()
+	if err != nil {
+		return "", nil, errors.WithStack(err)
+	}
+
+	wrapped, err := k.kms.Encrypt(ctx, key)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "Unable to wrap encryption key")
+	}
+
+	id := GetKeyID(key)
+
+	return id, map[string][]byte{
+		constants.SecretEncryptionWrappedKey: wrapped,
+		constants.SecretEncryptionKeyID:      []byte(id),
+	}, nil
+}
@@ -0,0 +1,53 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package encryption
+
+import (
+	"context"
+
+	"github.com/arangodb/kube-arangodb/pkg/deployment/pod"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	core "k8s.io/api/core/v1"
+)
+
+// secretProvider keeps the plain key in the secret
+type secretProvider struct{}
+
+func (s secretProvider) KeyID(secret *core.Secret) (string, error) {
+	id, _, err := pod.GetEncryptionKeyFromSecret(secret)
+	return id, err
+}
+
+func (s secretProvider) Key(ctx context.Context, secret *core.Secret) (string, []byte, error) {
+	return pod.GetEncryptionKeyFromSecret(secret)
+}
+
+func (s secretProvider) Generate(ctx context.Context) (string, map[string][]byte, error) {
+	key, err := generateKey()
+	if err != nil {
+		return "", nil, errors.WithStack(err)
+	}
+
+	return GetKeyID(key), map[string][]byte{
+		constants.SecretEncryptionKey: key,
+	}, nil
+}
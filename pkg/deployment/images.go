@@ -107,10 +107,17 @@ func (d *Deployment) ensureImages(apiObject *api.ArangoDeployment) (bool, bool,
 // image ID's into the status.Images list.
 // Returns: retrySoon, error
 func (ib *imagesBuilder) Run(ctx context.Context) (bool, bool, error) {
+	return ib.RunForImage(ctx, ib.Spec.GetImage())
+}
+
+// RunForImage creates pods needed to detect ImageID for the given image and puts the found
+// image ID into the status.Images list. The image does not need to be used by the deployment.
+// Returns: retrySoon, exists, error
+func (ib *imagesBuilder) RunForImage(ctx context.Context, image string) (bool, bool, error) {
 	// Check ArangoDB image
-	if _, found := ib.Status.Images.GetByImage(ib.Spec.GetImage()); !found {
+	if _, found := ib.Status.Images.GetByImage(image); !found {
 		// We need to find the image ID for the ArangoDB image
-		retrySoon, err := ib.fetchArangoDBImageIDAndVersion(ctx, ib.Spec.GetImage())
+		retrySoon, err := ib.fetchArangoDBImageIDAndVersion(ctx, image)
 		if err != nil {
 			return retrySoon, false, errors.WithStack(err)
 		}
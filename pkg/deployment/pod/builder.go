@@ -32,16 +32,27 @@ import (
 )
 
 type Input struct {
-	ApiObject    meta.Object
-	Deployment   deploymentApi.DeploymentSpec
-	Status       deploymentApi.DeploymentStatus
-	GroupSpec    deploymentApi.ServerGroupSpec
-	Group        deploymentApi.ServerGroup
-	Version      driver.Version
-	Member       deploymentApi.MemberStatus
-	ArangoMember deploymentApi.ArangoMember
-	Enterprise   bool
-	AutoUpgrade  bool
+	ApiObject          meta.Object
+	Deployment         deploymentApi.DeploymentSpec
+	Status             deploymentApi.DeploymentStatus
+	GroupSpec          deploymentApi.ServerGroupSpec
+	Group              deploymentApi.ServerGroup
+	Version            driver.Version
+	Member             deploymentApi.MemberStatus
+	ArangoMember       deploymentApi.ArangoMember
+	Enterprise         bool
+	AutoUpgrade        bool
+	CompatibilityLevel int
+}
+
+// IsCompatible returns true if pod spec changes introduced with the given compatibility level
+// can be applied to the pod. Builders introduced in a new compatibility level need to check it
+// before changing the pod, so running deployments are not rotated until they opt in.
+func (i Input) IsCompatible(level int) bool {
+	if i.CompatibilityLevel == 0 {
+		return true
+	}
+	return i.CompatibilityLevel >= level
 }
 
 type Builder interface {
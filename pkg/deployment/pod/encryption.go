@@ -164,6 +164,10 @@ func (e encryption) Verify(i Input, cachedStatus interfaces.Inspector) error {
 	}
 
 	if !MultiFileMode(i) {
+		if i.Deployment.RocksDB.Encryption.GetProvider() != api.RocksDBEncryptionProviderSecret {
+			return errors.Newf("Encryption key provider %s requires Enterprise Edition 3.7.0+", i.Deployment.RocksDB.Encryption.GetProvider())
+		}
+
 		secret, exists := cachedStatus.Secret(i.Deployment.RocksDB.Encryption.GetKeySecretName())
 		if !exists {
 			return errors.Newf("Encryption key secret does not exist %s", i.Deployment.RocksDB.Encryption.GetKeySecretName())
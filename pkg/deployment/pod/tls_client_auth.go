@@ -0,0 +1,126 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package pod
+
+import (
+	"path/filepath"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/interfaces"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	core "k8s.io/api/core/v1"
+)
+
+// GroupTLSClientAuthSupported returns true when servers of the group verify client certificates.
+func GroupTLSClientAuthSupported(mode api.DeploymentMode, group api.ServerGroup) bool {
+	switch mode {
+	case api.DeploymentModeCluster:
+		return group == api.ServerGroupCoordinators
+
+	case api.DeploymentModeSingle:
+		fallthrough
+	case api.DeploymentModeActiveFailover:
+		return group == api.ServerGroupSingle
+	default:
+		return false
+	}
+}
+
+// IsTLSClientAuthEnabled returns true when client certificates are verified by the member.
+func IsTLSClientAuthEnabled(i Input) bool {
+	return IsTLSClientAuthConfigured(i.Deployment, i.Group)
+}
+
+// IsTLSClientAuthConfigured returns true when the spec enables verification of client certificates
+// by servers of the group.
+func IsTLSClientAuthConfigured(spec api.DeploymentSpec, group api.ServerGroup) bool {
+	return spec.IsSecure() && spec.TLS.ClientAuth.IsEnabled() &&
+		GroupTLSClientAuthSupported(spec.GetMode(), group)
+}
+
+// GetTLSClientKeyfilePath returns the path of the client keyfile used by the lifecycle probe.
+func GetTLSClientKeyfilePath() string {
+	return filepath.Join(k8sutil.TLSClientKeyfileVolumeMountDir, constants.SecretTLSKeyfile)
+}
+
+func TLSClientAuth() Builder {
+	return tlsClientAuth{}
+}
+
+type tlsClientAuth struct{}
+
+func (s tlsClientAuth) Envs(i Input) []core.EnvVar {
+	return nil
+}
+
+func (s tlsClientAuth) Verify(i Input, cachedStatus interfaces.Inspector) error {
+	if !IsTLSClientAuthEnabled(i) {
+		return nil
+	}
+
+	name := k8sutil.CreateTLSClientTruststoreSecretName(i.ApiObject.GetName())
+	secret, exists := cachedStatus.Secret(name)
+	if !exists {
+		return errors.Newf("Client truststore secret not found %s", name)
+	}
+
+	if _, ok := secret.Data[constants.SecretCACertificate]; !ok {
+		return errors.Newf("Unable to find secret key %s/%s for client truststore", name, constants.SecretCACertificate)
+	}
+
+	return nil
+}
+
+func (s tlsClientAuth) Volumes(i Input) ([]core.Volume, []core.VolumeMount) {
+	if !IsTLSClientAuthEnabled(i) {
+		return nil, nil
+	}
+
+	volumes := []core.Volume{k8sutil.CreateVolumeWithSecret(k8sutil.TLSClientCAVolumeName, k8sutil.CreateTLSClientTruststoreSecretName(i.ApiObject.GetName()))}
+	volumeMounts := []core.VolumeMount{k8sutil.TLSClientCAVolumeMount()}
+
+	if i.Deployment.TLS.ClientAuth.IsRequired() {
+		// Lifecycle probe needs to present client certificate
+		volumes = append(volumes, k8sutil.CreateVolumeWithSecret(k8sutil.TLSClientKeyfileVolumeName, k8sutil.CreateTLSClientKeyfileSecretName(i.ApiObject.GetName())))
+		volumeMounts = append(volumeMounts, k8sutil.TLSClientKeyfileVolumeMount())
+	}
+
+	return volumes, volumeMounts
+}
+
+func (s tlsClientAuth) Args(i Input) k8sutil.OptionPairs {
+	if !IsTLSClientAuthEnabled(i) {
+		return nil
+	}
+
+	opts := k8sutil.CreateOptionPairs()
+
+	opts.Add("--ssl.cafile", filepath.Join(k8sutil.TLSClientCAVolumeMountDir, constants.SecretCACertificate))
+	if i.Deployment.TLS.ClientAuth.IsRequired() {
+		opts.Add("--ssl.require-peer-certificate", "true")
+	}
+
+	return opts
+}
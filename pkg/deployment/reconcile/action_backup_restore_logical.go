@@ -0,0 +1,164 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/backup/logical"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/rs/zerolog"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	registerAction(api.ActionTypeBackupRestoreLogical, newBackupRestoreLogicalAction)
+}
+
+func newBackupRestoreLogicalAction(log zerolog.Logger, action api.Action, actionCtx ActionContext) Action {
+	a := &actionBackupRestoreLogical{}
+
+	a.actionImpl = newActionImplDefRef(log, action, actionCtx, backupRestoreLogicalTimeout)
+
+	return a
+}
+
+// actionBackupRestoreLogical restores logical backup with arangorestore job.
+// Deployment does not need to have the same shape as the dumped one.
+type actionBackupRestoreLogical struct {
+	// actionImpl implement timeout and member id functions
+	actionImpl
+}
+
+func (a actionBackupRestoreLogical) Start(ctx context.Context) (bool, error) {
+	spec := a.actionCtx.GetSpec()
+	status := a.actionCtx.GetStatus()
+
+	if spec.RestoreFrom == nil {
+		return true, nil
+	}
+
+	if status.Restore != nil {
+		a.log.Warn().Msg("Backup restore status should be nil")
+		return true, nil
+	}
+
+	backupResource, err := a.actionCtx.GetBackup(*spec.RestoreFrom)
+	if err != nil {
+		a.log.Error().Err(err).Msg("Unable to find backup")
+		return true, nil
+	}
+
+	if !backupResource.Spec.IsLogical() || backupResource.Spec.Logical == nil {
+		a.log.Error().Msg("Backup is not a logical backup")
+		return true, nil
+	}
+
+	deployment := a.deploymentObject()
+
+	if err := logical.ValidateDeployment(deployment); err != nil {
+		a.log.Error().Err(err).Msg("Unable to restore logical backup")
+		return true, a.setRestoreResult(api.DeploymentRestoreStateRestoreFailed, err.Error())
+	}
+
+	if storage := backupResource.Spec.Logical.ObjectStorage; storage != nil {
+		if err := logical.EnsureRCloneConfigSecret(a.actionCtx.SecretsInterface(), logical.RCloneConfigSecretName(backupResource.GetName()),
+			storage.CredentialsSecretName, deployment.AsOwner()); err != nil {
+			return false, err
+		}
+	}
+
+	// Remove job which is left after the previous restore
+	propagation := meta.DeletePropagationBackground
+	if err := a.actionCtx.JobsInterface().Delete(logical.RestoreJobName(deployment), &meta.DeleteOptions{
+		PropagationPolicy: &propagation,
+	}); err != nil && !k8sutil.IsNotFound(err) {
+		return false, err
+	}
+
+	if _, err := a.actionCtx.JobsInterface().Create(logical.NewRestoreJob(deployment, backupResource)); err != nil {
+		return false, err
+	}
+
+	if err := a.actionCtx.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+		s.Restore = &api.DeploymentRestoreResult{
+			RequestedFrom: spec.GetRestoreFrom(),
+			State:         api.DeploymentRestoreStateRestoring,
+		}
+
+		return true
+	}, true); err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+func (a actionBackupRestoreLogical) CheckProgress(ctx context.Context) (bool, bool, error) {
+	job, err := a.actionCtx.JobsInterface().Get(logical.RestoreJobName(a.deploymentObject()), meta.GetOptions{})
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			return true, false, a.setRestoreResult(api.DeploymentRestoreStateRestoreFailed, "restore job does not exist anymore")
+		}
+
+		return false, false, err
+	}
+
+	switch state, message := logical.GetJobState(job); state {
+	case logical.JobStateSucceeded:
+		return true, false, a.setRestoreResult(api.DeploymentRestoreStateRestored, "")
+	case logical.JobStateFailed:
+		a.log.Error().Str("message", message).Msg("Restore failed")
+		return true, false, a.setRestoreResult(api.DeploymentRestoreStateRestoreFailed, message)
+	default:
+		return false, false, nil
+	}
+}
+
+func (a actionBackupRestoreLogical) setRestoreResult(state api.DeploymentRestoreState, message string) error {
+	spec := a.actionCtx.GetSpec()
+
+	return a.actionCtx.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+		s.Restore = &api.DeploymentRestoreResult{
+			RequestedFrom: spec.GetRestoreFrom(),
+			State:         state,
+			Message:       message,
+		}
+
+		return true
+	})
+}
+
+// deploymentObject returns deployment with the current spec and status used to render the restore job
+func (a actionBackupRestoreLogical) deploymentObject() *api.ArangoDeployment {
+	obj := a.actionCtx.GetAPIObject()
+
+	return &api.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+			UID:       obj.GetUID(),
+		},
+		Spec:   a.actionCtx.GetSpec(),
+		Status: a.actionCtx.GetStatus(),
+	}
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/arangodb/go-driver/agency"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	v1 "k8s.io/api/core/v1"
+	batchv1 "k8s.io/client-go/kubernetes/typed/batch/v1"
 
 	"github.com/arangodb/arangosync-client/client"
 	driver "github.com/arangodb/go-driver"
@@ -122,6 +123,8 @@ type ActionContext interface {
 	// WithStatusUpdate update status of ArangoDeployment with defined modifier. If action returns True action is taken
 	UpdateClusterCondition(conditionType api.ConditionType, status bool, reason, message string) error
 	SecretsInterface() k8sutil.SecretInterface
+	// JobsInterface return job interface
+	JobsInterface() batchv1.JobInterface
 	// WithStatusUpdate update status of ArangoDeployment with defined modifier. If action returns True action is taken
 	WithStatusUpdate(action func(s *api.DeploymentStatus) bool, force ...bool) error
 	// GetBackup receives information about a backup resource
@@ -176,6 +179,10 @@ func (ac *actionContext) SecretsInterface() k8sutil.SecretInterface {
 	return ac.context.SecretsInterface()
 }
 
+func (ac *actionContext) JobsInterface() batchv1.JobInterface {
+	return ac.context.JobsInterface()
+}
+
 func (ac *actionContext) GetShardSyncStatus() bool {
 	return ac.context.GetShardSyncStatus()
 }
@@ -30,8 +30,11 @@ import (
 
 	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
 
+	"github.com/arangodb/kube-arangodb/pkg/deployment/encryption"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/pod"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"k8s.io/apimachinery/pkg/types"
 
@@ -78,19 +81,47 @@ func (a *encryptionKeyAddAction) Start(ctx context.Context) (bool, error) {
 		return true, nil
 	}
 
-	secret := a.actionCtx.GetSpec().RocksDB.Encryption.GetKeySecretName()
-	if s, ok := a.action.Params[secretActionParam]; ok {
-		secret = s
-	}
+	var sha string
+	var d []byte
 
-	sha, d, exists, err := pod.GetEncryptionKey(a.actionCtx.SecretsInterface(), secret)
-	if err != nil {
-		a.log.Error().Err(err).Msgf("Unable to fetch current encryption key")
-		return true, nil
-	}
+	if secret, ok := a.action.Params[secretActionParam]; ok {
+		// Secret given in the action keeps the plain key
+		keySha, key, exists, err := pod.GetEncryptionKey(a.actionCtx.SecretsInterface(), secret)
+		if err != nil {
+			a.log.Error().Err(err).Msgf("Unable to fetch current encryption key")
+			return true, nil
+		}
 
-	if !exists {
-		return true, nil
+		if !exists {
+			return true, nil
+		}
+
+		sha, d = keySha, key
+	} else {
+		spec := a.actionCtx.GetSpec().RocksDB.Encryption
+
+		s, err := a.actionCtx.SecretsInterface().Get(spec.GetKeySecretName(), meta.GetOptions{})
+		if err != nil {
+			if k8sutil.IsNotFound(err) {
+				return true, nil
+			}
+			a.log.Error().Err(err).Msgf("Unable to fetch current encryption key")
+			return true, nil
+		}
+
+		provider, err := encryption.NewKeyProvider(spec, a.actionCtx.SecretsInterface())
+		if err != nil {
+			a.log.Error().Err(err).Msgf("Unable to create encryption key provider")
+			return true, nil
+		}
+
+		keySha, key, err := provider.Key(ctx, s)
+		if err != nil {
+			a.log.Error().Err(err).Msgf("Unable to fetch current encryption key")
+			return true, nil
+		}
+
+		sha, d = keySha, key
 	}
 
 	p := patch.NewPatch()
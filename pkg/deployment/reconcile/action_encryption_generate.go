@@ -0,0 +1,98 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/encryption"
+	"github.com/rs/zerolog"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	registerAction(api.ActionTypeEncryptionKeyGenerate, newEncryptionKeyGenerate)
+}
+
+func newEncryptionKeyGenerate(log zerolog.Logger, action api.Action, actionCtx ActionContext) Action {
+	a := &encryptionKeyGenerateAction{}
+
+	a.actionImpl = newActionImplDefRef(log, action, actionCtx, defaultTimeout)
+
+	return a
+}
+
+// encryptionKeyGenerateAction replaces the key in the key secret with a new one created by the key provider.
+// Key is added to the keyfolder and old key is removed by the regular encryption plan.
+type encryptionKeyGenerateAction struct {
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+func (a *encryptionKeyGenerateAction) Start(ctx context.Context) (bool, error) {
+	if err := ensureEncryptionSupport(a.actionCtx); err != nil {
+		a.log.Error().Err(err).Msgf("Action not supported")
+		return true, nil
+	}
+
+	spec := a.actionCtx.GetSpec().RocksDB.Encryption
+
+	s, ok := a.actionCtx.GetCachedStatus().Secret(spec.GetKeySecretName())
+	if !ok {
+		a.log.Error().Msgf("Encryption key secret is missing, no rotation will take place")
+		return true, nil
+	}
+
+	provider, err := encryption.NewKeyProvider(spec, a.actionCtx.SecretsInterface())
+	if err != nil {
+		a.log.Error().Err(err).Msgf("Unable to create encryption key provider")
+		return true, nil
+	}
+
+	keyID, data, err := provider.Generate(ctx)
+	if err != nil {
+		return false, errors.Wrapf(err, "Unable to generate encryption key")
+	}
+
+	s = s.DeepCopy()
+	s.Data = data
+
+	if _, err := a.actionCtx.SecretsInterface().Update(s); err != nil {
+		return false, errors.Wrapf(err, "Unable to update secret: %s", spec.GetKeySecretName())
+	}
+
+	if err := a.actionCtx.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+		now := meta.NewTime(time.Now())
+		s.Hashes.Encryption.RotatedAt = &now
+		return true
+	}); err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	a.log.Info().Str("key-id", keyID).Msgf("New encryption key generated")
+
+	return true, nil
+}
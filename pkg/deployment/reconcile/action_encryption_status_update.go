@@ -64,22 +64,28 @@ func (a *encryptionKeyStatusUpdateAction) Start(ctx context.Context) (bool, erro
 	}
 
 	keyHashes := secretKeysToListWithPrefix("sha256:", f)
+	keyID, keyIDFound := getEncryptionCurrentKeyID(a.actionCtx.GetSpec(), a.actionCtx.GetCachedStatus(), f)
+
+	if err = a.actionCtx.WithStatusUpdate(func(s *api.DeploymentStatus) (update bool) {
+		if keyIDFound && s.Hashes.Encryption.KeyID != keyID {
+			s.Hashes.Encryption.KeyID = keyID
+			update = true
+		}
 
-	if err = a.actionCtx.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
 		if len(keyHashes) == 0 {
 			if s.Hashes.Encryption.Keys != nil {
 				s.Hashes.Encryption.Keys = nil
 				return true
 			}
 
-			return false
+			return
 		}
 
 		if !util.CompareStringArray(keyHashes, s.Hashes.Encryption.Keys) {
 			s.Hashes.Encryption.Keys = keyHashes
 			return true
 		}
-		return false
+		return
 	}); err != nil {
 		return false, err
 	}
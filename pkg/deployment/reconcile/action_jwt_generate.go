@@ -0,0 +1,118 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/rs/zerolog"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func init() {
+	registerAction(api.ActionTypeJWTGenerate, newJWTGenerate)
+}
+
+func newJWTGenerate(log zerolog.Logger, action api.Action, actionCtx ActionContext) Action {
+	a := &jwtGenerateAction{}
+
+	a.actionImpl = newActionImplDefRef(log, action, actionCtx, defaultTimeout)
+
+	return a
+}
+
+// jwtGenerateAction replaces the JWT secret with a new random key.
+// Key is added to the folder and activated by the regular JWT rotation plan.
+type jwtGenerateAction struct {
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+func (a *jwtGenerateAction) Start(ctx context.Context) (bool, error) {
+	folder, err := ensureJWTFolderSupportFromAction(a.actionCtx)
+	if err != nil {
+		a.log.Error().Err(err).Msgf("Action not supported")
+		return true, nil
+	}
+
+	if !folder {
+		a.log.Error().Msgf("Action not supported")
+		return true, nil
+	}
+
+	secretName := a.actionCtx.GetSpec().Authentication.GetJWTSecretName()
+
+	s, ok := a.actionCtx.GetCachedStatus().Secret(secretName)
+	if !ok {
+		a.log.Error().Msgf("JWT Secret is missing, no rotation will take place")
+		return true, nil
+	}
+
+	tokenData := make([]byte, 32)
+	if _, err := rand.Read(tokenData); err != nil {
+		return false, errors.WithStack(err)
+	}
+	token := hex.EncodeToString(tokenData)
+
+	p := patch.NewPatch()
+	path := patch.NewPath("data", constants.SecretKeyToken)
+	if _, ok := s.Data[constants.SecretKeyToken]; ok {
+		p.ItemReplace(path, base64.StdEncoding.EncodeToString([]byte(token)))
+	} else {
+		p.ItemAdd(path, base64.StdEncoding.EncodeToString([]byte(token)))
+	}
+
+	patch, err := p.Marshal()
+	if err != nil {
+		a.log.Error().Err(err).Msgf("Unable to encrypt patch")
+		return true, nil
+	}
+
+	if _, err := a.actionCtx.SecretsInterface().Patch(secretName, types.JSONPatchType, patch); err != nil {
+		if !k8sutil.IsInvalid(err) {
+			return false, errors.Wrapf(err, "Unable to update secret: %s", secretName)
+		}
+	}
+
+	if err := a.actionCtx.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+		now := meta.NewTime(time.Now())
+		s.Hashes.JWT.RotatedAt = &now
+		return true
+	}); err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	a.log.Info().Msgf("New JWT key generated")
+
+	return true, nil
+}
@@ -123,5 +123,18 @@ func (a *jwtSetActiveAction) Start(ctx context.Context) (bool, error) {
 		}
 	}
 
+	if spec := a.actionCtx.GetSpec(); active && spec.Authentication.IsRotationEnabled() {
+		// Keep previous key to accept tokens signed with it during the overlap period
+		retired := util.SHA256(activeKeyData)
+		if retired != toActiveChecksum {
+			if err := a.actionCtx.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+				s.Hashes.JWT.Retired = retireJWTKey(s.Hashes.JWT.Retired, retired, spec.Authentication.GetRotationRetention())
+				return true
+			}); err != nil {
+				return false, errors.WithStack(err)
+			}
+		}
+	}
+
 	return true, nil
 }
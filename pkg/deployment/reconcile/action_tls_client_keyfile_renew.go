@@ -0,0 +1,66 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/resources"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/rs/zerolog"
+)
+
+func init() {
+	registerAction(api.ActionTypeRenewTLSClientKeyfile, newRenewTLSClientKeyfileAction)
+}
+
+func newRenewTLSClientKeyfileAction(log zerolog.Logger, action api.Action, actionCtx ActionContext) Action {
+	a := &renewTLSClientKeyfileAction{}
+
+	a.actionImpl = newActionImplDefRef(log, action, actionCtx, operationTLSCACertificateTimeout)
+
+	return a
+}
+
+// renewTLSClientKeyfileAction replaces the client keyfile used by the operator with a new one.
+type renewTLSClientKeyfileAction struct {
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+func (a *renewTLSClientKeyfileAction) Start(ctx context.Context) (bool, error) {
+	spec := a.actionCtx.GetSpec()
+	if !spec.TLS.IsSecure() || !spec.TLS.ClientAuth.IsEnabled() {
+		return true, nil
+	}
+
+	if err := resources.RenewOperatorClientKeyfile(a.log, a.actionCtx.SecretsInterface(), a.actionCtx.GetName(), spec.TLS); err != nil {
+		if k8sutil.IsNotFound(err) {
+			// Keyfile is created again by the inspection
+			return true, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
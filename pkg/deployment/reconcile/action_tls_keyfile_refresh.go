@@ -60,6 +60,11 @@ func (a *refreshTLSKeyfileCertificateAction) CheckProgress(ctx context.Context)
 
 	s, exists := a.actionCtx.GetCachedStatus().Secret(k8sutil.CreateTLSKeyfileSecretName(a.actionCtx.GetAPIObject().GetName(), a.action.Group.AsRole(), a.action.MemberID))
 	if !exists {
+		if a.actionCtx.GetSpec().TLS.IsExternallyIssued() {
+			// Certificate is not yet signed by the external issuer
+			a.log.Debug().Msg("Keyfile secret is not yet issued")
+			return false, false, nil
+		}
 		a.log.Warn().Msg("Keyfile secret is missing")
 		return true, false, nil
 	}
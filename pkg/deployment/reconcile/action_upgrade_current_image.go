@@ -71,6 +71,11 @@ func (a *setCurrentImageAction) CheckProgress(ctx context.Context) (bool, bool,
 	if !found {
 		return false, false, nil
 	}
+	if err := a.actionCtx.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+		return updateUpgradeStatus(s, imageInfo)
+	}); err != nil {
+		return false, false, errors.WithStack(err)
+	}
 	if err := a.actionCtx.SetCurrentImage(imageInfo); err != nil {
 		return false, false, errors.WithStack(err)
 	}
@@ -29,6 +29,7 @@ import (
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/rs/zerolog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func init() {
@@ -66,6 +67,18 @@ func (a *actionUpgradeMember) Start(ctx context.Context) (bool, error) {
 	if err := a.actionCtx.UpdateMember(m); err != nil {
 		return false, errors.WithStack(err)
 	}
+	// Keep track of the first upgraded member
+	if err := a.actionCtx.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+		if u := s.Upgrade; u != nil && !u.RolledBack && u.FirstMember == "" && m.Image != nil && m.Image.Image == u.ToImage {
+			now := metav1.Now()
+			u.FirstMember = m.ID
+			u.StartTime = &now
+			return true
+		}
+		return false
+	}); err != nil {
+		return false, errors.WithStack(err)
+	}
 	if group.IsArangod() {
 		// Invoke shutdown endpoint
 		c, err := a.actionCtx.GetServerClient(ctx, group, a.action.MemberID)
@@ -117,6 +130,16 @@ func (a *actionUpgradeMember) CheckProgress(ctx context.Context) (bool, bool, er
 		if m.Conditions.IsTrue(api.ConditionTypeTerminated) {
 			if m.Conditions.IsTrue(api.ConditionTypeUpgradeFailed) {
 				a.log.Error().Msgf("Upgrade of member failed")
+
+				if err := a.actionCtx.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+					if u := s.Upgrade; u != nil && !u.RolledBack && u.FirstMember == m.ID {
+						u.Failures++
+						return true
+					}
+					return false
+				}); err != nil {
+					return false, false, errors.WithStack(err)
+				}
 			}
 			// Invalidate plan
 			m.Phase = ""
@@ -136,6 +159,15 @@ func (a *actionUpgradeMember) CheckProgress(ctx context.Context) (bool, bool, er
 		}
 	}
 
+	if upgradeSpec := a.actionCtx.GetSpec().Upgrade.Get(); upgradeSpec.IsRollbackEnabled() {
+		if u := a.actionCtx.GetStatus().Upgrade; u != nil && !u.RolledBack && u.FirstMember == m.ID &&
+			u.IsTimedOut(upgradeSpec.GetTimeout().AsDuration()) {
+			// Abort the plan, so the upgrade can be rolled back
+			log.Error().Msgf("Upgrade of first member timed out")
+			return false, true, nil
+		}
+	}
+
 	log = log.With().
 		Str("pod-name", m.PodName).
 		Bool("is-upgrading", isUpgrading).Logger()
@@ -159,5 +191,17 @@ func (a *actionUpgradeMember) CheckProgress(ctx context.Context) (bool, bool, er
 	if err := a.actionCtx.UpdateMember(m); err != nil {
 		return false, false, errors.WithStack(err)
 	}
+	if isUpgrading {
+		// First member is upgraded, upgrade timeout is not checked anymore
+		if err := a.actionCtx.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+			if u := s.Upgrade; u != nil && u.FirstMember == m.ID && u.StartTime != nil {
+				u.StartTime = nil
+				return true
+			}
+			return false
+		}); err != nil {
+			return false, false, errors.WithStack(err)
+		}
+	}
 	return isUpgrading, false, nil
 }
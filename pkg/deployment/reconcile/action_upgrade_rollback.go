@@ -0,0 +1,98 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// upgradeRollbackReset is set when the upgrade tracking should be removed instead of marked as rolled back
+	upgradeRollbackReset = "reset"
+)
+
+func init() {
+	registerAction(api.ActionTypeUpgradeRollback, newUpgradeRollbackAction)
+}
+
+// newUpgradeRollbackAction creates a new Action that implements the given
+// planned UpgradeRollback action.
+func newUpgradeRollbackAction(log zerolog.Logger, action api.Action, actionCtx ActionContext) Action {
+	a := &upgradeRollbackAction{}
+
+	a.actionImpl = newActionImplDefRef(log, action, actionCtx, defaultTimeout)
+
+	return a
+}
+
+// upgradeRollbackAction marks the upgrade of the deployment as rolled back.
+type upgradeRollbackAction struct {
+	actionImpl
+
+	actionEmptyCheckProgress
+}
+
+// Start performs the start of the action.
+// Returns true if the action is completely finished, false in case
+// the start time needs to be recorded and a ready condition needs to be checked.
+func (a *upgradeRollbackAction) Start(ctx context.Context) (bool, error) {
+	var event *k8sutil.Event
+
+	if err := a.actionCtx.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+		if a.action.Params[upgradeRollbackReset] == conditionTrue {
+			if s.Upgrade == nil {
+				return false
+			}
+
+			s.Upgrade = nil
+			s.Conditions.Remove(api.ConditionTypeUpgradeRolledBack)
+			return true
+		}
+
+		if s.Upgrade == nil || s.Upgrade.RolledBack {
+			return false
+		}
+
+		s.Upgrade.RolledBack = true
+		s.Conditions.Update(api.ConditionTypeUpgradeRolledBack, true, "Upgrade Rolled Back", a.action.Reason)
+
+		var fromImage string
+		if s.Upgrade.FromImage != nil {
+			fromImage = s.Upgrade.FromImage.Image
+		}
+		event = k8sutil.NewUpgradeRolledBackEvent(a.actionCtx.GetAPIObject(), fromImage, s.Upgrade.ToImage, a.action.Reason)
+
+		return true
+	}); err != nil {
+		return false, err
+	}
+
+	if event != nil {
+		a.log.Warn().Str("reason", a.action.Reason).Msg("Upgrade rolled back")
+		a.actionCtx.CreateEvent(event)
+	}
+
+	return true, nil
+}
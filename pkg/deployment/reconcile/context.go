@@ -37,6 +37,7 @@ import (
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	v1 "k8s.io/api/core/v1"
+	batchv1 "k8s.io/client-go/kubernetes/typed/batch/v1"
 )
 
 // Context provides methods to the reconcile package.
@@ -114,6 +115,8 @@ type Context interface {
 	WithStatusUpdate(action func(s *api.DeploymentStatus) bool, force ...bool) error
 	// SecretsInterface return secret interface
 	SecretsInterface() k8sutil.SecretInterface
+	// JobsInterface return job interface
+	JobsInterface() batchv1.JobInterface
 	// GetBackup receives information about a backup resource
 	GetBackup(backup string) (*backupApi.ArangoBackup, error)
 	// GetName receives deployment name
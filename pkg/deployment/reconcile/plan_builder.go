@@ -40,6 +40,7 @@ import (
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/tracing"
 )
 
 // upgradeDecision is the result of an upgrade check.
@@ -64,7 +65,12 @@ func (d *Reconciler) CreatePlan(ctx context.Context, cachedStatus inspectorInter
 	spec := d.context.GetSpec()
 	status, lastVersion := d.context.GetStatus()
 	builderCtx := newPlanBuilderContext(d.context)
+
+	ctx, span := tracing.Start(ctx, "CreatePlan")
+	defer span.End()
+
 	newPlan, changed := createPlan(ctx, d.log, apiObject, status.Plan, spec, status, cachedStatus, builderCtx)
+	span.SetAttributes(tracing.Bool("plan.changed", changed), tracing.Int("plan.length", len(newPlan)))
 
 	// If not change, we're done
 	if !changed {
@@ -86,6 +92,7 @@ func (d *Reconciler) CreatePlan(ctx context.Context, cachedStatus inspectorInter
 	status.Plan = newPlan
 
 	if err := d.context.UpdateStatus(status, lastVersion); err != nil {
+		span.SetError(err)
 		return errors.WithStack(err), false
 	}
 	return nil, true
@@ -224,6 +231,11 @@ func createPlan(ctx context.Context, log zerolog.Logger, apiObject k8sutil.APIOb
 		plan = pb.Apply(createReplaceMemberPlan)
 	}
 
+	// Check for failed upgrades which should be rolled back
+	if plan.IsEmpty() {
+		plan = pb.Apply(createUpgradeRollbackPlan)
+	}
+
 	// Check for the need to rotate one or more members
 	if plan.IsEmpty() {
 		plan = pb.Apply(createRotateOrUpgradePlan)
@@ -250,6 +262,14 @@ func createPlan(ctx context.Context, log zerolog.Logger, apiObject k8sutil.APIOb
 		plan = pb.Apply(createKeyfileRenewalPlan)
 	}
 
+	if plan.IsEmpty() {
+		plan = pb.Apply(createKeyfileExpiryRenewalPlan)
+	}
+
+	if plan.IsEmpty() {
+		plan = pb.Apply(createClientKeyfileExpiryRenewalPlan)
+	}
+
 	// Check for changes storage classes or requirements
 	if plan.IsEmpty() {
 		plan = pb.Apply(createRotateServerStoragePlan)
@@ -267,6 +287,10 @@ func createPlan(ctx context.Context, log zerolog.Logger, apiObject k8sutil.APIOb
 		plan = pb.ApplySubPlan(createEncryptionKeyStatusPropagatedFieldUpdate, createEncryptionKeyCleanPlan)
 	}
 
+	if plan.IsEmpty() {
+		plan = pb.ApplySubPlan(createEncryptionKeyStatusPropagatedFieldUpdate, createEncryptionKeyRotationPlan)
+	}
+
 	if plan.IsEmpty() {
 		plan = pb.ApplySubPlan(createTLSStatusPropagatedFieldUpdate, createCACleanPlan)
 	}
@@ -308,6 +332,36 @@ func createRotateMemberPlan(log zerolog.Logger, member api.MemberStatus,
 	return plan
 }
 
+// createRotateMemberBatchPlan creates a plan to rotate the given members of a group at the same time.
+// All members are restarted first, then the plan waits until every one of them is back up and in sync.
+func createRotateMemberBatchPlan(log zerolog.Logger, members api.MemberStatusList,
+	group api.ServerGroup, reason string) api.Plan {
+	if len(members) == 1 {
+		return createRotateMemberPlan(log, members[0], group, reason)
+	}
+
+	var plan api.Plan
+	for _, member := range members {
+		log.Debug().
+			Str("id", member.ID).
+			Str("role", group.AsRole()).
+			Str("reason", reason).
+			Msg("Creating batch rotation plan")
+		plan = append(plan,
+			api.NewAction(api.ActionTypeCleanTLSKeyfileCertificate, group, member.ID, "Remove server keyfile and enforce renewal/recreation"),
+			api.NewAction(api.ActionTypeResignLeadership, group, member.ID, reason),
+			api.NewAction(api.ActionTypeRotateMember, group, member.ID, reason),
+		)
+	}
+	for _, member := range members {
+		plan = append(plan,
+			api.NewAction(api.ActionTypeWaitForMemberUp, group, member.ID),
+			api.NewAction(api.ActionTypeWaitForMemberInSync, group, member.ID),
+		)
+	}
+	return plan
+}
+
 type planBuilder func(ctx context.Context,
 	log zerolog.Logger, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
@@ -51,6 +51,8 @@ type PlanBuilderContext interface {
 	GetPvc(pvcName string) (*core.PersistentVolumeClaim, error)
 	// GetShardSyncStatus returns true if all shards are in sync
 	GetShardSyncStatus() bool
+	// GetDeploymentHealth returns a copy of the latest known state of cluster health
+	GetDeploymentHealth() (driver.ClusterHealth, error)
 	// InvalidateSyncStatus resets the sync state to false and triggers an inspection
 	InvalidateSyncStatus()
 	// GetStatus returns the current status of the deployment
@@ -24,6 +24,9 @@ package reconcile
 
 import (
 	"context"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/deployment/encryption"
 
 	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
 
@@ -103,7 +106,7 @@ func createEncryptionKey(ctx context.Context,
 		return nil
 	}
 
-	name, _, err := pod.GetEncryptionKeyFromSecret(secret)
+	name, err := encryption.GetKeyIDFromSecret(spec.RocksDB.Encryption, secret)
 	if err != nil {
 		log.Error().Err(err).Msgf("Unable to fetch encryption key")
 		return nil
@@ -180,9 +183,80 @@ func createEncryptionKeyStatusUpdateRequired(ctx context.Context,
 		return true
 	}
 
+	if keyID, ok := getEncryptionCurrentKeyID(spec, cachedStatus, keyfolder); ok && keyID != status.Hashes.Encryption.KeyID {
+		return true
+	}
+
 	return false
 }
 
+// getEncryptionCurrentKeyID returns the ID of the key from the key secret, if it is already present in the keyfolder.
+func getEncryptionCurrentKeyID(spec api.DeploymentSpec, cachedStatus inspectorInterface.Inspector, keyfolder *core.Secret) (string, bool) {
+	secret, exists := cachedStatus.Secret(spec.RocksDB.Encryption.GetKeySecretName())
+	if !exists {
+		return "", false
+	}
+
+	keyID, err := encryption.GetKeyIDFromSecret(spec.RocksDB.Encryption, secret)
+	if err != nil {
+		return "", false
+	}
+
+	if _, ok := keyfolder.Data[keyID]; !ok {
+		return "", false
+	}
+
+	return keyID, true
+}
+
+// createEncryptionKeyRotationPlan generates a new encryption key when the rotation interval passed.
+// It is done only when previous key was propagated and retired.
+func createEncryptionKeyRotationPlan(ctx context.Context,
+	log zerolog.Logger, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	cachedStatus inspectorInterface.Inspector, context PlanBuilderContext) api.Plan {
+	if skipEncryptionPlan(spec, status) || !spec.RocksDB.Encryption.IsRotationEnabled() {
+		return nil
+	}
+
+	if !status.Hashes.Encryption.Propagated {
+		return nil
+	}
+
+	keyfolder, exists := cachedStatus.Secret(pod.GetEncryptionFolderSecretName(context.GetName()))
+	if !exists || len(keyfolder.Data) != 1 {
+		return nil
+	}
+
+	secret, exists := cachedStatus.Secret(spec.RocksDB.Encryption.GetKeySecretName())
+	if !exists {
+		return nil
+	}
+
+	if !isEncryptionKeyRotationRequired(spec, status, secret, time.Now()) {
+		return nil
+	}
+
+	return api.Plan{
+		api.NewAction(api.ActionTypeEncryptionKeyGenerate, api.ServerGroupUnknown, "", "Generate new encryption key"),
+	}
+}
+
+// isEncryptionKeyRotationRequired returns true when the rotation interval passed since the last rotation.
+// Creation of the key secret is taken into account if no rotation took place yet.
+func isEncryptionKeyRotationRequired(spec api.DeploymentSpec, status api.DeploymentStatus, secret *core.Secret, now time.Time) bool {
+	if !spec.RocksDB.Encryption.IsRotationEnabled() {
+		return false
+	}
+
+	last := secret.GetCreationTimestamp().Time
+	if r := status.Hashes.Encryption.RotatedAt; r != nil {
+		last = r.Time
+	}
+
+	return now.Sub(last) >= spec.RocksDB.Encryption.GetRotationInterval().AsDuration()
+}
+
 func createEncryptionKeyCleanPlan(ctx context.Context,
 	log zerolog.Logger, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
@@ -212,7 +286,7 @@ func createEncryptionKeyCleanPlan(ctx context.Context,
 		return nil
 	}
 
-	name, _, err := pod.GetEncryptionKeyFromSecret(secret)
+	name, err := encryption.GetKeyIDFromSecret(spec.RocksDB.Encryption, secret)
 	if err != nil {
 		return nil
 	}
@@ -39,6 +39,7 @@ import (
 	core "k8s.io/api/core/v1"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	shared "github.com/arangodb/kube-arangodb/pkg/apis/shared/v1"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/pod"
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/constants"
@@ -102,6 +103,11 @@ func createJWTKeyUpdate(ctx context.Context,
 		return addJWTPropagatedPlanAction(status, api.NewAction(api.ActionTypeJWTSetActive, api.ServerGroupUnknown, "", "Set active key").AddParam(checksum, jwtSha))
 	}
 
+	var retained shared.HashList
+	if spec.Authentication.IsRotationEnabled() {
+		retained = status.Hashes.JWT.Retired
+	}
+
 	for key := range folder.Data {
 		if key == pod.ActiveJWTKey || key == constants.SecretKeyToken {
 			continue
@@ -111,12 +117,58 @@ func createJWTKeyUpdate(ctx context.Context,
 			continue
 		}
 
+		if retained.ContainsSHA256(key) {
+			continue
+		}
+
 		return addJWTPropagatedPlanAction(status, api.NewAction(api.ActionTypeJWTClean, api.ServerGroupUnknown, "", "Remove old key").AddParam(checksum, key))
 	}
 
+	if isJWTRotationRequired(spec, status, s, time.Now()) {
+		return addJWTPropagatedPlanAction(status, api.NewAction(api.ActionTypeJWTGenerate, api.ServerGroupUnknown, "", "Generate new key"))
+	}
+
 	return addJWTPropagatedPlanAction(status)
 }
 
+// isJWTRotationRequired returns true when the scheduled rotation interval passed since the last rotation.
+// Creation of the JWT secret is taken into account if no rotation took place yet.
+func isJWTRotationRequired(spec api.DeploymentSpec, status api.DeploymentStatus, secret *core.Secret, now time.Time) bool {
+	if !spec.Authentication.IsRotationEnabled() {
+		return false
+	}
+
+	last := secret.GetCreationTimestamp().Time
+	if r := status.Hashes.JWT.RotatedAt; r != nil {
+		last = r.Time
+	}
+
+	return now.Sub(last) >= spec.Authentication.GetRotationInterval().AsDuration()
+}
+
+// retireJWTKey adds the key to the front of retired keys list and drops keys above the retention.
+func retireJWTKey(retired shared.HashList, key string, retention int) shared.HashList {
+	if retention <= 0 {
+		return nil
+	}
+
+	r := shared.HashList{fmt.Sprintf("sha256:%s", key)}
+
+	for _, k := range retired {
+		if len(r) >= retention {
+			break
+		}
+
+		if r.Contains(k) {
+			continue
+		}
+
+		r = append(r, k)
+	}
+
+	return r
+}
+
 func createJWTStatusUpdate(ctx context.Context,
 	log zerolog.Logger, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
@@ -60,6 +60,13 @@ func createRestorePlan(ctx context.Context,
 			return nil
 		}
 
+		if backup.Spec.IsLogical() {
+			// Logical backup is restored with arangorestore, so encryption keys and shape of the deployment do not matter
+			return api.Plan{
+				api.NewAction(api.ActionTypeBackupRestoreLogical, api.ServerGroupUnknown, ""),
+			}
+		}
+
 		if spec.RocksDB.IsEncrypted() {
 			if ok, p := createRestorePlanEncryption(ctx, log, spec, status, context, backup); !ok {
 				return nil
@@ -75,8 +75,24 @@ func createRotateOrUpgradePlanInternal(log zerolog.Logger, apiObject k8sutil.API
 	var upgradeNotAllowed bool
 	var fromVersion, toVersion driver.Version
 	var fromLicense, toLicense upgraderules.License
+	var upgradeOnHold bool
 
-	status.Members.ForeachServerGroup(func(group api.ServerGroup, members api.MemberStatusList) error {
+	status.Members.ForeachServerInGroups(func(group api.ServerGroup, members api.MemberStatusList) error {
+		// Stateless groups can be rotated in batches of up to maxUnavailable members
+		maxUnavailable := spec.GetServerGroupSpec(group).GetMaxUnavailable()
+		var rotateBatch api.MemberStatusList
+		var rotateBatchReason string
+
+		defer func() {
+			if newPlan.IsEmpty() && len(rotateBatch) > 0 {
+				newPlan = createRotateMemberBatchPlan(log, rotateBatch, group, rotateBatchReason)
+			}
+		}()
+
+		if !upgradeOnHold && newPlan.IsEmpty() && !upgradeStageAllowed(log, apiObject, spec, status, group, members, context) {
+			// Next upgrade stage is not allowed yet, groups later in the order have to wait as well
+			upgradeOnHold = true
+		}
 
 		for _, m := range members {
 			if m.Phase != api.MemberPhaseCreated || m.PodName == "" {
@@ -92,9 +108,20 @@ func createRotateOrUpgradePlanInternal(log zerolog.Logger, apiObject k8sutil.API
 			// Got pod, compare it with what it should be
 			decision := podNeedsUpgrading(log, m, spec, status.Images)
 			if decision.Hold {
+				rotateBatch = nil
 				return nil
 			}
 
+			if decision.UpgradeNeeded && status.Upgrade.IsRolledBack(spec.GetImage()) {
+				// Upgrade to this image was rolled back, keep member on its current image
+				decision = upgradeDecision{}
+			}
+
+			if decision.UpgradeNeeded && upgradeOnHold {
+				// Upgrade is paused between stages
+				continue
+			}
+
 			if decision.UpgradeNeeded && !decision.UpgradeAllowed {
 				// Oops, upgrade is not allowed
 				upgradeNotAllowed = true
@@ -111,6 +138,10 @@ func createRotateOrUpgradePlanInternal(log zerolog.Logger, apiObject k8sutil.API
 			}
 
 			if decision.UpgradeNeeded {
+				if len(rotateBatch) > 0 {
+					// Upgrades are always done one by one, wait for the rotation batch first
+					continue
+				}
 				// Yes, upgrade is needed (and allowed)
 				newPlan = createUpgradeMemberPlan(log, m, group, "Version upgrade", spec, status,
 					!decision.AutoUpgradeNeeded)
@@ -118,6 +149,15 @@ func createRotateOrUpgradePlanInternal(log zerolog.Logger, apiObject k8sutil.API
 				// Use new level of rotate logic
 				rotNeeded, reason := podNeedsRotation(log, pod, apiObject, spec, group, status, m, cachedStatus, context)
 				if rotNeeded {
+					if maxUnavailable > 1 {
+						if len(rotateBatch) < maxUnavailable {
+							if len(rotateBatch) == 0 {
+								rotateBatchReason = reason
+							}
+							rotateBatch = append(rotateBatch, m)
+						}
+						continue
+					}
 					newPlan = createRotateMemberPlan(log, m, group, reason)
 				}
 			}
@@ -128,7 +168,7 @@ func createRotateOrUpgradePlanInternal(log zerolog.Logger, apiObject k8sutil.API
 			}
 		}
 		return nil
-	})
+	}, spec.Upgrade.Get().GetOrder()...)
 
 	status.Members.ForeachServerInGroups(func(group api.ServerGroup, members api.MemberStatusList) error {
 		for _, m := range members {
@@ -205,14 +245,8 @@ func podNeedsUpgrading(log zerolog.Logger, status api.MemberStatus, spec api.Dep
 	// Image changed, check if change is allowed
 	specVersion := currentImage.ArangoDBVersion
 	memberVersion := memberImage.ArangoDBVersion
-	asLicense := func(info api.ImageInfo) upgraderules.License {
-		if info.Enterprise {
-			return upgraderules.LicenseEnterprise
-		}
-		return upgraderules.LicenseCommunity
-	}
-	specLicense := asLicense(currentImage)
-	memberLicense := asLicense(memberImage)
+	specLicense := ImageInfoLicense(currentImage)
+	memberLicense := ImageInfoLicense(memberImage)
 	if err := upgraderules.CheckUpgradeRulesWithLicense(memberVersion, specVersion, memberLicense, specLicense); err != nil {
 		// E.g. 3.x -> 4.x, we cannot allow automatically
 		return upgradeDecision{
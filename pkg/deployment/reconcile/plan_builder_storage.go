@@ -28,6 +28,7 @@ import (
 	"github.com/rs/zerolog"
 	core "k8s.io/api/core/v1"
 
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
@@ -71,11 +72,19 @@ func createRotateServerStoragePlan(ctx context.Context,
 				continue
 			}
 
-			if util.StringOrDefault(pvc.Spec.StorageClassName) != storageClassName && storageClassName != "" {
-				// Storageclass has changed
-				log.Info().Str("pod-name", m.PodName).
-					Str("pvc-storage-class", util.StringOrDefault(pvc.Spec.StorageClassName)).
-					Str("group-storage-class", storageClassName).Msg("Storage class has changed - pod needs replacement")
+			_, evacuate := pvc.GetAnnotations()[deployment.ArangoDeploymentPVCEvacuateAnnotation]
+
+			if evacuate || (util.StringOrDefault(pvc.Spec.StorageClassName) != storageClassName && storageClassName != "") {
+				if evacuate {
+					// Volume needs to be moved out of the node
+					log.Info().Str("pod-name", m.PodName).
+						Str("pvc", pvc.GetName()).Msg("Volume evacuation requested - pod needs replacement")
+				} else {
+					// Storageclass has changed
+					log.Info().Str("pod-name", m.PodName).
+						Str("pvc-storage-class", util.StringOrDefault(pvc.Spec.StorageClassName)).
+						Str("group-storage-class", storageClassName).Msg("Storage class has changed - pod needs replacement")
+				}
 
 				if group == api.ServerGroupDBServers {
 					plan = append(plan,
@@ -94,6 +103,9 @@ func createRotateServerStoragePlan(ctx context.Context,
 						api.NewAction(api.ActionTypeAddMember, group, m.ID),
 						api.NewAction(api.ActionTypeWaitForMemberUp, group, m.ID),
 					)
+				} else if evacuate {
+					// Only agents & dbservers are allowed to move their volumes.
+					context.CreateEvent(k8sutil.NewCannotEvacuateVolumeEvent(apiObject, m.ID, group.AsRole(), "Not supported"))
 				} else {
 					// Only agents & dbservers are allowed to change their storage class.
 					context.CreateEvent(k8sutil.NewCannotChangeStorageClassEvent(apiObject, m.ID, group.AsRole(), "Not supported"))
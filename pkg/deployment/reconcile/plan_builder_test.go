@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
@@ -36,6 +37,7 @@ import (
 	monitoring "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
 
 	policy "k8s.io/api/policy/v1beta1"
+	batchv1 "k8s.io/client-go/kubernetes/typed/batch/v1"
 
 	"github.com/arangodb/kube-arangodb/pkg/deployment/resources/inspector"
 
@@ -49,6 +51,7 @@ import (
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	driver "github.com/arangodb/go-driver"
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
 	"github.com/arangodb/kube-arangodb/pkg/util"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
@@ -65,6 +68,7 @@ type testContext struct {
 	PVC              *core.PersistentVolumeClaim
 	PVCErr           error
 	RecordedEvent    *k8sutil.Event
+	Health           *driver.ClusterHealth
 }
 
 func (c *testContext) GetAuthentication() conn.Auth {
@@ -89,6 +93,10 @@ func (c *testContext) SecretsInterface() k8sutil.SecretInterface {
 	panic("implement me")
 }
 
+func (c *testContext) JobsInterface() batchv1.JobInterface {
+	panic("implement me")
+}
+
 func (c *testContext) WithStatusUpdate(action func(s *api.DeploymentStatus) bool, force ...bool) error {
 	panic("implement me")
 }
@@ -185,7 +193,10 @@ func (c *testContext) DeleteSecret(secretName string) error {
 }
 
 func (c *testContext) GetDeploymentHealth() (driver.ClusterHealth, error) {
-	panic("implement me")
+	if c.Health == nil {
+		return driver.ClusterHealth{}, errors.Newf("No cluster health available")
+	}
+	return *c.Health, nil
 }
 
 func (c *testContext) DisableScalingCluster() error {
@@ -628,6 +639,35 @@ func TestCreatePlan(t *testing.T) {
 			},
 			ExpectedLog: "Storage class has changed - pod needs replacement",
 		},
+		{
+			Name: "Evacuate volume of DBServers",
+			PVCS: map[string]*core.PersistentVolumeClaim{
+				"pvc_test": {
+					ObjectMeta: meta.ObjectMeta{
+						Annotations: map[string]string{
+							deployment.ArangoDeploymentPVCEvacuateAnnotation: "true",
+						},
+					},
+				},
+			},
+			context: &testContext{
+				ArangoDeployment: deploymentTemplate.DeepCopy(),
+			},
+			Helper: func(ad *api.ArangoDeployment) {
+				ad.Status.Members.DBServers[0].Phase = api.MemberPhaseCreated
+				ad.Status.Members.DBServers[0].PersistentVolumeClaimName = "pvc_test"
+			},
+			ExpectedPlan: []api.Action{
+				api.NewAction(api.ActionTypeDisableClusterScaling, api.ServerGroupDBServers, ""),
+				api.NewAction(api.ActionTypeAddMember, api.ServerGroupDBServers, ""),
+				api.NewAction(api.ActionTypeWaitForMemberUp, api.ServerGroupDBServers, ""),
+				api.NewAction(api.ActionTypeCleanOutMember, api.ServerGroupDBServers, ""),
+				api.NewAction(api.ActionTypeShutdownMember, api.ServerGroupDBServers, ""),
+				api.NewAction(api.ActionTypeRemoveMember, api.ServerGroupDBServers, ""),
+				api.NewAction(api.ActionTypeEnableClusterScaling, api.ServerGroupDBServers, ""),
+			},
+			ExpectedLog: "Volume evacuation requested - pod needs replacement",
+		},
 		{
 			Name: "Change Storage for Agents with deprecated storage class name",
 			PVCS: map[string]*core.PersistentVolumeClaim{
@@ -822,7 +862,7 @@ func TestCreatePlan(t *testing.T) {
 			if testCase.Helper != nil {
 				testCase.Helper(testCase.context.ArangoDeployment)
 			}
-			err, _ := r.CreatePlan(ctx, inspector.NewInspectorFromData(testCase.Pods, testCase.Secrets, testCase.PVCS, testCase.Services, testCase.ServiceAccounts, testCase.PDBS, testCase.ServiceMonitors, testCase.ArangoMembers))
+			err, _ := r.CreatePlan(ctx, inspector.NewInspectorFromData(testCase.Pods, testCase.Secrets, testCase.PVCS, testCase.Services, testCase.ServiceAccounts, testCase.PDBS, testCase.ServiceMonitors, testCase.ArangoMembers, nil))
 
 			// Assert
 			if testCase.ExpectedEvent != nil {
@@ -849,3 +889,170 @@ func TestCreatePlan(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateKeyfileExpiryRenewalPlan(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	log := zerolog.Nop()
+	spec := api.DeploymentSpec{
+		Mode: api.NewMode(api.DeploymentModeCluster),
+	}
+	spec.SetDefaults("test")
+
+	var status api.DeploymentStatus
+	status.Members.DBServers = api.MemberStatusList{
+		api.MemberStatus{ID: "valid"},
+		api.MemberStatus{ID: "expiring"},
+	}
+	status.Certificates = api.CertificateStatusList{
+		{
+			Type:     api.CertificateTypeKeyfile,
+			Secret:   "valid-keyfile",
+			Group:    api.ServerGroupDBServers,
+			MemberID: "valid",
+			NotAfter: meta.NewTime(time.Now().Add(30 * 24 * time.Hour)),
+		},
+		{
+			Type:     api.CertificateTypeKeyfile,
+			Secret:   "expiring-keyfile",
+			Group:    api.ServerGroupDBServers,
+			MemberID: "expiring",
+			NotAfter: meta.NewTime(time.Now().Add(time.Hour)),
+		},
+	}
+
+	plan := createKeyfileExpiryRenewalPlan(ctx, log, nil, spec, status, inspector.NewEmptyInspector(), &testContext{})
+	require.NotEmpty(t, plan)
+	assert.Equal(t, api.ActionTypeRenewTLSCertificate, plan[0].Type)
+	assert.Equal(t, "expiring", plan[0].MemberID)
+	for _, action := range plan {
+		assert.Equal(t, "expiring", action.MemberID)
+	}
+
+	// Disabled TLS
+	spec.TLS.CASecretName = util.NewString(api.CASecretNameDisabled)
+	assert.Empty(t, createKeyfileExpiryRenewalPlan(ctx, log, nil, spec, status, inspector.NewEmptyInspector(), &testContext{}))
+}
+
+func TestCreateClientKeyfileExpiryRenewalPlan(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	log := zerolog.Nop()
+	mode := api.TLSClientAuthModeOptional
+	spec := api.DeploymentSpec{
+		Mode: api.NewMode(api.DeploymentModeCluster),
+		TLS: api.TLSSpec{
+			ClientAuth: &api.TLSClientAuthSpec{Mode: &mode},
+		},
+	}
+	spec.SetDefaults("test")
+
+	var status api.DeploymentStatus
+	status.Certificates = api.CertificateStatusList{
+		{
+			Type:     api.CertificateTypeClientKeyfile,
+			Secret:   "test-client-keyfile",
+			NotAfter: meta.NewTime(time.Now().Add(30 * 24 * time.Hour)),
+		},
+	}
+	assert.Empty(t, createClientKeyfileExpiryRenewalPlan(ctx, log, nil, spec, status, inspector.NewEmptyInspector(), &testContext{}))
+
+	status.Certificates[0].NotAfter = meta.NewTime(time.Now().Add(time.Hour))
+	plan := createClientKeyfileExpiryRenewalPlan(ctx, log, nil, spec, status, inspector.NewEmptyInspector(), &testContext{})
+	require.Len(t, plan, 1)
+	assert.Equal(t, api.ActionTypeRenewTLSClientKeyfile, plan[0].Type)
+
+	// Disabled client authentication
+	spec.TLS.ClientAuth = nil
+	assert.Empty(t, createClientKeyfileExpiryRenewalPlan(ctx, log, nil, spec, status, inspector.NewEmptyInspector(), &testContext{}))
+}
+
+func TestCreateRotateMemberBatchPlan(t *testing.T) {
+	log := zerolog.Nop()
+	members := api.MemberStatusList{
+		api.MemberStatus{ID: "crdn1"},
+		api.MemberStatus{ID: "crdn2"},
+	}
+
+	plan := createRotateMemberBatchPlan(log, members, api.ServerGroupCoordinators, "test")
+	require.Len(t, plan, 10)
+
+	// All members are rotated before waiting for any of them
+	for i, id := range []string{"crdn1", "crdn2"} {
+		assert.Equal(t, api.ActionTypeRotateMember, plan[i*3+2].Type)
+		assert.Equal(t, id, plan[i*3+2].MemberID)
+		assert.Equal(t, api.ActionTypeWaitForMemberUp, plan[6+i*2].Type)
+		assert.Equal(t, id, plan[6+i*2].MemberID)
+	}
+
+	// Single member falls back to the regular rotation plan
+	single := createRotateMemberBatchPlan(log, members[:1], api.ServerGroupCoordinators, "test")
+	require.Len(t, single, 5)
+	assert.Equal(t, api.ActionTypeRotateMember, single[2].Type)
+	assert.Equal(t, api.ActionTypeWaitForMemberUp, single[3].Type)
+}
+
+func TestIsJWTRotationRequired(t *testing.T) {
+	now := time.Now()
+	interval := api.Duration("24h")
+
+	secret := &core.Secret{
+		ObjectMeta: meta.ObjectMeta{
+			CreationTimestamp: meta.NewTime(now.Add(-2 * time.Hour)),
+		},
+	}
+
+	spec := api.DeploymentSpec{}
+	spec.SetDefaults("test")
+
+	var status api.DeploymentStatus
+
+	require.False(t, isJWTRotationRequired(spec, status, secret, now))
+
+	spec.Authentication.RotationInterval = &interval
+	require.False(t, isJWTRotationRequired(spec, status, secret, now))
+	require.True(t, isJWTRotationRequired(spec, status, secret, now.Add(23*time.Hour)))
+
+	rotatedAt := meta.NewTime(now.Add(12 * time.Hour))
+	status.Hashes.JWT.RotatedAt = &rotatedAt
+	require.False(t, isJWTRotationRequired(spec, status, secret, now.Add(23*time.Hour)))
+	require.True(t, isJWTRotationRequired(spec, status, secret, now.Add(36*time.Hour)))
+}
+
+func TestRetireJWTKey(t *testing.T) {
+	require.Nil(t, retireJWTKey(nil, "a", 0))
+	require.Equal(t, []string{"sha256:a"}, []string(retireJWTKey(nil, "a", 1)))
+	require.Equal(t, []string{"sha256:b"}, []string(retireJWTKey([]string{"sha256:a"}, "b", 1)))
+	require.Equal(t, []string{"sha256:c", "sha256:b"}, []string(retireJWTKey([]string{"sha256:b", "sha256:a"}, "c", 2)))
+	require.Equal(t, []string{"sha256:a", "sha256:b"}, []string(retireJWTKey([]string{"sha256:b", "sha256:a"}, "a", 3)))
+}
+
+func TestIsEncryptionKeyRotationRequired(t *testing.T) {
+	now := time.Now()
+	interval := api.Duration("24h")
+
+	secret := &core.Secret{
+		ObjectMeta: meta.ObjectMeta{
+			CreationTimestamp: meta.NewTime(now.Add(-2 * time.Hour)),
+		},
+	}
+
+	spec := api.DeploymentSpec{}
+	spec.SetDefaults("test")
+
+	var status api.DeploymentStatus
+
+	require.False(t, isEncryptionKeyRotationRequired(spec, status, secret, now))
+
+	spec.RocksDB.Encryption.KeySecretName = util.NewString("encryption")
+	spec.RocksDB.Encryption.RotationInterval = &interval
+	require.False(t, isEncryptionKeyRotationRequired(spec, status, secret, now))
+	require.True(t, isEncryptionKeyRotationRequired(spec, status, secret, now.Add(23*time.Hour)))
+
+	rotatedAt := meta.NewTime(now.Add(12 * time.Hour))
+	status.Hashes.Encryption.RotatedAt = &rotatedAt
+	require.False(t, isEncryptionKeyRotationRequired(spec, status, secret, now.Add(23*time.Hour)))
+	require.True(t, isEncryptionKeyRotationRequired(spec, status, secret, now.Add(36*time.Hour)))
+}
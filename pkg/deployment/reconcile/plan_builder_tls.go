@@ -47,8 +47,6 @@ import (
 	"github.com/rs/zerolog"
 )
 
-const CertificateRenewalMargin = 7 * 24 * time.Hour
-
 func createTLSStatusPropagatedFieldUpdate(ctx context.Context,
 	log zerolog.Logger, apiObject k8sutil.APIObject,
 	spec api.DeploymentSpec, status api.DeploymentStatus,
@@ -168,7 +166,7 @@ func createCAAppendPlan(ctx context.Context,
 		return nil
 	}
 
-	ca, _, err := resources.GetKeyCertFromSecret(log, caSecret, resources.CACertName, resources.CAKeyName)
+	ca, err := resources.GetCAFromSecret(log, caSecret, spec.TLS)
 	if err != nil {
 		log.Warn().Err(err).Str("secret", spec.TLS.GetCASecretName()).Msg("CA Secret does not contains Cert")
 		return nil
@@ -210,6 +208,11 @@ func createCARenewalPlan(ctx context.Context,
 		return nil
 	}
 
+	if spec.TLS.IsExternallyIssued() {
+		log.Debug().Str("secret", spec.TLS.GetCASecretName()).Msg("CA is managed by external issuer, we wont do anything")
+		return nil
+	}
+
 	caSecret, exists := cachedStatus.Secret(spec.TLS.GetCASecretName())
 	if !exists {
 		log.Warn().Str("secret", spec.TLS.GetCASecretName()).Msg("CA Secret does not exists")
@@ -228,7 +231,7 @@ func createCARenewalPlan(ctx context.Context,
 	}
 
 	for _, ca := range cas {
-		if time.Now().Add(CertificateRenewalMargin).After(ca.NotAfter) {
+		if time.Now().Add(spec.TLS.GetRenewBefore().AsDuration()).After(ca.NotAfter) {
 			// CA will expire soon, renewal needed
 			return api.Plan{api.NewAction(api.ActionTypeRenewTLSCACertificate, api.ServerGroupUnknown, "", "Renew CA Certificate")}
 		}
@@ -252,7 +255,7 @@ func createCACleanPlan(ctx context.Context,
 		return nil
 	}
 
-	ca, _, err := resources.GetKeyCertFromSecret(log, caSecret, resources.CACertName, resources.CAKeyName)
+	ca, err := resources.GetCAFromSecret(log, caSecret, spec.TLS)
 	if err != nil {
 		log.Warn().Err(err).Str("secret", spec.TLS.GetCASecretName()).Msg("CA Secret does not contains Cert")
 		return nil
@@ -378,6 +381,68 @@ func createKeyfileRenewalPlan(ctx context.Context,
 	}
 }
 
+// createKeyfileExpiryRenewalPlan creates plan to renew keyfiles which are about to expire
+func createKeyfileExpiryRenewalPlan(ctx context.Context,
+	log zerolog.Logger, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	cachedStatus inspectorInterface.Inspector, planCtx PlanBuilderContext) api.Plan {
+	if !spec.TLS.IsSecure() {
+		return nil
+	}
+
+	renewBefore := spec.TLS.GetRenewBefore().AsDuration()
+	mode := createKeyfileRenewalPlanMode(spec, status)
+
+	var plan api.Plan
+
+	status.Members.ForeachServerGroup(func(group api.ServerGroup, members api.MemberStatusList) error {
+		if !group.IsArangod() {
+			return nil
+		}
+
+		for _, member := range members {
+			if mode != api.TLSRotateModeInPlace && !plan.IsEmpty() {
+				return nil
+			}
+
+			cert, ok := status.Certificates.GetByMemberID(member.ID)
+			if !ok || cert.ExpiresIn(time.Now()) > renewBefore {
+				continue
+			}
+
+			log.Info().Str("member", member.ID).Time("not-after", cert.NotAfter.Time).Msg("Keyfile is about to expire - renewal required")
+			plan = append(plan, api.NewAction(api.ActionTypeRenewTLSCertificate, group, member.ID, "Renew server keyfile before expiry"))
+			if mode == api.TLSRotateModeInPlace {
+				plan = append(plan, api.NewAction(api.ActionTypeRefreshTLSKeyfileCertificate, group, member.ID, "Renew Member Keyfile"))
+			} else {
+				plan = append(plan, createRotateMemberPlan(log, member, group, "Restart server after keyfile renewal")...)
+			}
+		}
+
+		return nil
+	})
+
+	return plan
+}
+
+// createClientKeyfileExpiryRenewalPlan creates plan to renew the client keyfile of the operator when it is about to expire
+func createClientKeyfileExpiryRenewalPlan(ctx context.Context,
+	log zerolog.Logger, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	cachedStatus inspectorInterface.Inspector, planCtx PlanBuilderContext) api.Plan {
+	if !spec.TLS.IsSecure() || !spec.TLS.ClientAuth.IsEnabled() {
+		return nil
+	}
+
+	cert, ok := status.Certificates.GetByType(api.CertificateTypeClientKeyfile)
+	if !ok || cert.ExpiresIn(time.Now()) > spec.TLS.GetRenewBefore().AsDuration() {
+		return nil
+	}
+
+	log.Info().Time("not-after", cert.NotAfter.Time).Msg("Client keyfile is about to expire - renewal required")
+	return api.Plan{api.NewAction(api.ActionTypeRenewTLSClientKeyfile, api.ServerGroupUnknown, "", "Renew operator client keyfile before expiry")}
+}
+
 func createKeyfileRenewalPlanMode(
 	spec api.DeploymentSpec, status api.DeploymentStatus) api.TLSRotateMode {
 	if !spec.TLS.IsSecure() {
@@ -461,7 +526,7 @@ func keyfileRenewalRequired(ctx context.Context,
 		return false, false
 	}
 
-	ca, _, err := resources.GetKeyCertFromSecret(log, caSecret, resources.CACertName, resources.CAKeyName)
+	ca, err := resources.GetCAFromSecret(log, caSecret, spec.TLS)
 	if err != nil {
 		log.Warn().Err(err).Str("secret", spec.TLS.GetCASecretName()).Msg("CA Secret does not contains Cert")
 		return false, false
@@ -494,7 +559,7 @@ func keyfileRenewalRequired(ctx context.Context,
 			continue
 		}
 
-		if time.Now().Add(CertificateRenewalMargin).After(cert.NotAfter) {
+		if time.Now().Add(spec.TLS.GetRenewBefore().AsDuration()).After(cert.NotAfter) {
 			log.Warn().Msg("Renewal margin exceeded")
 			return true, true
 		}
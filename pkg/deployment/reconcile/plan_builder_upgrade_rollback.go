@@ -0,0 +1,163 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	upgraderules "github.com/arangodb/go-upgrade-rules"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+	"github.com/rs/zerolog"
+)
+
+// createUpgradeRollbackPlan creates a plan to roll back the upgrade of the deployment
+// when the upgrade of the first member keeps failing or does not finish in time.
+func createUpgradeRollbackPlan(ctx context.Context,
+	log zerolog.Logger, apiObject k8sutil.APIObject,
+	spec api.DeploymentSpec, status api.DeploymentStatus,
+	cachedStatus inspectorInterface.Inspector, context PlanBuilderContext) api.Plan {
+	upgrade := status.Upgrade
+	if upgrade == nil || upgrade.FromImage == nil {
+		return nil
+	}
+
+	if upgrade.RolledBack {
+		if spec.GetImage() != upgrade.ToImage {
+			// Image was changed after the rollback, next upgrade starts from scratch
+			return api.Plan{
+				api.NewAction(api.ActionTypeUpgradeRollback, api.ServerGroupUnknown, "", "Deployment image changed").
+					AddParam(upgradeRollbackReset, conditionTrue),
+			}
+		}
+		return nil
+	}
+
+	upgradeSpec := spec.Upgrade.Get()
+	if !upgradeSpec.IsRollbackEnabled() || spec.GetImage() != upgrade.ToImage || upgrade.FirstMember == "" {
+		return nil
+	}
+
+	var reason string
+	if maxFailures := upgradeSpec.GetMaxFailures(); upgrade.Failures >= maxFailures {
+		reason = fmt.Sprintf("Upgrade of member %s failed %d times", upgrade.FirstMember, upgrade.Failures)
+	} else if timeout := upgradeSpec.GetTimeout().AsDuration(); upgrade.IsTimedOut(timeout) {
+		reason = fmt.Sprintf("Upgrade of member %s did not finish within %s", upgrade.FirstMember, timeout)
+	} else {
+		return nil
+	}
+
+	log.Warn().Str("from", upgrade.FromImage.Image).Str("to", upgrade.ToImage).Str("reason", reason).
+		Msg("Creating upgrade rollback plan")
+
+	return createUpgradeRollbackMembersPlan(log, status, *upgrade.FromImage, reason)
+}
+
+// createUpgradeRollbackMembersPlan reverts the current image and all members which are already running the new image,
+// as long as the data format of the member allows to go back to the previous version.
+func createUpgradeRollbackMembersPlan(log zerolog.Logger, status api.DeploymentStatus, from api.ImageInfo, reason string) api.Plan {
+	var rollback api.Plan
+	var kept []string
+
+	status.Members.ForeachServerGroup(func(group api.ServerGroup, members api.MemberStatusList) error {
+		for _, m := range members {
+			if m.Image == nil || m.Image.Image == from.Image {
+				continue
+			}
+
+			if err := upgraderules.CheckUpgradeRulesWithLicense(m.Image.ArangoDBVersion, from.ArangoDBVersion,
+				ImageInfoLicense(*m.Image), ImageInfoLicense(from)); err != nil {
+				log.Warn().Err(err).Str("id", m.ID).Str("role", group.AsRole()).
+					Msg("Member cannot be rolled back, data format is not compatible")
+				kept = append(kept, m.ID)
+				continue
+			}
+
+			rollback = append(rollback,
+				api.NewAction(api.ActionTypeSetMemberCurrentImage, group, m.ID, reason).SetImage(from.Image))
+
+			if m.Phase == api.MemberPhaseCreated {
+				rollback = append(rollback,
+					api.NewAction(api.ActionTypeResignLeadership, group, m.ID, reason),
+					api.NewAction(api.ActionTypeRotateMember, group, m.ID, reason),
+					api.NewAction(api.ActionTypeWaitForMemberUp, group, m.ID),
+				)
+			}
+		}
+		return nil
+	})
+
+	message := reason
+	if len(kept) > 0 {
+		message = fmt.Sprintf("%s. Members %s stay on the new image, data format is not compatible with %s",
+			reason, strings.Join(kept, ", "), from.ArangoDBVersion)
+	}
+
+	plan := api.Plan{
+		api.NewAction(api.ActionTypeUpgradeRollback, api.ServerGroupUnknown, "", message),
+		api.NewAction(api.ActionTypeSetCurrentImage, api.ServerGroupUnknown, "", reason).SetImage(from.Image),
+	}
+
+	if len(rollback) > 0 {
+		plan = append(plan, withMaintenance(rollback...)...)
+	}
+
+	return plan
+}
+
+// ImageInfoLicense returns the license of the given image, used by the upgrade rules
+func ImageInfoLicense(info api.ImageInfo) upgraderules.License {
+	if info.Enterprise {
+		return upgraderules.LicenseEnterprise
+	}
+	return upgraderules.LicenseCommunity
+}
+
+// updateUpgradeStatus starts tracking of the upgrade when the current image changes.
+// Returns true if the status was changed.
+func updateUpgradeStatus(s *api.DeploymentStatus, to api.ImageInfo) bool {
+	if s.CurrentImage == nil || s.CurrentImage.Image == to.Image {
+		return false
+	}
+
+	if u := s.Upgrade; u != nil {
+		if u.ToImage == to.Image {
+			// Already tracked
+			return false
+		}
+
+		if u.RolledBack && u.FromImage != nil && u.FromImage.Image == to.Image {
+			// Rollback in progress
+			return false
+		}
+	}
+
+	s.Upgrade = &api.DeploymentUpgradeStatus{
+		FromImage: s.CurrentImage.DeepCopy(),
+		ToImage:   to.Image,
+	}
+	s.Conditions.Remove(api.ConditionTypeUpgradeRolledBack)
+
+	return true
+}
@@ -0,0 +1,150 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	driver "github.com/arangodb/go-driver"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/resources/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UpgradeRollback_Plan(t *testing.T) {
+	newImageInfoP := func(image string, version driver.Version) *api.ImageInfo {
+		return &api.ImageInfo{Image: image, ImageID: image + "id", ArangoDBVersion: version, Enterprise: true}
+	}
+
+	newSpec := func(image string, strategy api.DeploymentUpgradeStrategy) api.DeploymentSpec {
+		return api.DeploymentSpec{
+			Image:   util.NewString(image),
+			Upgrade: &api.DeploymentUpgradeSpec{Strategy: &strategy, MaxFailures: util.NewInt(2)},
+		}
+	}
+
+	newStatus := func(from, to *api.ImageInfo, failures int) api.DeploymentStatus {
+		var status api.DeploymentStatus
+		status.CurrentImage = to
+		status.Upgrade = &api.DeploymentUpgradeStatus{
+			FromImage:   from,
+			ToImage:     to.Image,
+			FirstMember: "agent1",
+			Failures:    failures,
+		}
+		status.Members.Agents = api.MemberStatusList{
+			{ID: "agent1", Phase: api.MemberPhaseCreated, Image: to},
+			{ID: "agent2", Phase: api.MemberPhaseCreated, Image: from},
+		}
+		return status
+	}
+
+	actionsFor := func(plan api.Plan, id string) []api.ActionType {
+		var r []api.ActionType
+		for _, a := range plan {
+			if a.MemberID == id {
+				r = append(r, a.Type)
+			}
+		}
+		return r
+	}
+
+	createPlan := func(spec api.DeploymentSpec, status api.DeploymentStatus) api.Plan {
+		return createUpgradeRollbackPlan(context.Background(), zerolog.Nop(), nil, spec, status, inspector.NewEmptyInspector(), &testContext{})
+	}
+
+	t.Run("Strategy disabled", func(t *testing.T) {
+		status := newStatus(newImageInfoP("a", "3.7.1"), newImageInfoP("b", "3.7.2"), 5)
+		require.Empty(t, createPlan(newSpec("b", api.DeploymentUpgradeStrategyNone), status))
+	})
+
+	t.Run("Not enough failures", func(t *testing.T) {
+		status := newStatus(newImageInfoP("a", "3.7.1"), newImageInfoP("b", "3.7.2"), 1)
+		require.Empty(t, createPlan(newSpec("b", api.DeploymentUpgradeStrategyRollback), status))
+	})
+
+	t.Run("Rollback of patch upgrade", func(t *testing.T) {
+		status := newStatus(newImageInfoP("a", "3.7.1"), newImageInfoP("b", "3.7.2"), 2)
+		plan := createPlan(newSpec("b", api.DeploymentUpgradeStrategyRollback), status)
+		require.True(t, len(plan) > 2)
+
+		assert.Equal(t, api.ActionTypeUpgradeRollback, plan[0].Type)
+		assert.Equal(t, api.ActionTypeSetCurrentImage, plan[1].Type)
+		assert.Equal(t, "a", plan[1].Image)
+
+		assert.Equal(t, []api.ActionType{api.ActionTypeSetMemberCurrentImage, api.ActionTypeResignLeadership,
+			api.ActionTypeRotateMember, api.ActionTypeWaitForMemberUp}, actionsFor(plan, "agent1"))
+		assert.Empty(t, actionsFor(plan, "agent2"))
+	})
+
+	t.Run("Member with incompatible data format is kept", func(t *testing.T) {
+		status := newStatus(newImageInfoP("a", "3.7.1"), newImageInfoP("b", "3.8.0"), 2)
+		plan := createPlan(newSpec("b", api.DeploymentUpgradeStrategyRollback), status)
+		require.Len(t, plan, 2)
+
+		assert.Equal(t, api.ActionTypeUpgradeRollback, plan[0].Type)
+		assert.Contains(t, plan[0].Reason, "Members agent1 stay on the new image")
+		assert.Equal(t, api.ActionTypeSetCurrentImage, plan[1].Type)
+	})
+
+	t.Run("Image changed after rollback", func(t *testing.T) {
+		status := newStatus(newImageInfoP("a", "3.7.1"), newImageInfoP("b", "3.7.2"), 2)
+		status.Upgrade.RolledBack = true
+
+		require.Empty(t, createPlan(newSpec("b", api.DeploymentUpgradeStrategyRollback), status))
+
+		plan := createPlan(newSpec("c", api.DeploymentUpgradeStrategyRollback), status)
+		require.Len(t, plan, 1)
+		assert.Equal(t, api.ActionTypeUpgradeRollback, plan[0].Type)
+		assert.Equal(t, conditionTrue, plan[0].Params[upgradeRollbackReset])
+	})
+}
+
+func Test_UpgradeRollback_UpdateUpgradeStatus(t *testing.T) {
+	from := api.ImageInfo{Image: "a", ArangoDBVersion: "3.7.1"}
+	to := api.ImageInfo{Image: "b", ArangoDBVersion: "3.7.2"}
+
+	var status api.DeploymentStatus
+	require.False(t, updateUpgradeStatus(&status, to))
+
+	status.CurrentImage = from.DeepCopy()
+	require.True(t, updateUpgradeStatus(&status, to))
+	require.NotNil(t, status.Upgrade)
+	assert.Equal(t, "a", status.Upgrade.FromImage.Image)
+	assert.Equal(t, "b", status.Upgrade.ToImage)
+	require.False(t, updateUpgradeStatus(&status, to))
+
+	// Rollback to the previous image keeps the tracking
+	status.Upgrade.RolledBack = true
+	status.CurrentImage = to.DeepCopy()
+	status.Conditions.Update(api.ConditionTypeUpgradeRolledBack, true, "", "")
+	require.False(t, updateUpgradeStatus(&status, from))
+	assert.True(t, status.Conditions.IsTrue(api.ConditionTypeUpgradeRolledBack))
+
+	// New upgrade resets the tracking
+	require.True(t, updateUpgradeStatus(&status, api.ImageInfo{Image: "c"}))
+	assert.False(t, status.Upgrade.RolledBack)
+	assert.False(t, status.Conditions.IsTrue(api.ConditionTypeUpgradeRolledBack))
+}
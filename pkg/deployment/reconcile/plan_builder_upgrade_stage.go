@@ -0,0 +1,129 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"time"
+
+	driver "github.com/arangodb/go-driver"
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/rs/zerolog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// upgradeStageAllowed returns true if the upgrade of the next member of the group can be started.
+// The upgrade of each group is a stage. With the canary step enabled, the first member of the group is
+// a separate stage and the upgrade pauses until the pause is over or the upgrade is approved.
+// With the health gate enabled, the cluster needs to be healthy before the next stage is started.
+func upgradeStageAllowed(log zerolog.Logger, apiObject metav1.Object, spec api.DeploymentSpec, status api.DeploymentStatus,
+	group api.ServerGroup, members api.MemberStatusList, context PlanBuilderContext) bool {
+	upgradeSpec := spec.Upgrade.Get()
+	if !upgradeSpec.IsCanaryEnabled() && !upgradeSpec.IsHealthGateEnabled() {
+		return true
+	}
+
+	var upgraded api.MemberStatusList
+	pending := 0
+	for _, m := range members {
+		if m.Phase != api.MemberPhaseCreated || m.PodName == "" {
+			continue
+		}
+
+		if decision := podNeedsUpgrading(zerolog.Nop(), m, spec, status.Images); decision.UpgradeNeeded {
+			pending++
+		} else {
+			upgraded = append(upgraded, m)
+		}
+	}
+
+	if pending == 0 {
+		return true
+	}
+
+	log = log.With().Str("role", group.AsRole()).Logger()
+
+	switch {
+	case len(upgraded) == 0:
+		// Upgrade of the group starts
+	case upgradeSpec.IsCanaryEnabled() && len(upgraded) == 1:
+		// Canary member is upgraded
+		if !canaryPauseFinished(log, apiObject, spec, upgradeSpec, upgraded[0]) {
+			return false
+		}
+	default:
+		// Upgrade of the group is in progress
+		return true
+	}
+
+	if upgradeSpec.IsHealthGateEnabled() && !clusterHealthy(log, spec, context) {
+		log.Info().Msg("Upgrade stage is on hold, cluster is not healthy")
+		return false
+	}
+
+	return true
+}
+
+// canaryPauseFinished returns true if the upgrade was approved or the canary member is ready for long enough.
+func canaryPauseFinished(log zerolog.Logger, apiObject metav1.Object, spec api.DeploymentSpec,
+	upgradeSpec api.DeploymentUpgradeSpec, canary api.MemberStatus) bool {
+	if apiObject != nil {
+		if image, ok := apiObject.GetAnnotations()[deployment.ArangoDeploymentUpgradeApproveAnnotation]; ok && image == spec.GetImage() {
+			return true
+		}
+	}
+
+	if pause := upgradeSpec.Canary.GetPause().AsDuration(); pause > 0 {
+		if c, ok := canary.Conditions.Get(api.ConditionTypeReady); ok && c.IsTrue() && time.Since(c.LastTransitionTime.Time) >= pause {
+			return true
+		}
+
+		log.Info().Str("canary", canary.ID).Dur("pause", pause).Msg("Upgrade is paused after canary member")
+		return false
+	}
+
+	log.Info().Str("canary", canary.ID).Str("annotation", deployment.ArangoDeploymentUpgradeApproveAnnotation).
+		Msg("Upgrade is paused after canary member, waiting for approval")
+	return false
+}
+
+// clusterHealthy returns true if all servers of the cluster are reported as good.
+func clusterHealthy(log zerolog.Logger, spec api.DeploymentSpec, context PlanBuilderContext) bool {
+	if spec.GetMode() != api.DeploymentModeCluster {
+		// Deployment health is currently only applicable for clusters
+		return true
+	}
+
+	h, err := context.GetDeploymentHealth()
+	if err != nil {
+		log.Debug().Err(err).Msg("Unable to get cluster health")
+		return false
+	}
+
+	for id, sh := range h.Health {
+		if sh.Status != driver.ServerStatusGood {
+			log.Debug().Str("id", string(id)).Str("status", string(sh.Status)).Msg("Server is not healthy")
+			return false
+		}
+	}
+
+	return true
+}
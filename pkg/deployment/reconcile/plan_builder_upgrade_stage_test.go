@@ -0,0 +1,111 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package reconcile
+
+import (
+	"testing"
+	"time"
+
+	driver "github.com/arangodb/go-driver"
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_UpgradeStage_Allowed(t *testing.T) {
+	from := api.ImageInfo{Image: "a", ImageID: "aid", ArangoDBVersion: "3.7.1", Enterprise: true}
+	to := api.ImageInfo{Image: "b", ImageID: "bid", ArangoDBVersion: "3.7.2", Enterprise: true}
+
+	var status api.DeploymentStatus
+	status.Images = api.ImageInfoList{}.Add(from, to)
+
+	newMember := func(id string, image api.ImageInfo, readySince time.Duration) api.MemberStatus {
+		m := api.MemberStatus{ID: id, Phase: api.MemberPhaseCreated, PodName: id, Image: image.DeepCopy()}
+		m.Conditions = api.ConditionList{
+			{Type: api.ConditionTypeReady, Status: core.ConditionTrue, LastTransitionTime: meta.NewTime(time.Now().Add(-readySince))},
+		}
+		return m
+	}
+
+	newSpec := func(upgrade *api.DeploymentUpgradeSpec) api.DeploymentSpec {
+		return api.DeploymentSpec{
+			Mode:    api.NewMode(api.DeploymentModeCluster),
+			Image:   util.NewString(to.Image),
+			Upgrade: upgrade,
+		}
+	}
+
+	notStarted := api.MemberStatusList{newMember("prmr1", from, time.Hour), newMember("prmr2", from, time.Hour)}
+	canary := api.MemberStatusList{newMember("prmr1", to, time.Minute), newMember("prmr2", from, time.Hour)}
+	finished := api.MemberStatusList{newMember("prmr1", to, time.Minute), newMember("prmr2", to, time.Minute)}
+
+	healthy := &driver.ClusterHealth{Health: map[driver.ServerID]driver.ServerHealth{
+		"prmr1": {Status: driver.ServerStatusGood},
+	}}
+	unhealthy := &driver.ClusterHealth{Health: map[driver.ServerID]driver.ServerHealth{
+		"prmr1": {Status: driver.ServerStatusBad},
+	}}
+
+	allowed := func(spec api.DeploymentSpec, obj meta.Object, members api.MemberStatusList, ctx *testContext) bool {
+		return upgradeStageAllowed(zerolog.Nop(), obj, spec, status, api.ServerGroupDBServers, members, ctx)
+	}
+
+	t.Run("Stages disabled", func(t *testing.T) {
+		spec := newSpec(nil)
+		assert.True(t, allowed(spec, nil, canary, &testContext{}))
+	})
+
+	t.Run("Canary pause", func(t *testing.T) {
+		spec := newSpec(&api.DeploymentUpgradeSpec{Canary: &api.DeploymentUpgradeCanarySpec{}})
+		assert.True(t, allowed(spec, nil, notStarted, &testContext{}))
+		assert.False(t, allowed(spec, nil, canary, &testContext{}))
+		assert.True(t, allowed(spec, nil, finished, &testContext{}))
+
+		approved := &api.ArangoDeployment{}
+		approved.SetAnnotations(map[string]string{deployment.ArangoDeploymentUpgradeApproveAnnotation: to.Image})
+		assert.True(t, allowed(spec, approved, canary, &testContext{}))
+
+		approved.SetAnnotations(map[string]string{deployment.ArangoDeploymentUpgradeApproveAnnotation: "other"})
+		assert.False(t, allowed(spec, approved, canary, &testContext{}))
+	})
+
+	t.Run("Canary pause with duration", func(t *testing.T) {
+		spec := newSpec(&api.DeploymentUpgradeSpec{Canary: &api.DeploymentUpgradeCanarySpec{Pause: api.NewDuration("30s")}})
+		assert.True(t, allowed(spec, nil, canary, &testContext{}))
+
+		spec.Upgrade.Canary.Pause = api.NewDuration("10m")
+		assert.False(t, allowed(spec, nil, canary, &testContext{}))
+	})
+
+	t.Run("Health gate", func(t *testing.T) {
+		spec := newSpec(&api.DeploymentUpgradeSpec{HealthGate: util.NewBool(true)})
+		assert.False(t, allowed(spec, nil, notStarted, &testContext{}))
+		assert.False(t, allowed(spec, nil, notStarted, &testContext{Health: unhealthy}))
+		assert.True(t, allowed(spec, nil, notStarted, &testContext{Health: healthy}))
+
+		// Gate is checked between stages only
+		assert.True(t, allowed(spec, nil, canary, &testContext{Health: unhealthy}))
+	})
+}
@@ -34,7 +34,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/audit"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/tracing"
 )
 
 // ExecutePlan tries to execute the plan as far as possible.
@@ -70,11 +72,22 @@ func (d *Reconciler) ExecutePlan(ctx context.Context, cachedStatus inspectorInte
 
 		log := logContext.Logger()
 
+		// Every path of the loop returns after the first action, so the span ends with it
+		ctx, span := tracing.Start(ctx, "Action "+planAction.Type.String(),
+			tracing.String("action.id", planAction.ID),
+			tracing.String("action.type", planAction.Type.String()),
+			tracing.String("action.group", planAction.Group.AsRole()),
+			tracing.String("action.member", planAction.MemberID),
+			tracing.Bool("action.started", !planAction.StartTime.IsZero()))
+		defer span.End()
+
 		action := d.createAction(ctx, log, planAction, cachedStatus)
 		if planAction.StartTime.IsZero() {
 			// Not started yet
 			ready, err := action.Start(ctx)
 			if err != nil {
+				span.SetError(err)
+				// Start is retried in the next loop, so the action is not recorded as failed in the audit
 				log.Debug().Err(err).
 					Msg("Failed to start action")
 				return false, errors.WithStack(err)
@@ -101,12 +114,18 @@ func (d *Reconciler) ExecutePlan(ctx context.Context, cachedStatus inspectorInte
 				}
 			}
 			log.Debug().Bool("ready", ready).Msg("Action Start completed")
+			d.auditAction(audit.PhaseStarted, planAction, action.MemberID(), "")
+			if ready {
+				d.auditAction(audit.PhaseFinished, planAction, action.MemberID(), "")
+			}
 
 			return true, nil
 		} else {
 			// First action of plan has been started, check its progress
 			ready, abort, err := action.CheckProgress(ctx)
+			span.SetAttributes(tracing.Bool("action.ready", ready), tracing.Bool("action.abort", abort))
 			if err != nil {
+				span.SetError(err)
 				log.Debug().Err(err).Msg("Failed to check action progress")
 				return false, errors.WithStack(err)
 			}
@@ -125,6 +144,7 @@ func (d *Reconciler) ExecutePlan(ctx context.Context, cachedStatus inspectorInte
 						return false, errors.WithStack(err)
 					}
 				}
+				d.auditAction(audit.PhaseFinished, planAction, action.MemberID(), "")
 			}
 			log.Debug().
 				Bool("abort", abort).
@@ -134,6 +154,7 @@ func (d *Reconciler) ExecutePlan(ctx context.Context, cachedStatus inspectorInte
 				deadlineExpired := false
 				if abort {
 					log.Warn().Msg("Action aborted. Removing the entire plan")
+					d.auditAction(audit.PhaseFailed, planAction, action.MemberID(), "Action aborted")
 					d.context.CreateEvent(k8sutil.NewPlanAbortedEvent(d.context.GetAPIObject(), string(planAction.Type), planAction.MemberID, planAction.Group.AsRole()))
 				} else {
 					// Not ready yet & no abort, check timeout
@@ -142,6 +163,7 @@ func (d *Reconciler) ExecutePlan(ctx context.Context, cachedStatus inspectorInte
 						// Timeout has expired
 						deadlineExpired = true
 						log.Warn().Msg("Action not finished in time. Removing the entire plan")
+						d.auditAction(audit.PhaseFailed, planAction, action.MemberID(), "Action not finished in time")
 						d.context.CreateEvent(k8sutil.NewPlanTimeoutEvent(d.context.GetAPIObject(), string(planAction.Type), planAction.MemberID, planAction.Group.AsRole()))
 					}
 				}
@@ -174,3 +196,36 @@ func (d *Reconciler) createAction(ctx context.Context, log zerolog.Logger, actio
 
 	return f(log, action, actionCtx)
 }
+
+// auditAction writes the audit record for the given plan action.
+func (d *Reconciler) auditAction(phase audit.Phase, action api.Action, memberID, failure string) {
+	if !audit.Enabled() {
+		return
+	}
+
+	apiObject := d.context.GetAPIObject()
+
+	r := audit.Record{
+		Time:       time.Now().UTC(),
+		Source:     audit.SourceDeployment,
+		Phase:      phase,
+		Action:     action.Type.String(),
+		ActionID:   action.ID,
+		Namespace:  apiObject.GetNamespace(),
+		Deployment: apiObject.GetName(),
+		Group:      action.Group.AsRole(),
+		Member:     memberID,
+		Reason:     action.Reason,
+		Error:      failure,
+	}
+
+	if phase != audit.PhaseStarted && action.StartTime != nil {
+		r.SetDuration(action.StartTime.Time)
+	}
+
+	if hash, err := d.context.GetSpec().Checksum(); err == nil {
+		r.SpecHash = hash
+	}
+
+	audit.Emit(r)
+}
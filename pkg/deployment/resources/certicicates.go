@@ -145,3 +145,22 @@ func GetKeyCertFromSecret(log zerolog.Logger, secret *core.Secret, certName, key
 
 	return cert, keys, nil
 }
+
+// GetCAFromSecret returns the CA certificates of the given TLS spec.
+// Externally issued certificates are signed by a CA without a private key in the secret.
+func GetCAFromSecret(log zerolog.Logger, secret *core.Secret, spec api.TLSSpec) (Certificates, error) {
+	if spec.IsExternallyIssued() {
+		if _, exists := secret.Data[CACertName]; !exists {
+			return nil, errors.Newf("Key %s missing in secret", CACertName)
+		}
+
+		return GetCertsFromSecret(log, secret), nil
+	}
+
+	ca, _, err := GetKeyCertFromSecret(log, secret, CACertName, CAKeyName)
+	if err != nil {
+		return nil, err
+	}
+
+	return ca, nil
+}
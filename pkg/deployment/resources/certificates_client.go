@@ -0,0 +1,168 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangoclientcertificate"
+)
+
+// EnsureClientCertificates issues client certificates requested by the ArangoClientCertificate resources
+// which reference this deployment.
+func (r *Resources) EnsureClientCertificates(cachedStatus inspectorInterface.Inspector) error {
+	return cachedStatus.IterateArangoClientCertificates(func(cert *api.ArangoClientCertificate) error {
+		if err := r.refreshCache(cachedStatus, r.ensureClientCertificate(cachedStatus, cert.DeepCopy())); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return nil
+	}, arangoclientcertificate.FilterByDeploymentName(r.context.GetAPIObject().GetName()))
+}
+
+// ensureClientCertificate ensures that the secret of the given client certificate exists and is not about to expire.
+func (r *Resources) ensureClientCertificate(cachedStatus inspectorInterface.Inspector, cert *api.ArangoClientCertificate) error {
+	spec := r.context.GetSpec()
+	log := r.log.With().Str("client-certificate", cert.GetName()).Logger()
+	secrets := r.context.GetKubeCli().CoreV1().Secrets(cert.GetNamespace())
+
+	if err := cert.Spec.Validate(); err != nil {
+		return r.updateClientCertificateStatus(cert, "", nil, "Invalid spec", err.Error())
+	}
+
+	if !spec.TLS.IsSecure() || !spec.TLS.ClientAuth.IsEnabled() {
+		return r.updateClientCertificateStatus(cert, "", nil, "Client authentication disabled", "TLS client authentication is not enabled in the deployment")
+	}
+
+	secretName := cert.Spec.GetSecretName(cert.GetName())
+	ttl := cert.Spec.GetTTL().AsDuration()
+
+	if secret, exists := cachedStatus.Secret(secretName); exists {
+		if !meta.IsControlledBy(secret, cert) {
+			return r.updateClientCertificateStatus(cert, "", nil, "Secret conflict", "Secret exists and is not owned by the client certificate")
+		}
+
+		certs := GetCertsFromData(log, secret.Data[core.TLSCertKey])
+		if len(certs) > 0 {
+			notAfter := certificatesNotAfter(certs)
+
+			if time.Until(notAfter) > clientCertificateRenewBefore(spec.TLS.GetRenewBefore().AsDuration(), ttl) {
+				return r.updateClientCertificateStatus(cert, secretName, &notAfter, "", "")
+			}
+		}
+
+		// Certificate is invalid or about to expire, issue a new one
+		log.Info().Str("secret", secretName).Msg("Renewing client certificate")
+		if err := secrets.Delete(secretName, &meta.DeleteOptions{}); err != nil && !k8sutil.IsNotFound(err) {
+			return errors.WithStack(err)
+		}
+
+		return errors.Reconcile()
+	}
+
+	certPem, keyPem, err := createClientAuthCertificate(log, secrets, cert.Spec.GetCommonName(cert.GetName()), ttl, spec.TLS.ClientAuth.GetCASecretName())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	secret := &core.Secret{
+		ObjectMeta: meta.ObjectMeta{
+			Name: secretName,
+		},
+		Type: core.SecretTypeTLS,
+		Data: map[string][]byte{
+			core.TLSCertKey:       []byte(certPem),
+			core.TLSPrivateKeyKey: []byte(keyPem),
+		},
+	}
+
+	// Server CA allows clients to verify the deployment
+	if ca, exists := cachedStatus.Secret(spec.TLS.GetCASecretName()); exists {
+		if caCert, ok := ca.Data[constants.SecretCACertificate]; ok {
+			secret.Data[constants.SecretCACertificate] = caCert
+		}
+	}
+
+	owner := cert.AsOwner()
+	k8sutil.AddOwnerRefToObject(secret, &owner)
+
+	if _, err := secrets.Create(secret); err != nil && !k8sutil.IsAlreadyExists(err) {
+		return errors.WithStack(err)
+	}
+
+	log.Info().Str("secret", secretName).Msg("Issued client certificate")
+
+	return errors.Reconcile()
+}
+
+// updateClientCertificateStatus saves the state of the client certificate when it changed.
+// Empty reason marks the certificate as ready.
+func (r *Resources) updateClientCertificateStatus(cert *api.ArangoClientCertificate, secretName string, notAfter *time.Time, reason, message string) error {
+	status := cert.Status.DeepCopy()
+
+	changed := status.Conditions.Update(api.ConditionTypeReady, reason == "", reason, message)
+
+	if status.SecretName != secretName {
+		status.SecretName = secretName
+		changed = true
+	}
+
+	if notAfter == nil {
+		if status.NotAfter != nil {
+			status.NotAfter = nil
+			changed = true
+		}
+	} else if status.NotAfter == nil || !status.NotAfter.Time.Equal(*notAfter) {
+		t := meta.NewTime(*notAfter)
+		status.NotAfter = &t
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	cert.Status = *status
+	if _, err := r.context.GetArangoCli().DatabaseV1().ArangoClientCertificates(cert.GetNamespace()).UpdateStatus(cert); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// clientCertificateRenewBefore returns the margin before expiry at which client certificates are renewed.
+// Margin is limited to the half of the ttl to prevent certificates from being renewed in a loop.
+func clientCertificateRenewBefore(renewBefore, ttl time.Duration) time.Duration {
+	if ttl > 0 && renewBefore >= ttl {
+		return ttl / 2
+	}
+
+	return renewBefore
+}
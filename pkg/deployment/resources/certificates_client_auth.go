@@ -32,20 +32,21 @@ import (
 	certificates "github.com/arangodb-helper/go-certificates"
 	"github.com/rs/zerolog"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
 const (
-	clientAuthECDSACurve = "P256" // This curve is the default that ArangoDB accepts and plenty strong
+	clientAuthECDSACurve         = "P256" // This curve is the default that ArangoDB accepts and plenty strong
+	operatorClientAuthCommonName = "operator"
 )
 
-// createClientAuthCACertificate creates a client authentication CA certificate and stores it in a secret with name
-// specified in the given spec.
-func createClientAuthCACertificate(log zerolog.Logger, secrets k8sutil.SecretInterface, spec api.SyncAuthenticationSpec, deploymentName string, ownerRef *metav1.OwnerReference) error {
-	log = log.With().Str("secret", spec.GetClientCASecretName()).Logger()
+// createClientAuthCACertificate creates a client authentication CA certificate and stores it in a secret with
+// the given name.
+func createClientAuthCACertificate(log zerolog.Logger, secrets k8sutil.SecretInterface, caSecretName string, deploymentName string, ownerRef *metav1.OwnerReference) error {
+	log = log.With().Str("secret", caSecretName).Logger()
 	options := certificates.CreateCertificateOptions{
 		CommonName:   fmt.Sprintf("%s Client Authentication Root Certificate", deploymentName),
 		ValidFrom:    time.Now(),
@@ -59,7 +60,7 @@ func createClientAuthCACertificate(log zerolog.Logger, secrets k8sutil.SecretInt
 		log.Debug().Err(err).Msg("Failed to create CA certificate")
 		return errors.WithStack(err)
 	}
-	if err := k8sutil.CreateCASecret(secrets, spec.GetClientCASecretName(), cert, priv, ownerRef); err != nil {
+	if err := k8sutil.CreateCASecret(secrets, caSecretName, cert, priv, ownerRef); err != nil {
 		if k8sutil.IsAlreadyExists(err) {
 			log.Debug().Msg("CA Secret already exists")
 		} else {
@@ -71,20 +72,19 @@ func createClientAuthCACertificate(log zerolog.Logger, secrets k8sutil.SecretInt
 	return nil
 }
 
-// createClientAuthCertificateKeyfile creates a client authentication certificate for a specific user and stores
-// it in a secret with the given name.
-func createClientAuthCertificateKeyfile(log zerolog.Logger, secrets v1.SecretInterface, commonName string, ttl time.Duration, spec api.SyncAuthenticationSpec, secretName string, ownerRef *metav1.OwnerReference) error {
-	log = log.With().Str("secret", secretName).Logger()
+// createClientAuthCertificate creates a client authentication certificate for a specific user, signed by the
+// CA stored in the secret with the given name. It returns the PEM encoded certificate and private key.
+func createClientAuthCertificate(log zerolog.Logger, secrets k8sutil.SecretInterface, commonName string, ttl time.Duration, caSecretName string) (string, string, error) {
 	// Load CA certificate
-	caCert, caKey, _, err := k8sutil.GetCASecret(secrets, spec.GetClientCASecretName(), nil)
+	caCert, caKey, _, err := k8sutil.GetCASecret(secrets, caSecretName, nil)
 	if err != nil {
 		log.Debug().Err(err).Msg("Failed to load CA certificate")
-		return errors.WithStack(err)
+		return "", "", errors.WithStack(err)
 	}
 	ca, err := certificates.LoadCAFromPEM(caCert, caKey)
 	if err != nil {
 		log.Debug().Err(err).Msg("Failed to decode CA certificate")
-		return errors.WithStack(err)
+		return "", "", errors.WithStack(err)
 	}
 
 	options := certificates.CreateCertificateOptions{
@@ -97,19 +97,65 @@ func createClientAuthCertificateKeyfile(log zerolog.Logger, secrets v1.SecretInt
 	}
 	cert, priv, err := certificates.CreateCertificate(options, &ca)
 	if err != nil {
-		log.Debug().Err(err).Msg("Failed to create server certificate")
+		log.Debug().Err(err).Msg("Failed to create client certificate")
+		return "", "", errors.WithStack(err)
+	}
+	return cert, priv, nil
+}
+
+// createClientAuthCertificateKeyfile creates a client authentication certificate for a specific user and stores
+// it in a secret with the given name.
+func createClientAuthCertificateKeyfile(log zerolog.Logger, secrets k8sutil.SecretInterface, commonName string, ttl time.Duration, caSecretName string, secretName string, ownerRef *metav1.OwnerReference) error {
+	log = log.With().Str("secret", secretName).Logger()
+	keyfile, err := createClientAuthKeyfile(log, secrets, commonName, ttl, caSecretName)
+	if err != nil {
 		return errors.WithStack(err)
 	}
-	keyfile := strings.TrimSpace(cert) + "\n" +
-		strings.TrimSpace(priv)
 	if err := k8sutil.CreateTLSKeyfileSecret(secrets, secretName, keyfile, ownerRef); err != nil {
 		if k8sutil.IsAlreadyExists(err) {
-			log.Debug().Msg("Server Secret already exists")
+			log.Debug().Msg("Client Secret already exists")
 		} else {
-			log.Debug().Err(err).Msg("Failed to create server Secret")
+			log.Debug().Err(err).Msg("Failed to create client Secret")
 		}
 		return errors.WithStack(err)
 	}
-	log.Debug().Msg("Created server Secret")
+	log.Debug().Msg("Created client Secret")
+	return nil
+}
+
+// RenewOperatorClientKeyfile replaces the client authentication certificate used by the operator in place,
+// so the operator keeps a valid certificate while it is renewed.
+func RenewOperatorClientKeyfile(log zerolog.Logger, secrets k8sutil.SecretInterface, deploymentName string, spec api.TLSSpec) error {
+	secretName := k8sutil.CreateTLSClientKeyfileSecretName(deploymentName)
+	log = log.With().Str("secret", secretName).Logger()
+
+	secret, err := secrets.Get(secretName, metav1.GetOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	keyfile, err := createClientAuthKeyfile(log, secrets, operatorClientAuthCommonName, spec.GetTTL().AsDuration(), spec.ClientAuth.GetCASecretName())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	secret.Data = map[string][]byte{
+		constants.SecretTLSKeyfile: []byte(keyfile),
+	}
+	if _, err := secrets.Update(secret); err != nil {
+		log.Debug().Err(err).Msg("Failed to update client Secret")
+		return errors.WithStack(err)
+	}
+	log.Debug().Msg("Renewed client Secret")
 	return nil
 }
+
+// createClientAuthKeyfile creates a client authentication certificate for a specific user and returns it
+// together with its private key in the keyfile format.
+func createClientAuthKeyfile(log zerolog.Logger, secrets k8sutil.SecretInterface, commonName string, ttl time.Duration, caSecretName string) (string, error) {
+	cert, priv, err := createClientAuthCertificate(log, secrets, commonName, ttl, caSecretName)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return strings.TrimSpace(cert) + "\n" + strings.TrimSpace(priv), nil
+}
@@ -0,0 +1,34 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientCertificateRenewBefore(t *testing.T) {
+	require.Equal(t, 168*time.Hour, clientCertificateRenewBefore(168*time.Hour, 2160*time.Hour))
+	require.Equal(t, 12*time.Hour, clientCertificateRenewBefore(168*time.Hour, 24*time.Hour))
+	require.Equal(t, 168*time.Hour, clientCertificateRenewBefore(168*time.Hour, 0))
+}
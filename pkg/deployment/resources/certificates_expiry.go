@@ -0,0 +1,234 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"sort"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/metrics"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
+)
+
+var (
+	certificateExpiryGauges = metrics.MustRegisterGaugeVec(metricsComponent, "certificate_expiry", "Expiry time of TLS certificates managed by the operator (unix timestamp in sec)", metrics.DeploymentName, "type", "secret")
+)
+
+// GetCertsFromKeyfile returns the certificates stored in the keyfile secret.
+// The server certificate is returned first.
+func GetCertsFromKeyfile(secret *core.Secret) (Certificates, error) {
+	keyfile, exists := secret.Data[constants.SecretTLSKeyfile]
+	if !exists {
+		return nil, errors.Newf("Key %s missing in secret", constants.SecretTLSKeyfile)
+	}
+
+	var certs Certificates
+
+	for {
+		block, rest := pem.Decode(keyfile)
+		if block == nil {
+			break
+		}
+
+		keyfile = rest
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.Newf("Keyfile does not contain any certificates")
+	}
+
+	return certs, nil
+}
+
+// InspectCertificates parses TLS certificates managed by the operator, publishes their expiry
+// and emits warning events when configured thresholds are reached.
+func (r *Resources) InspectCertificates(cachedStatus inspectorInterface.Inspector) error {
+	spec := r.context.GetSpec()
+	status, lastVersion := r.context.GetStatus()
+	apiObject := r.context.GetAPIObject()
+	deploymentName := apiObject.GetName()
+	log := r.log
+
+	var certs api.CertificateStatusList
+
+	add := func(c api.CertificateStatus) {
+		if prev, ok := status.Certificates.GetBySecret(c.Secret); ok && prev.NotAfter.Equal(&c.NotAfter) {
+			c.Warning = api.NewDurationOrNil(prev.Warning)
+		}
+
+		if threshold, ok := certificateExpiryThreshold(spec.TLS.GetExpiryWarnings(), c.ExpiresIn(time.Now())); ok {
+			if c.Warning == nil || threshold.AsDuration() < c.Warning.AsDuration() {
+				r.context.CreateEvent(k8sutil.NewCertificateExpiryEvent(apiObject, string(c.Type), c.Secret, c.NotAfter.Time))
+				c.Warning = api.NewDuration(threshold)
+			}
+		}
+
+		certificateExpiryGauges.WithLabelValues(deploymentName, string(c.Type), c.Secret).Set(float64(c.NotAfter.Unix()))
+		certs = append(certs, c)
+	}
+
+	if spec.TLS.IsSecure() {
+		if secret, exists := cachedStatus.Secret(spec.TLS.GetCASecretName()); exists {
+			if ca, err := GetCAFromSecret(log, secret, spec.TLS); err != nil {
+				log.Warn().Err(err).Str("secret", secret.GetName()).Msg("Unable to parse CA certificate")
+			} else if len(ca) > 0 {
+				add(api.CertificateStatus{
+					Type:     api.CertificateTypeCA,
+					Secret:   secret.GetName(),
+					NotAfter: meta.NewTime(certificatesNotAfter(ca)),
+				})
+			}
+		}
+
+		status.Members.ForeachServerGroup(func(group api.ServerGroup, list api.MemberStatusList) error {
+			if !group.IsArangod() {
+				return nil
+			}
+
+			for _, m := range list {
+				secret, exists := cachedStatus.Secret(k8sutil.CreateTLSKeyfileSecretName(deploymentName, group.AsRole(), m.ID))
+				if !exists {
+					continue
+				}
+
+				keyfile, err := GetCertsFromKeyfile(secret)
+				if err != nil {
+					log.Warn().Err(err).Str("secret", secret.GetName()).Msg("Unable to parse keyfile certificate")
+					continue
+				}
+
+				add(api.CertificateStatus{
+					Type:     api.CertificateTypeKeyfile,
+					Secret:   secret.GetName(),
+					Group:    group,
+					MemberID: m.ID,
+					NotAfter: meta.NewTime(keyfile[0].NotAfter),
+				})
+			}
+
+			return nil
+		})
+
+		for _, name := range util.SortKeys(spec.TLS.GetSNI().Mapping) {
+			secret, exists := cachedStatus.Secret(name)
+			if !exists {
+				continue
+			}
+
+			keyfile, err := GetCertsFromKeyfile(secret)
+			if err != nil {
+				log.Warn().Err(err).Str("secret", secret.GetName()).Msg("Unable to parse SNI certificate")
+				continue
+			}
+
+			add(api.CertificateStatus{
+				Type:     api.CertificateTypeSNI,
+				Secret:   secret.GetName(),
+				NotAfter: meta.NewTime(keyfile[0].NotAfter),
+			})
+		}
+
+		if spec.TLS.ClientAuth.IsEnabled() {
+			if secret, exists := cachedStatus.Secret(k8sutil.CreateTLSClientKeyfileSecretName(deploymentName)); exists {
+				if keyfile, err := GetCertsFromKeyfile(secret); err != nil {
+					log.Warn().Err(err).Str("secret", secret.GetName()).Msg("Unable to parse client keyfile certificate")
+				} else {
+					add(api.CertificateStatus{
+						Type:     api.CertificateTypeClientKeyfile,
+						Secret:   secret.GetName(),
+						NotAfter: meta.NewTime(keyfile[0].NotAfter),
+					})
+				}
+			}
+		}
+	}
+
+	// Remove metrics of certificates which are not managed anymore
+	for _, c := range status.Certificates {
+		if _, ok := certs.GetBySecret(c.Secret); !ok {
+			certificateExpiryGauges.DeleteLabelValues(deploymentName, string(c.Type), c.Secret)
+		}
+	}
+
+	if status.Certificates.Equal(certs) {
+		return nil
+	}
+
+	status.Certificates = certs
+	if err := r.context.UpdateStatus(status, lastVersion); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// certificatesNotAfter returns the earliest expiry time of given certificates.
+func certificatesNotAfter(certs Certificates) time.Time {
+	var notAfter time.Time
+
+	for _, cert := range certs {
+		if notAfter.IsZero() || cert.NotAfter.Before(notAfter) {
+			notAfter = cert.NotAfter
+		}
+	}
+
+	return notAfter
+}
+
+// certificateExpiryThreshold returns the lowest threshold which was already reached.
+func certificateExpiryThreshold(thresholds []api.Duration, expiresIn time.Duration) (api.Duration, bool) {
+	sorted := make([]api.Duration, len(thresholds))
+	copy(sorted, thresholds)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].AsDuration() < sorted[j].AsDuration()
+	})
+
+	for _, threshold := range sorted {
+		if expiresIn <= threshold.AsDuration() {
+			return threshold, true
+		}
+	}
+
+	return "", false
+}
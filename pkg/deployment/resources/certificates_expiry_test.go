@@ -0,0 +1,51 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertificateExpiryThreshold(t *testing.T) {
+	thresholds := []api.Duration{"24h", "720h", "168h"}
+
+	_, ok := certificateExpiryThreshold(thresholds, 1000*time.Hour)
+	require.False(t, ok)
+
+	threshold, ok := certificateExpiryThreshold(thresholds, 700*time.Hour)
+	require.True(t, ok)
+	require.Equal(t, api.Duration("720h"), threshold)
+
+	threshold, ok = certificateExpiryThreshold(thresholds, 100*time.Hour)
+	require.True(t, ok)
+	require.Equal(t, api.Duration("168h"), threshold)
+
+	threshold, ok = certificateExpiryThreshold(thresholds, -time.Hour)
+	require.True(t, ok)
+	require.Equal(t, api.Duration("24h"), threshold)
+
+	_, ok = certificateExpiryThreshold(nil, time.Hour)
+	require.False(t, ok)
+}
@@ -0,0 +1,271 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	certificates "k8s.io/api/certificates/v1beta1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// tlsServerKeyUsages defines usages requested for server certificates.
+var tlsServerKeyUsages = []certificates.KeyUsage{
+	certificates.UsageDigitalSignature,
+	certificates.UsageKeyEncipherment,
+	certificates.UsageServerAuth,
+}
+
+// tlsCertificateIssuer signs server certificates with an external CA.
+type tlsCertificateIssuer interface {
+	// Request submits the PEM encoded certificate signing request under the given name.
+	Request(name string, csr []byte, duration time.Duration, ownerRef *meta.OwnerReference) error
+	// Get returns the PEM encoded certificate chain for the request with the given name.
+	// When the request is not signed yet, nil is returned.
+	Get(name string) ([]byte, error)
+	// Delete removes the request with the given name.
+	Delete(name string) error
+}
+
+// newTLSCertificateIssuer returns the issuer for the given spec.
+func newTLSCertificateIssuer(kubecli kubernetes.Interface, namespace string, spec *api.TLSIssuerSpec) (tlsCertificateIssuer, error) {
+	if spec == nil {
+		return nil, errors.Newf("TLS issuer is not defined")
+	}
+
+	switch spec.Type {
+	case api.TLSIssuerTypeCertManager:
+		return &certManagerIssuer{kubecli: kubecli, namespace: namespace, spec: *spec}, nil
+	case api.TLSIssuerTypeKubernetes:
+		return &kubernetesIssuer{kubecli: kubecli, namespace: namespace, spec: *spec}, nil
+	default:
+		return nil, errors.Newf("TLS issuer type %s is not supported", spec.Type)
+	}
+}
+
+const (
+	certManagerAPIVersion                 = "cert-manager.io/v1"
+	certManagerCertificateRequestKind     = "CertificateRequest"
+	certManagerCertificateRequestResource = "certificaterequests"
+)
+
+// certManagerCertificateRequest is the subset of the cert-manager CertificateRequest resource used by the operator.
+type certManagerCertificateRequest struct {
+	meta.TypeMeta   `json:",inline"`
+	meta.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   certManagerCertificateRequestSpec   `json:"spec"`
+	Status certManagerCertificateRequestStatus `json:"status,omitempty"`
+}
+
+type certManagerCertificateRequestSpec struct {
+	Request   []byte                  `json:"request"`
+	IssuerRef certManagerIssuerRef    `json:"issuerRef"`
+	Duration  *meta.Duration          `json:"duration,omitempty"`
+	Usages    []certificates.KeyUsage `json:"usages,omitempty"`
+}
+
+type certManagerIssuerRef struct {
+	Name  string `json:"name"`
+	Kind  string `json:"kind,omitempty"`
+	Group string `json:"group,omitempty"`
+}
+
+type certManagerCertificateRequestStatus struct {
+	Conditions  []certManagerCondition `json:"conditions,omitempty"`
+	Certificate []byte                 `json:"certificate,omitempty"`
+	CA          []byte                 `json:"ca,omitempty"`
+}
+
+type certManagerCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// certManagerIssuer signs certificates with cert-manager CertificateRequest resources.
+type certManagerIssuer struct {
+	kubecli   kubernetes.Interface
+	namespace string
+	spec      api.TLSIssuerSpec
+}
+
+func (c *certManagerIssuer) path(name ...string) []string {
+	return append([]string{"apis", certManagerAPIVersion, "namespaces", c.namespace, certManagerCertificateRequestResource}, name...)
+}
+
+func (c *certManagerIssuer) Request(name string, csr []byte, duration time.Duration, ownerRef *meta.OwnerReference) error {
+	request := certManagerCertificateRequest{
+		TypeMeta: meta.TypeMeta{
+			APIVersion: certManagerAPIVersion,
+			Kind:       certManagerCertificateRequestKind,
+		},
+		ObjectMeta: meta.ObjectMeta{
+			Name:      name,
+			Namespace: c.namespace,
+		},
+		Spec: certManagerCertificateRequestSpec{
+			Request: csr,
+			IssuerRef: certManagerIssuerRef{
+				Name:  c.spec.GetName(),
+				Kind:  c.spec.GetKind(),
+				Group: c.spec.GetGroup(),
+			},
+			Duration: &meta.Duration{Duration: duration},
+			Usages:   tlsServerKeyUsages,
+		},
+	}
+	k8sutil.AddOwnerRefToObject(&request, ownerRef)
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := c.kubecli.Discovery().RESTClient().Post().AbsPath(c.path()...).
+		SetHeader("Content-Type", "application/json").Body(data).Do().Error(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (c *certManagerIssuer) Get(name string) ([]byte, error) {
+	data, err := c.kubecli.Discovery().RESTClient().Get().AbsPath(c.path(name)...).Do().Raw()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var request certManagerCertificateRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, condition := range request.Status.Conditions {
+		switch condition.Type {
+		case "Ready":
+			if condition.Status == "False" && (condition.Reason == "Failed" || condition.Reason == "Denied") {
+				return nil, errors.Newf("CertificateRequest %s was not issued: %s", name, condition.Message)
+			}
+		case "Denied", "InvalidRequest":
+			if condition.Status == "True" {
+				return nil, errors.Newf("CertificateRequest %s was rejected: %s", name, condition.Message)
+			}
+		}
+	}
+
+	if len(request.Status.Certificate) == 0 {
+		return nil, nil
+	}
+
+	return request.Status.Certificate, nil
+}
+
+func (c *certManagerIssuer) Delete(name string) error {
+	if err := c.kubecli.Discovery().RESTClient().Delete().AbsPath(c.path(name)...).Do().Error(); err != nil && !k8sutil.IsNotFound(err) {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// kubernetesIssuer signs certificates with Kubernetes CertificateSigningRequest resources.
+type kubernetesIssuer struct {
+	kubecli   kubernetes.Interface
+	namespace string
+	spec      api.TLSIssuerSpec
+}
+
+// requestName returns the name of the cluster scoped CertificateSigningRequest.
+func (k *kubernetesIssuer) requestName(name string) string {
+	return fmt.Sprintf("%s-%s", k.namespace, name)
+}
+
+func (k *kubernetesIssuer) Request(name string, csr []byte, duration time.Duration, ownerRef *meta.OwnerReference) error {
+	csrs := k.kubecli.CertificatesV1beta1().CertificateSigningRequests()
+
+	// CertificateSigningRequest is cluster scoped, so it can not be owned by a namespaced resource
+	request := &certificates.CertificateSigningRequest{
+		ObjectMeta: meta.ObjectMeta{
+			Name: k.requestName(name),
+		},
+		Spec: certificates.CertificateSigningRequestSpec{
+			Request: csr,
+			Usages:  tlsServerKeyUsages,
+		},
+	}
+
+	created, err := csrs.Create(request)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if k.spec.GetApprove() {
+		created.Status.Conditions = append(created.Status.Conditions, certificates.CertificateSigningRequestCondition{
+			Type:           certificates.CertificateApproved,
+			Reason:         "ArangoDeploymentApproved",
+			Message:        "Approved by the ArangoDeployment operator",
+			LastUpdateTime: meta.Now(),
+		})
+
+		if _, err := csrs.UpdateApproval(created); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+func (k *kubernetesIssuer) Get(name string) ([]byte, error) {
+	request, err := k.kubecli.CertificatesV1beta1().CertificateSigningRequests().Get(k.requestName(name), meta.GetOptions{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, condition := range request.Status.Conditions {
+		if condition.Type == certificates.CertificateDenied {
+			return nil, errors.Newf("CertificateSigningRequest %s was denied: %s", request.GetName(), condition.Message)
+		}
+	}
+
+	if len(request.Status.Certificate) == 0 {
+		return nil, nil
+	}
+
+	return request.Status.Certificate, nil
+}
+
+func (k *kubernetesIssuer) Delete(name string) error {
+	if err := k.kubecli.CertificatesV1beta1().CertificateSigningRequests().Delete(k.requestName(name), &meta.DeleteOptions{}); err != nil && !k8sutil.IsNotFound(err) {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
@@ -23,7 +23,14 @@
 package resources
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"net"
 	"strings"
 	"time"
 
@@ -31,10 +38,12 @@ import (
 
 	certificates "github.com/arangodb-helper/go-certificates"
 	"github.com/rs/zerolog"
+	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
@@ -124,3 +133,120 @@ func createTLSServerCertificate(log zerolog.Logger, secrets v1.SecretInterface,
 	log.Debug().Msg("Created server Secret")
 	return nil
 }
+
+// requestTLSServerCertificate creates a private key with a certificate signing request for a specific server
+// and submits it to the external issuer. The private key is kept in the request secret until the certificate is issued.
+func requestTLSServerCertificate(log zerolog.Logger, secrets k8sutil.SecretInterface, issuer tlsCertificateIssuer, serverNames []string, spec api.TLSSpec,
+	secretName string, ownerRef *metav1.OwnerReference) error {
+
+	log = log.With().Str("secret", secretName).Logger()
+	// Load alt names
+	dnsNames, ipAddresses, emailAddress, err := spec.GetParsedAltNames()
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to get alternate names")
+		return errors.WithStack(err)
+	}
+
+	template := x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName: serverNames[0],
+		},
+		EmailAddresses: emailAddress,
+	}
+	for _, host := range append(append(serverNames, dnsNames...), ipAddresses...) {
+		if host == "" {
+			continue
+		}
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to create private key")
+		return errors.WithStack(err)
+	}
+	privData, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &template, priv)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to create certificate request")
+		return errors.WithStack(err)
+	}
+
+	requestSecret := &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: k8sutil.AppendTLSRequestSecretPostfix(secretName),
+		},
+		Data: map[string][]byte{
+			constants.SecretTLSKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privData}),
+		},
+	}
+	k8sutil.AddOwnerRefToObject(requestSecret, ownerRef)
+	if _, err := secrets.Create(requestSecret); err != nil {
+		if k8sutil.IsAlreadyExists(err) {
+			log.Debug().Msg("Request Secret already exists")
+		} else {
+			log.Debug().Err(err).Msg("Failed to create request Secret")
+		}
+		return errors.WithStack(err)
+	}
+
+	if err := issuer.Request(secretName, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}),
+		spec.GetTTL().AsDuration(), ownerRef); err != nil && !k8sutil.IsAlreadyExists(err) {
+		log.Debug().Err(err).Msg("Failed to request server certificate")
+		return errors.WithStack(err)
+	}
+	log.Debug().Msg("Requested server certificate")
+	return nil
+}
+
+// collectTLSServerCertificate stores the certificate signed by the external issuer in a secret with the given name.
+// Returns true when the certificate was issued.
+func collectTLSServerCertificate(log zerolog.Logger, secrets k8sutil.SecretInterface, issuer tlsCertificateIssuer, requestSecret *core.Secret,
+	secretName string, ownerRef *metav1.OwnerReference) (bool, error) {
+
+	log = log.With().Str("secret", secretName).Logger()
+
+	priv, ok := requestSecret.Data[constants.SecretTLSKey]
+	if !ok {
+		return false, errors.Newf("Key %s missing in secret %s", constants.SecretTLSKey, requestSecret.GetName())
+	}
+
+	cert, err := issuer.Get(secretName)
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			// Request is gone, start over with a new private key
+			log.Debug().Msg("Certificate request is missing")
+			return false, errors.WithStack(secrets.Delete(requestSecret.GetName(), &metav1.DeleteOptions{}))
+		}
+		return false, errors.WithStack(err)
+	}
+
+	if len(cert) == 0 {
+		log.Debug().Msg("Server certificate is not yet issued")
+		return false, nil
+	}
+
+	keyfile := strings.TrimSpace(string(cert)) + "\n" +
+		strings.TrimSpace(string(priv))
+	if err := k8sutil.CreateTLSKeyfileSecret(secrets, secretName, keyfile, ownerRef); err != nil && !k8sutil.IsAlreadyExists(err) {
+		log.Debug().Err(err).Msg("Failed to create server Secret")
+		return false, errors.WithStack(err)
+	}
+
+	if err := issuer.Delete(secretName); err != nil {
+		return false, errors.WithStack(err)
+	}
+	if err := secrets.Delete(requestSecret.GetName(), &metav1.DeleteOptions{}); err != nil && !k8sutil.IsNotFound(err) {
+		return false, errors.WithStack(err)
+	}
+
+	log.Debug().Msg("Created server Secret from issued certificate")
+	return true, nil
+}
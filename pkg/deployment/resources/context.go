@@ -74,6 +74,8 @@ type Context interface {
 	GetArangoCli() versioned.Interface
 	// GetLifecycleImage returns the image name containing the lifecycle helper (== name of operator image)
 	GetLifecycleImage() string
+	// GetOperatorVersion returns the version of the operator
+	GetOperatorVersion() string
 	// GetOperatorUUIDImage returns the image name containing the uuid helper (== name of operator image)
 	GetOperatorUUIDImage() string
 	// GetMetricsExporterImage returns the image name containing the default metrics exporter image
@@ -0,0 +1,123 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package inspector
+
+import (
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector/arangoclientcertificate"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (i *inspector) IterateArangoClientCertificates(action arangoclientcertificate.Action, filters ...arangoclientcertificate.Filter) error {
+	for _, cert := range i.ArangoClientCertificates() {
+		if err := i.iterateArangoClientCertificate(cert, action, filters...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *inspector) iterateArangoClientCertificate(cert *api.ArangoClientCertificate, action arangoclientcertificate.Action, filters ...arangoclientcertificate.Filter) error {
+	for _, filter := range filters {
+		if !filter(cert) {
+			return nil
+		}
+	}
+
+	return action(cert)
+}
+
+func (i *inspector) ArangoClientCertificates() []*api.ArangoClientCertificate {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	var r []*api.ArangoClientCertificate
+	for _, cert := range i.arangoClientCertificates {
+		r = append(r, cert)
+	}
+
+	return r
+}
+
+func (i *inspector) ArangoClientCertificate(name string) (*api.ArangoClientCertificate, bool) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	cert, ok := i.arangoClientCertificates[name]
+	if !ok {
+		return nil, false
+	}
+
+	return cert, true
+}
+
+func arangoClientCertificatesToMap(k versioned.Interface, namespace string) (map[string]*api.ArangoClientCertificate, error) {
+	certs, err := getArangoClientCertificates(k, namespace, "")
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			// CRD is not installed
+			return map[string]*api.ArangoClientCertificate{}, nil
+		}
+		return nil, err
+	}
+
+	certMap := map[string]*api.ArangoClientCertificate{}
+
+	for _, cert := range certs {
+		_, exists := certMap[cert.GetName()]
+		if exists {
+			return nil, errors.Newf("ArangoClientCertificate %s already exists in map, error received", cert.GetName())
+		}
+
+		certMap[cert.GetName()] = arangoClientCertificatePointer(cert)
+	}
+
+	return certMap, nil
+}
+
+func arangoClientCertificatePointer(cert api.ArangoClientCertificate) *api.ArangoClientCertificate {
+	return &cert
+}
+
+func getArangoClientCertificates(k versioned.Interface, namespace, cont string) ([]api.ArangoClientCertificate, error) {
+	certs, err := k.DatabaseV1().ArangoClientCertificates(namespace).List(meta.ListOptions{
+		Limit:    128,
+		Continue: cont,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if certs.Continue != "" {
+		nextCertsLayer, err := getArangoClientCertificates(k, namespace, certs.Continue)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(certs.Items, nextCertsLayer...), nil
+	}
+
+	return certs.Items, nil
+}
@@ -78,11 +78,16 @@ func NewInspector(k kubernetes.Interface, m monitoringClient.MonitoringV1Interfa
 		return nil, err
 	}
 
-	return NewInspectorFromData(pods, secrets, pvcs, services, serviceAccounts, podDisruptionBudgets, serviceMonitors, arangoMembers), nil
+	arangoClientCertificates, err := arangoClientCertificatesToMap(c, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewInspectorFromData(pods, secrets, pvcs, services, serviceAccounts, podDisruptionBudgets, serviceMonitors, arangoMembers, arangoClientCertificates), nil
 }
 
 func NewEmptyInspector() inspectorInterface.Inspector {
-	return NewInspectorFromData(nil, nil, nil, nil, nil, nil, nil, nil)
+	return NewInspectorFromData(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 }
 
 func NewInspectorFromData(pods map[string]*core.Pod,
@@ -92,30 +97,33 @@ func NewInspectorFromData(pods map[string]*core.Pod,
 	serviceAccounts map[string]*core.ServiceAccount,
 	podDisruptionBudgets map[string]*policy.PodDisruptionBudget,
 	serviceMonitors map[string]*monitoring.ServiceMonitor,
-	arangoMembers map[string]*api.ArangoMember) inspectorInterface.Inspector {
+	arangoMembers map[string]*api.ArangoMember,
+	arangoClientCertificates map[string]*api.ArangoClientCertificate) inspectorInterface.Inspector {
 	return &inspector{
-		pods:                 pods,
-		secrets:              secrets,
-		pvcs:                 pvcs,
-		services:             services,
-		serviceAccounts:      serviceAccounts,
-		podDisruptionBudgets: podDisruptionBudgets,
-		serviceMonitors:      serviceMonitors,
-		arangoMembers:        arangoMembers,
+		pods:                     pods,
+		secrets:                  secrets,
+		pvcs:                     pvcs,
+		services:                 services,
+		serviceAccounts:          serviceAccounts,
+		podDisruptionBudgets:     podDisruptionBudgets,
+		serviceMonitors:          serviceMonitors,
+		arangoMembers:            arangoMembers,
+		arangoClientCertificates: arangoClientCertificates,
 	}
 }
 
 type inspector struct {
 	lock sync.Mutex
 
-	pods                 map[string]*core.Pod
-	secrets              map[string]*core.Secret
-	pvcs                 map[string]*core.PersistentVolumeClaim
-	services             map[string]*core.Service
-	serviceAccounts      map[string]*core.ServiceAccount
-	podDisruptionBudgets map[string]*policy.PodDisruptionBudget
-	serviceMonitors      map[string]*monitoring.ServiceMonitor
-	arangoMembers        map[string]*api.ArangoMember
+	pods                     map[string]*core.Pod
+	secrets                  map[string]*core.Secret
+	pvcs                     map[string]*core.PersistentVolumeClaim
+	services                 map[string]*core.Service
+	serviceAccounts          map[string]*core.ServiceAccount
+	podDisruptionBudgets     map[string]*policy.PodDisruptionBudget
+	serviceMonitors          map[string]*monitoring.ServiceMonitor
+	arangoMembers            map[string]*api.ArangoMember
+	arangoClientCertificates map[string]*api.ArangoClientCertificate
 
 	ns string
 	k  kubernetes.Interface
@@ -166,6 +174,11 @@ func (i *inspector) Refresh(k kubernetes.Interface, m monitoringClient.Monitorin
 		return err
 	}
 
+	arangoClientCertificates, err := arangoClientCertificatesToMap(c, namespace)
+	if err != nil {
+		return err
+	}
+
 	i.pods = pods
 	i.secrets = secrets
 	i.pvcs = pvcs
@@ -174,6 +187,7 @@ func (i *inspector) Refresh(k kubernetes.Interface, m monitoringClient.Monitorin
 	i.podDisruptionBudgets = podDisruptionBudgets
 	i.serviceMonitors = serviceMonitors
 	i.arangoMembers = arangoMembers
+	i.arangoClientCertificates = arangoClientCertificates
 
 	return nil
 }
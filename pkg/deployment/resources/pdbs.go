@@ -54,14 +54,17 @@ func (r *Resources) EnsurePDBs() error {
 		// Coordinators are not that critical. To keep the service available two should be enough
 		minAgents := spec.GetServerGroupSpec(api.ServerGroupAgents).GetCount() - 1
 		minDBServers := spec.GetServerGroupSpec(api.ServerGroupDBServers).GetCount() - 1
-		minCoordinators := min(spec.GetServerGroupSpec(api.ServerGroupCoordinators).GetCount()-1, 2)
+		// Stateless groups may lose up to maxUnavailable members during batch rotation
+		coordinators := spec.GetServerGroupSpec(api.ServerGroupCoordinators)
+		minCoordinators := min(coordinators.GetCount()-coordinators.GetMaxUnavailable(), 2)
 
 		// Setting those to zero triggers a remove of the PDB
 		minSyncMaster := 0
 		minSyncWorker := 0
 		if spec.Sync.IsEnabled() {
 			minSyncMaster = spec.GetServerGroupSpec(api.ServerGroupSyncMasters).GetCount() - 1
-			minSyncWorker = spec.GetServerGroupSpec(api.ServerGroupSyncWorkers).GetCount() - 1
+			syncWorkers := spec.GetServerGroupSpec(api.ServerGroupSyncWorkers)
+			minSyncWorker = syncWorkers.GetCount() - syncWorkers.GetMaxUnavailable()
 		}
 
 		// Ensure all PDBs as calculated
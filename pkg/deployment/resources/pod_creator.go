@@ -91,6 +91,7 @@ func createArangodArgs(input pod.Input, additionalOptions ...k8sutil.OptionPair)
 
 	// TLS
 	options.Merge(pod.TLS().Args(input))
+	options.Merge(pod.TLSClientAuth().Args(input))
 
 	// RocksDB
 	options.Merge(pod.Encryption().Args(input))
@@ -557,6 +558,14 @@ func (r *Resources) createPodForMember(spec api.DeploymentSpec, memberID string,
 	if err := status.Members.Update(m, group); err != nil {
 		return errors.WithStack(err)
 	}
+	// Record operator which rendered the pod
+	if status.Compatibility == nil {
+		status.Compatibility = &api.DeploymentCompatibilityStatus{
+			Level:       api.GetCompatibilityLevel(spec, status),
+			LatestLevel: api.DeploymentCompatibilityLevelLatest,
+		}
+	}
+	status.Compatibility.OperatorVersion = r.context.GetOperatorVersion()
 	if err := r.context.UpdateStatus(status, lastVersion); err != nil {
 		return errors.WithStack(err)
 	}
@@ -217,6 +217,8 @@ func (m *MemberArangoDPod) AsInput() pod.Input {
 		AutoUpgrade:  m.autoUpgrade,
 		Member:       m.status,
 		ArangoMember: m.arangoMember,
+
+		CompatibilityLevel: api.GetCompatibilityLevel(m.spec, m.deploymentStatus),
 	}
 }
 
@@ -245,6 +247,10 @@ func (m *MemberArangoDPod) Validate(cachedStatus interfaces.Inspector) error {
 		return err
 	}
 
+	if err := pod.TLSClientAuth().Verify(i, cachedStatus); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -349,6 +355,7 @@ func (m *MemberArangoDPod) GetVolumes() ([]core.Volume, []core.VolumeMount) {
 
 	// TLS
 	volumes.Append(pod.TLS(), m.AsInput())
+	volumes.Append(pod.TLSClientAuth(), m.AsInput())
 
 	// Encryption
 	volumes.Append(pod.Encryption(), m.AsInput())
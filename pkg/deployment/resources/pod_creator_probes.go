@@ -195,9 +195,19 @@ func (r *Resources) probeCommand(spec api.DeploymentSpec, group api.ServerGroup,
 		args = append(args, "--auth")
 	}
 
+	if r.isTLSClientAuthRequired(spec, group) {
+		args = append(args, fmt.Sprintf("--client-keyfile=%s", pod.GetTLSClientKeyfilePath()))
+	}
+
 	return args, nil
 }
 
+// isTLSClientAuthRequired returns true when servers of the group reject requests without client certificate.
+// HTTP probes of kubelet are not able to present one, so lifecycle probe needs to be used instead.
+func (r *Resources) isTLSClientAuthRequired(spec api.DeploymentSpec, group api.ServerGroup) bool {
+	return spec.TLS.ClientAuth.IsRequired() && pod.IsTLSClientAuthConfigured(spec, group)
+}
+
 func (r *Resources) probeBuilderLivenessCoreSelect() probeBuilder {
 	if features.JWTRotation().Enabled() {
 		return r.probeBuilderLivenessCoreOperator
@@ -218,6 +228,10 @@ func (r *Resources) probeBuilderLivenessCoreOperator(spec api.DeploymentSpec, gr
 }
 
 func (r *Resources) probeBuilderLivenessCore(spec api.DeploymentSpec, group api.ServerGroup, version driver.Version) (Probe, error) {
+	if r.isTLSClientAuthRequired(spec, group) {
+		return r.probeBuilderLivenessCoreOperator(spec, group, version)
+	}
+
 	authorization := ""
 	if spec.IsAuthenticated() {
 		secretData, err := r.getJWTSecret(spec)
@@ -313,6 +327,10 @@ func (r *Resources) probeBuilderReadinessCoreOperator(spec api.DeploymentSpec, g
 }
 
 func (r *Resources) probeBuilderReadinessCore(spec api.DeploymentSpec, group api.ServerGroup, version driver.Version) (Probe, error) {
+	if r.isTLSClientAuthRequired(spec, group) {
+		return r.probeBuilderReadinessCoreOperator(spec, group, version)
+	}
+
 	localPath := "/_api/version"
 	switch spec.GetMode() {
 	case api.DeploymentModeActiveFailover:
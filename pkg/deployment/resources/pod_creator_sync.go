@@ -25,6 +25,8 @@ package resources
 import (
 	"math"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	"github.com/arangodb/kube-arangodb/pkg/util/collection"
 
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil/interfaces"
@@ -40,6 +42,10 @@ import (
 
 const (
 	ArangoSyncExecutor string = "/usr/sbin/arangosync"
+
+	// Annotations understood by the CNI bandwidth plugin
+	podIngressBandwidthAnnotation = "kubernetes.io/ingress-bandwidth"
+	podEgressBandwidthAnnotation  = "kubernetes.io/egress-bandwidth"
 )
 
 type ArangoSyncContainer struct {
@@ -317,7 +323,27 @@ func (m *MemberSyncPod) ApplyPodSpec(spec *core.PodSpec) error {
 }
 
 func (m *MemberSyncPod) Annotations() map[string]string {
-	return collection.MergeAnnotations(m.spec.Annotations, m.groupSpec.Annotations)
+	return collection.MergeAnnotations(m.spec.Annotations, m.groupSpec.Annotations, m.bandwidthAnnotations())
+}
+
+// bandwidthAnnotations returns the CNI bandwidth plugin annotations which limit
+// the network traffic of sync workers to spec.sync.rateLimit.maxBandwidth.
+func (m *MemberSyncPod) bandwidthAnnotations() map[string]string {
+	if m.group != api.ServerGroupSyncWorkers {
+		return nil
+	}
+
+	v := m.spec.Sync.RateLimit.GetMaxBandwidth()
+	if v <= 0 {
+		return nil
+	}
+
+	// The bandwidth plugin expects bits per second
+	bandwidth := resource.NewQuantity(v*8, resource.DecimalSI).String()
+	return map[string]string{
+		podIngressBandwidthAnnotation: bandwidth,
+		podEgressBandwidthAnnotation:  bandwidth,
+	}
 }
 
 func (m *MemberSyncPod) Labels() map[string]string {
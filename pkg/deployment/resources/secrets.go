@@ -23,6 +23,7 @@
 package resources
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
@@ -32,6 +33,7 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 	inspectorInterface "github.com/arangodb/kube-arangodb/pkg/util/k8sutil/inspector"
 
+	"github.com/arangodb/kube-arangodb/pkg/deployment/encryption"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
 	"github.com/arangodb/kube-arangodb/pkg/deployment/patch"
 	"k8s.io/apimachinery/pkg/types"
@@ -56,6 +58,10 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 )
 
+const (
+	encryptionKeyTimeout = 10 * time.Second
+)
+
 var (
 	inspectedSecretsCounters     = metrics.MustRegisterCounterVec(metricsComponent, "inspected_secrets", "Number of Secret inspections per deployment", metrics.DeploymentName)
 	inspectSecretsDurationGauges = metrics.MustRegisterGaugeVec(metricsComponent, "inspect_secrets_duration", "Amount of time taken by a single inspection of all Secrets for a deployment (in sec)", metrics.DeploymentName)
@@ -121,6 +127,13 @@ func (r *Resources) EnsureSecrets(log zerolog.Logger, cachedStatus inspectorInte
 			return errors.WithStack(err)
 		}
 
+		if spec.TLS.ClientAuth.IsEnabled() {
+			counterMetric.Inc()
+			if err := r.refreshCache(cachedStatus, r.ensureTLSClientAuthSecrets(cachedStatus, secrets, spec.TLS.ClientAuth.GetCASecretName(), spec.TLS.GetTTL().AsDuration())); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
 		if err := status.Members.ForeachServerGroup(func(group api.ServerGroup, list api.MemberStatusList) error {
 			if !group.IsArangod() {
 				return nil
@@ -161,6 +174,13 @@ func (r *Resources) EnsureSecrets(log zerolog.Logger, cachedStatus inspectorInte
 						serverNames = append(serverNames, ip)
 					}
 					owner := member.AsOwner()
+					if spec.TLS.IsExternallyIssued() {
+						if err := r.refreshCache(cachedStatus, r.ensureTLSServerCertificateIssued(cachedStatus, secrets, serverNames, spec.TLS, tlsKeyfileSecretName, &owner)); err != nil {
+							return errors.WithStack(errors.Wrapf(err, "Failed to issue TLS keyfile secret"))
+						}
+						continue
+					}
+
 					if err := r.refreshCache(cachedStatus, createTLSServerCertificate(log, secrets, serverNames, spec.TLS, tlsKeyfileSecretName, &owner)); err != nil && !k8sutil.IsAlreadyExists(err) {
 						return errors.WithStack(errors.Wrapf(err, "Failed to create TLS keyfile secret"))
 					}
@@ -177,7 +197,7 @@ func (r *Resources) EnsureSecrets(log zerolog.Logger, cachedStatus inspectorInte
 	}
 	if spec.RocksDB.IsEncrypted() {
 		if i := status.CurrentImage; i != nil && features.EncryptionRotation().Supported(i.ArangoDBVersion, i.Enterprise) {
-			if err := r.refreshCache(cachedStatus, r.ensureEncryptionKeyfolderSecret(cachedStatus, secrets, spec.RocksDB.Encryption.GetKeySecretName(), pod.GetEncryptionFolderSecretName(deploymentName))); err != nil {
+			if err := r.refreshCache(cachedStatus, r.ensureEncryptionKeyfolderSecret(cachedStatus, secrets, spec.RocksDB.Encryption, pod.GetEncryptionFolderSecretName(deploymentName))); err != nil {
 				return errors.WithStack(err)
 			}
 		}
@@ -196,7 +216,7 @@ func (r *Resources) EnsureSecrets(log zerolog.Logger, cachedStatus inspectorInte
 			return errors.WithStack(err)
 		}
 		counterMetric.Inc()
-		if err := r.refreshCache(cachedStatus, r.ensureClientAuthCACertificateSecret(cachedStatus, secrets, spec.Sync.Authentication)); err != nil {
+		if err := r.refreshCache(cachedStatus, r.ensureClientAuthCACertificateSecret(cachedStatus, secrets, spec.Sync.Authentication.GetClientCASecretName())); err != nil {
 			return errors.WithStack(err)
 		}
 	}
@@ -401,7 +421,8 @@ func (r *Resources) createTokenSecret(secrets k8sutil.SecretInterface, secretNam
 	return operatorErrors.Reconcile()
 }
 
-func (r *Resources) ensureEncryptionKeyfolderSecret(cachedStatus inspectorInterface.Inspector, secrets k8sutil.SecretInterface, keyfileSecretName, secretName string) error {
+func (r *Resources) ensureEncryptionKeyfolderSecret(cachedStatus inspectorInterface.Inspector, secrets k8sutil.SecretInterface, spec api.RocksDBEncryptionSpec, secretName string) error {
+	keyfileSecretName := spec.GetKeySecretName()
 	_, folderExists := cachedStatus.Secret(secretName)
 
 	keyfile, exists := cachedStatus.Secret(keyfileSecretName)
@@ -409,22 +430,32 @@ func (r *Resources) ensureEncryptionKeyfolderSecret(cachedStatus inspectorInterf
 		if folderExists {
 			return nil
 		}
+		if spec.GetProvider() == api.RocksDBEncryptionProviderKMS {
+			// Wrapped key can be created only by the operator
+			return r.createEncryptionKeySecret(secrets, spec, keyfileSecretName)
+		}
 		return errors.Newf("Unable to find original secret %s", keyfileSecretName)
 	}
 
+	if folderExists {
+		return nil
+	}
+
 	if len(keyfile.Data) == 0 {
-		if folderExists {
-			return nil
-		}
 		return errors.Newf("Missing key in secret")
 	}
 
-	d, ok := keyfile.Data[constants.SecretEncryptionKey]
-	if !ok {
-		if folderExists {
-			return nil
-		}
-		return errors.Newf("Missing key in secret")
+	provider, err := encryption.NewKeyProvider(spec, secrets)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), encryptionKeyTimeout)
+	defer cancel()
+
+	_, d, err := provider.Key(ctx, keyfile)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get encryption key")
 	}
 
 	owner := r.context.GetAPIObject().AsOwner()
@@ -434,6 +465,30 @@ func (r *Resources) ensureEncryptionKeyfolderSecret(cachedStatus inspectorInterf
 	return nil
 }
 
+// createEncryptionKeySecret creates the key secret with a new key generated by the provider.
+func (r *Resources) createEncryptionKeySecret(secrets k8sutil.SecretInterface, spec api.RocksDBEncryptionSpec, secretName string) error {
+	provider, err := encryption.NewKeyProvider(spec, secrets)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), encryptionKeyTimeout)
+	defer cancel()
+
+	_, data, err := provider.Generate(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to generate encryption key")
+	}
+
+	if err := r.createSecretWithMod(secrets, secretName, func(s *core.Secret) {
+		s.Data = data
+	}); err != nil && !k8sutil.IsAlreadyExists(err) {
+		return err
+	}
+
+	return operatorErrors.Reconcile()
+}
+
 func AppendKeyfileToKeyfolder(cachedStatus inspectorInterface.Inspector, secrets k8sutil.SecretInterface, ownerRef *meta.OwnerReference, secretName string, encryptionKey []byte) error {
 	encSha := fmt.Sprintf("%0x", sha256.Sum256(encryptionKey))
 	if _, exists := cachedStatus.Secret(secretName); !exists {
@@ -484,6 +539,8 @@ func (r *Resources) ensureExporterTokenSecret(cachedStatus inspectorInterface.In
 				// Failed to create secret
 				return errors.WithStack(err)
 			}
+		} else if err := r.updateExporterTokenSecret(cachedStatus, secrets, tokenSecretName, secretSecretName); err != nil {
+			return errors.WithStack(err)
 		}
 
 		return operatorErrors.Reconcile()
@@ -491,6 +548,44 @@ func (r *Resources) ensureExporterTokenSecret(cachedStatus inspectorInterface.In
 	return nil
 }
 
+// updateExporterTokenSecret signs the exporter token again with the active key of the JWT folder.
+// It keeps the exporter authenticated after the active JWT key was rotated. Key becomes active only once
+// it is propagated to all servers, so the new token is accepted everywhere. Secrets without the active key
+// (deployments without JWT folder) are not re-signed.
+func (r *Resources) updateExporterTokenSecret(cachedStatus inspectorInterface.Inspector, secrets k8sutil.SecretInterface, tokenSecretName, secretSecretName string) error {
+	tokenSecret, exists := cachedStatus.Secret(tokenSecretName)
+	if !exists {
+		return errors.Newf("Secret %s does not exists", tokenSecretName)
+	}
+
+	jwtSecret, exists := cachedStatus.Secret(secretSecretName)
+	if !exists {
+		return errors.Newf("Secret %s does not exists", secretSecretName)
+	}
+
+	secret, ok := jwtSecret.Data[pod.ActiveJWTKey]
+	if !ok || len(secret) == 0 {
+		return nil
+	}
+
+	token, err := k8sutil.CreateJWTTokenFromSecret(string(secret), exporterTokenClaims)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	tokenSecret = tokenSecret.DeepCopy()
+	if tokenSecret.Data == nil {
+		tokenSecret.Data = map[string][]byte{}
+	}
+	tokenSecret.Data[constants.SecretKeyToken] = []byte(token)
+
+	if _, err := secrets.Update(tokenSecret); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
 func (r *Resources) ensureExporterTokenSecretCreateRequired(cachedStatus inspectorInterface.Inspector, tokenSecretName, secretSecretName string) (bool, bool, error) {
 	if secret, exists := cachedStatus.Secret(tokenSecretName); !exists {
 		return true, false, nil
@@ -532,6 +627,11 @@ func (r *Resources) ensureExporterTokenSecretCreateRequired(cachedStatus inspect
 // of the deployment. If not, it will add such a secret with a generated CA certificate.
 func (r *Resources) ensureTLSCACertificateSecret(cachedStatus inspectorInterface.Inspector, secrets k8sutil.SecretInterface, spec api.TLSSpec) error {
 	if _, exists := cachedStatus.Secret(spec.GetCASecretName()); !exists {
+		if spec.IsExternallyIssued() {
+			// CA of the external issuer needs to be provided
+			return errors.Newf("CA Secret %s with certificate of the issuer is missing", spec.GetCASecretName())
+		}
+
 		// Secret not found, create it
 		apiObject := r.context.GetAPIObject()
 		owner := apiObject.AsOwner()
@@ -549,6 +649,36 @@ func (r *Resources) ensureTLSCACertificateSecret(cachedStatus inspectorInterface
 	return nil
 }
 
+// ensureTLSServerCertificateIssued requests a server certificate from the external issuer
+// and stores it in a keyfile secret with given name once it is signed.
+func (r *Resources) ensureTLSServerCertificateIssued(cachedStatus inspectorInterface.Inspector, secrets k8sutil.SecretInterface, serverNames []string, spec api.TLSSpec,
+	secretName string, ownerRef *meta.OwnerReference) error {
+	issuer, err := newTLSCertificateIssuer(r.context.GetKubeCli(), r.context.GetNamespace(), spec.Issuer)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	requestSecret, exists := cachedStatus.Secret(k8sutil.AppendTLSRequestSecretPostfix(secretName))
+	if !exists {
+		if err := requestTLSServerCertificate(r.log, secrets, issuer, serverNames, spec, secretName, ownerRef); err != nil && !k8sutil.IsAlreadyExists(err) {
+			return errors.WithStack(err)
+		}
+
+		return operatorErrors.Reconcile()
+	}
+
+	issued, err := collectTLSServerCertificate(r.log, secrets, issuer, requestSecret, secretName, ownerRef)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if issued {
+		return operatorErrors.Reconcile()
+	}
+
+	return nil
+}
+
 // ensureTLSCACertificateSecret checks if a secret with given name exists in the namespace
 // of the deployment. If not, it will add such a secret with a generated CA certificate.
 func (r *Resources) ensureTLSCAFolderSecret(cachedStatus inspectorInterface.Inspector, secrets k8sutil.SecretInterface, spec api.TLSSpec, folderSecretName string) error {
@@ -562,7 +692,7 @@ func (r *Resources) ensureTLSCAFolderSecret(cachedStatus inspectorInterface.Insp
 	}
 
 	if _, exists := cachedStatus.Secret(spec.GetCASecretName()); !exists {
-		ca, _, err := GetKeyCertFromSecret(r.log, caSecret, CACertName, CAKeyName)
+		ca, err := GetCAFromSecret(r.log, caSecret, spec)
 		if err != nil {
 			return errors.WithStack(err)
 		}
@@ -588,13 +718,13 @@ func (r *Resources) ensureTLSCAFolderSecret(cachedStatus inspectorInterface.Insp
 
 // ensureClientAuthCACertificateSecret checks if a secret with given name exists in the namespace
 // of the deployment. If not, it will add such a secret with a generated CA certificate.
-func (r *Resources) ensureClientAuthCACertificateSecret(cachedStatus inspectorInterface.Inspector, secrets k8sutil.SecretInterface, spec api.SyncAuthenticationSpec) error {
-	if _, exists := cachedStatus.Secret(spec.GetClientCASecretName()); !exists {
+func (r *Resources) ensureClientAuthCACertificateSecret(cachedStatus inspectorInterface.Inspector, secrets k8sutil.SecretInterface, caSecretName string) error {
+	if _, exists := cachedStatus.Secret(caSecretName); !exists {
 		// Secret not found, create it
 		apiObject := r.context.GetAPIObject()
 		owner := apiObject.AsOwner()
 		deploymentName := apiObject.GetName()
-		if err := createClientAuthCACertificate(r.log, secrets, spec, deploymentName, &owner); k8sutil.IsAlreadyExists(err) {
+		if err := createClientAuthCACertificate(r.log, secrets, caSecretName, deploymentName, &owner); k8sutil.IsAlreadyExists(err) {
 			// Secret added while we tried it also
 			return nil
 		} else if err != nil {
@@ -607,6 +737,59 @@ func (r *Resources) ensureClientAuthCACertificateSecret(cachedStatus inspectorIn
 	return nil
 }
 
+// ensureTLSClientAuthSecrets ensures that the client authentication CA, the truststore mounted into the servers
+// and the keyfile used by the operator to authenticate itself exist.
+func (r *Resources) ensureTLSClientAuthSecrets(cachedStatus inspectorInterface.Inspector, secrets k8sutil.SecretInterface, caSecretName string, ttl time.Duration) error {
+	if err := r.ensureClientAuthCACertificateSecret(cachedStatus, secrets, caSecretName); err != nil {
+		return err
+	}
+
+	caSecret, exists := cachedStatus.Secret(caSecretName)
+	if !exists {
+		return errors.Newf("Client CA Secret is missing")
+	}
+
+	caCert, ok := caSecret.Data[constants.SecretCACertificate]
+	if !ok {
+		return errors.Newf("Client CA Secret is invalid")
+	}
+
+	apiObject := r.context.GetAPIObject()
+	deploymentName := apiObject.GetName()
+	owner := apiObject.AsOwner()
+
+	truststoreSecretName := k8sutil.CreateTLSClientTruststoreSecretName(deploymentName)
+	if truststore, exists := cachedStatus.Secret(truststoreSecretName); !exists {
+		return r.createSecretWithMod(secrets, truststoreSecretName, func(s *core.Secret) {
+			s.Data[constants.SecretCACertificate] = caCert
+		})
+	} else if !equality.Semantic.DeepEqual(truststore.Data[constants.SecretCACertificate], caCert) {
+		truststore = truststore.DeepCopy()
+		truststore.Data = map[string][]byte{
+			constants.SecretCACertificate: caCert,
+		}
+
+		if _, err := secrets.Update(truststore); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return operatorErrors.Reconcile()
+	}
+
+	keyfileSecretName := k8sutil.CreateTLSClientKeyfileSecretName(deploymentName)
+	if _, exists := cachedStatus.Secret(keyfileSecretName); !exists {
+		if err := createClientAuthCertificateKeyfile(r.log, secrets, operatorClientAuthCommonName, ttl, caSecretName, keyfileSecretName, &owner); k8sutil.IsAlreadyExists(err) {
+			return nil
+		} else if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return operatorErrors.Reconcile()
+	}
+
+	return nil
+}
+
 // getJWTSecret loads the JWT secret from a Secret configured in apiObject.Spec.Authentication.JWTSecretName.
 func (r *Resources) getJWTSecret(spec api.DeploymentSpec) (string, error) {
 	if !spec.IsAuthenticated() {
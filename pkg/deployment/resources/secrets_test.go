@@ -0,0 +1,90 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/arangodb/kube-arangodb/pkg/deployment/pod"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/resources/inspector"
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	jg "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/require"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestUpdateExporterTokenSecret(t *testing.T) {
+	const (
+		namespace   = "test"
+		tokenName   = "test-exporter-jwt"
+		folderName  = "test-jwt-folder"
+		oldKey      = "old"
+		newKey      = "new"
+		otherSecret = "test-jwt"
+	)
+
+	tokenSecret := &core.Secret{
+		ObjectMeta: meta.ObjectMeta{Name: tokenName, Namespace: namespace},
+		Data:       map[string][]byte{constants.SecretKeyToken: []byte("signed-with-old-key")},
+	}
+	folderSecret := &core.Secret{
+		ObjectMeta: meta.ObjectMeta{Name: folderName, Namespace: namespace},
+		Data: map[string][]byte{
+			oldKey:                   []byte(oldKey),
+			newKey:                   []byte(newKey),
+			pod.ActiveJWTKey:         []byte(newKey),
+			constants.SecretKeyToken: []byte(newKey),
+		},
+	}
+	jwtSecret := &core.Secret{
+		ObjectMeta: meta.ObjectMeta{Name: otherSecret, Namespace: namespace},
+		Data:       map[string][]byte{constants.SecretKeyToken: []byte(newKey)},
+	}
+
+	kubeCli := fake.NewSimpleClientset(tokenSecret, folderSecret, jwtSecret)
+	secrets := kubeCli.CoreV1().Secrets(namespace)
+	cachedStatus := inspector.NewInspectorFromData(nil, map[string]*core.Secret{
+		tokenName:   tokenSecret,
+		folderName:  folderSecret,
+		otherSecret: jwtSecret,
+	}, nil, nil, nil, nil, nil, nil, nil)
+
+	r := &Resources{}
+
+	// Secret without active key is not used to sign the token
+	require.NoError(t, r.updateExporterTokenSecret(cachedStatus, secrets, tokenName, otherSecret))
+	s, err := secrets.Get(tokenName, meta.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "signed-with-old-key", string(s.Data[constants.SecretKeyToken]))
+
+	// Token is signed with the active key of the folder
+	require.NoError(t, r.updateExporterTokenSecret(cachedStatus, secrets, tokenName, folderName))
+	s, err = secrets.Get(tokenName, meta.GetOptions{})
+	require.NoError(t, err)
+
+	token, err := jg.Parse(string(s.Data[constants.SecretKeyToken]), func(token *jg.Token) (interface{}, error) {
+		return []byte(newKey), nil
+	})
+	require.NoError(t, err)
+	require.True(t, token.Valid)
+}
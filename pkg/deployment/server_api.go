@@ -23,8 +23,10 @@
 package deployment
 
 import (
+	"fmt"
 	"sort"
 
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
 	"github.com/arangodb/kube-arangodb/pkg/util/errors"
 
 	v1 "k8s.io/api/core/v1"
@@ -231,6 +233,34 @@ func (d *Deployment) DatabaseVersion() (string, string) {
 	return "", ""
 }
 
+// UpgradeReport returns the report of the upgrade to the given image.
+// When image is empty, the report of the candidate image from the status is returned.
+func (d *Deployment) UpgradeReport(image string) (*api.DeploymentUpgradeReport, error) {
+	status, _ := d.GetStatus()
+	if image == "" {
+		if status.UpgradeReport == nil {
+			return nil, errors.WithStack(server.NotFoundError)
+		}
+		return status.UpgradeReport.DeepCopy(), nil
+	}
+
+	info, found := status.Images.GetByImage(image)
+	if !found {
+		return &api.DeploymentUpgradeReport{
+			Image:   image,
+			Message: fmt.Sprintf("Image is not discovered yet, set the %s annotation to discover it", deployment.ArangoDeploymentUpgradeCandidateAnnotation),
+		}, nil
+	}
+
+	return newUpgradeReport(d.GetSpec(), status, info), nil
+}
+
+// Compatibility returns the pod rendering compatibility of the deployment.
+func (d *Deployment) Compatibility() *api.DeploymentCompatibilityStatus {
+	status, _ := d.GetStatus()
+	return status.Compatibility.DeepCopy()
+}
+
 // Members returns all members of the deployment by role.
 func (d *Deployment) Members() map[api.ServerGroup][]server.Member {
 	result := make(map[api.ServerGroup][]server.Member)
@@ -0,0 +1,165 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"context"
+
+	upgraderules "github.com/arangodb/go-upgrade-rules"
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/features"
+	"github.com/arangodb/kube-arangodb/pkg/deployment/reconcile"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// inspectUpgradeReport discovers the image given in the upgrade candidate annotation and stores
+// the report of the upgrade to that image in the status. The spec of the deployment is not changed.
+// Returns true when the inspection should be retried soon.
+func (d *Deployment) inspectUpgradeReport(ctx context.Context, apiObject *api.ArangoDeployment) (bool, error) {
+	candidate := apiObject.GetAnnotations()[deployment.ArangoDeploymentUpgradeCandidateAnnotation]
+	if candidate == "" {
+		return false, d.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+			if s.UpgradeReport == nil {
+				return false
+			}
+
+			s.UpgradeReport = nil
+			return true
+		})
+	}
+
+	status, lastVersion := d.GetStatus()
+	if _, found := status.Images.GetByImage(candidate); !found {
+		ib := imagesBuilder{
+			APIObject: apiObject,
+			Spec:      apiObject.Spec,
+			Status:    status,
+			Log:       d.deps.Log,
+			KubeCli:   d.deps.KubeCli,
+			UpdateCRStatus: func(status api.DeploymentStatus) error {
+				if err := d.UpdateStatus(status, lastVersion); err != nil {
+					return errors.WithStack(err)
+				}
+				return nil
+			},
+		}
+
+		retrySoon, _, err := ib.RunForImage(ctx, candidate)
+		if err != nil {
+			return retrySoon, errors.WithStack(err)
+		}
+
+		if err := d.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+			if r := s.UpgradeReport; r != nil && r.Image == candidate {
+				return false
+			}
+
+			s.UpgradeReport = &api.DeploymentUpgradeReport{Image: candidate}
+			return true
+		}); err != nil {
+			return retrySoon, errors.WithStack(err)
+		}
+
+		return retrySoon, nil
+	}
+
+	return false, d.WithStatusUpdate(func(s *api.DeploymentStatus) bool {
+		info, found := s.Images.GetByImage(candidate)
+		if !found {
+			return false
+		}
+
+		report := newUpgradeReport(apiObject.Spec, *s, info)
+		if s.UpgradeReport.Equal(report) {
+			return false
+		}
+
+		s.UpgradeReport = report
+		return true
+	})
+}
+
+// newUpgradeReport describes what the operator does when the deployment image is changed to the candidate image.
+func newUpgradeReport(spec api.DeploymentSpec, status api.DeploymentStatus, candidate api.ImageInfo) *api.DeploymentUpgradeReport {
+	versionCheck := features.UpgradeVersionCheck()
+
+	report := &api.DeploymentUpgradeReport{
+		Image:               candidate.Image,
+		Discovered:          true,
+		ToVersion:           candidate.ArangoDBVersion,
+		ToEnterprise:        candidate.Enterprise,
+		UpgradeVersionCheck: versionCheck.Enabled() && versionCheck.Supported(candidate.ArangoDBVersion, candidate.Enterprise),
+	}
+
+	current := status.CurrentImage
+	if current == nil {
+		if info, found := status.Images.GetByImage(spec.GetImage()); found {
+			current = &info
+		}
+	}
+
+	if current == nil {
+		report.Message = "Image currently used by the deployment is not known yet"
+		return report
+	}
+
+	report.FromVersion = current.ArangoDBVersion
+	report.FromEnterprise = current.Enterprise
+	report.LicenseChanged = current.Enterprise != candidate.Enterprise
+
+	if err := upgraderules.CheckUpgradeRulesWithLicense(current.ArangoDBVersion, candidate.ArangoDBVersion,
+		reconcile.ImageInfoLicense(*current), reconcile.ImageInfoLicense(candidate)); err != nil {
+		report.Decision = api.DeploymentUpgradeReportDecisionNotAllowed
+		report.Message = err.Error()
+		return report
+	}
+
+	report.Allowed = true
+
+	switch {
+	case current.Image == candidate.Image:
+		report.Decision = api.DeploymentUpgradeReportDecisionNone
+		return report
+	case current.ArangoDBVersion.Major() != candidate.ArangoDBVersion.Major() ||
+		current.ArangoDBVersion.Minor() != candidate.ArangoDBVersion.Minor():
+		report.Decision = api.DeploymentUpgradeReportDecisionMinor
+	default:
+		report.Decision = api.DeploymentUpgradeReportDecisionPatch
+	}
+
+	status.Members.ForeachServerInGroups(func(group api.ServerGroup, members api.MemberStatusList) error {
+		for _, m := range members {
+			if m.Image != nil && m.Image.Image == candidate.Image {
+				continue
+			}
+
+			report.Members = append(report.Members, api.DeploymentUpgradeReportMember{
+				ID:      m.ID,
+				Group:   group,
+				Upgrade: !group.IsStateless(),
+			})
+		}
+		return nil
+	}, spec.Upgrade.Get().GetOrder()...)
+
+	return report
+}
@@ -0,0 +1,96 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package deployment
+
+import (
+	"testing"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUpgradeReport(t *testing.T) {
+	current := api.ImageInfo{Image: "arangodb:3.7.1", ImageID: "id1", ArangoDBVersion: "3.7.1", Enterprise: true}
+
+	spec := api.DeploymentSpec{
+		Mode:  api.NewMode(api.DeploymentModeCluster),
+		Image: util.NewString(current.Image),
+	}
+
+	var status api.DeploymentStatus
+	status.CurrentImage = current.DeepCopy()
+	status.Members.Agents = api.MemberStatusList{{ID: "agnt1", Image: current.DeepCopy()}}
+	status.Members.DBServers = api.MemberStatusList{{ID: "prmr1", Image: current.DeepCopy()}}
+	status.Members.Coordinators = api.MemberStatusList{{ID: "crdn1", Image: current.DeepCopy()}}
+
+	t.Run("Same image", func(t *testing.T) {
+		report := newUpgradeReport(spec, status, current)
+		assert.True(t, report.Discovered)
+		assert.True(t, report.Allowed)
+		assert.Equal(t, api.DeploymentUpgradeReportDecisionNone, report.Decision)
+		assert.Empty(t, report.Members)
+	})
+
+	t.Run("Patch upgrade", func(t *testing.T) {
+		report := newUpgradeReport(spec, status, api.ImageInfo{Image: "arangodb:3.7.2", ArangoDBVersion: "3.7.2", Enterprise: true})
+		assert.True(t, report.Allowed)
+		assert.False(t, report.LicenseChanged)
+		assert.Equal(t, api.DeploymentUpgradeReportDecisionPatch, report.Decision)
+		require.Len(t, report.Members, 3)
+		assert.Equal(t, api.DeploymentUpgradeReportMember{ID: "agnt1", Group: api.ServerGroupAgents, Upgrade: true}, report.Members[0])
+		assert.Equal(t, api.DeploymentUpgradeReportMember{ID: "prmr1", Group: api.ServerGroupDBServers, Upgrade: true}, report.Members[1])
+		assert.Equal(t, api.DeploymentUpgradeReportMember{ID: "crdn1", Group: api.ServerGroupCoordinators}, report.Members[2])
+	})
+
+	t.Run("Minor upgrade in custom order", func(t *testing.T) {
+		orderedSpec := spec
+		orderedSpec.Upgrade = &api.DeploymentUpgradeSpec{Order: []api.ServerGroup{api.ServerGroupCoordinators}}
+
+		report := newUpgradeReport(orderedSpec, status, api.ImageInfo{Image: "arangodb:3.8.0", ArangoDBVersion: "3.8.0", Enterprise: true})
+		assert.True(t, report.Allowed)
+		assert.Equal(t, api.DeploymentUpgradeReportDecisionMinor, report.Decision)
+		require.Len(t, report.Members, 3)
+		assert.Equal(t, "crdn1", report.Members[0].ID)
+	})
+
+	t.Run("Major upgrade", func(t *testing.T) {
+		report := newUpgradeReport(spec, status, api.ImageInfo{Image: "arangodb:4.0.0", ArangoDBVersion: "4.0.0", Enterprise: true})
+		assert.False(t, report.Allowed)
+		assert.Equal(t, api.DeploymentUpgradeReportDecisionNotAllowed, report.Decision)
+		assert.NotEmpty(t, report.Message)
+		assert.Empty(t, report.Members)
+	})
+
+	t.Run("Edition change", func(t *testing.T) {
+		report := newUpgradeReport(spec, status, api.ImageInfo{Image: "arangodb/community:3.7.1", ArangoDBVersion: "3.7.1"})
+		assert.True(t, report.LicenseChanged)
+		assert.False(t, report.Allowed)
+		assert.Equal(t, api.DeploymentUpgradeReportDecisionNotAllowed, report.Decision)
+	})
+
+	t.Run("Unknown current image", func(t *testing.T) {
+		report := newUpgradeReport(spec, api.DeploymentStatus{}, current)
+		assert.False(t, report.Allowed)
+		assert.NotEmpty(t, report.Message)
+	})
+}
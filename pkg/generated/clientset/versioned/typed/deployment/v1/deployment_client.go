@@ -30,6 +30,7 @@ import (
 
 type DatabaseV1Interface interface {
 	RESTClient() rest.Interface
+	ArangoClientCertificatesGetter
 	ArangoDeploymentsGetter
 	ArangoMembersGetter
 }
@@ -39,6 +40,10 @@ type DatabaseV1Client struct {
 	restClient rest.Interface
 }
 
+func (c *DatabaseV1Client) ArangoClientCertificates(namespace string) ArangoClientCertificateInterface {
+	return newArangoClientCertificates(c, namespace)
+}
+
 func (c *DatabaseV1Client) ArangoDeployments(namespace string) ArangoDeploymentInterface {
 	return newArangoDeployments(c, namespace)
 }
@@ -22,6 +22,8 @@
 
 package v1
 
+type ArangoClientCertificateExpansion interface{}
+
 type ArangoDeploymentExpansion interface{}
 
 type ArangoMemberExpansion interface{}
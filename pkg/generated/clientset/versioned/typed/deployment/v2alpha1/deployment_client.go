@@ -30,6 +30,7 @@ import (
 
 type DatabaseV2alpha1Interface interface {
 	RESTClient() rest.Interface
+	ArangoClientCertificatesGetter
 	ArangoDeploymentsGetter
 	ArangoMembersGetter
 }
@@ -39,6 +40,10 @@ type DatabaseV2alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *DatabaseV2alpha1Client) ArangoClientCertificates(namespace string) ArangoClientCertificateInterface {
+	return newArangoClientCertificates(c, namespace)
+}
+
 func (c *DatabaseV2alpha1Client) ArangoDeployments(namespace string) ArangoDeploymentInterface {
 	return newArangoDeployments(c, namespace)
 }
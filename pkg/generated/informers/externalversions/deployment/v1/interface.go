@@ -28,6 +28,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ArangoClientCertificates returns a ArangoClientCertificateInformer.
+	ArangoClientCertificates() ArangoClientCertificateInformer
 	// ArangoDeployments returns a ArangoDeploymentInformer.
 	ArangoDeployments() ArangoDeploymentInformer
 	// ArangoMembers returns a ArangoMemberInformer.
@@ -45,6 +47,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// ArangoClientCertificates returns a ArangoClientCertificateInformer.
+func (v *version) ArangoClientCertificates() ArangoClientCertificateInformer {
+	return &arangoClientCertificateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ArangoDeployments returns a ArangoDeploymentInformer.
 func (v *version) ArangoDeployments() ArangoDeploymentInformer {
 	return &arangoDeploymentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
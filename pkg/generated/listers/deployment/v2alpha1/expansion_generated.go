@@ -22,6 +22,14 @@
 
 package v2alpha1
 
+// ArangoClientCertificateListerExpansion allows custom methods to be added to
+// ArangoClientCertificateLister.
+type ArangoClientCertificateListerExpansion interface{}
+
+// ArangoClientCertificateNamespaceListerExpansion allows custom methods to be added to
+// ArangoClientCertificateNamespaceLister.
+type ArangoClientCertificateNamespaceListerExpansion interface{}
+
 // ArangoDeploymentListerExpansion allows custom methods to be added to
 // ArangoDeploymentLister.
 type ArangoDeploymentListerExpansion interface{}
@@ -33,6 +33,12 @@ const (
 
 	// DeploymentName is a label key used for the name of a deployment
 	DeploymentName = "deployment"
+	// DeploymentReplicationName is a label key used for the name of a deployment replication
+	DeploymentReplicationName = "deployment_replication"
+	// Database is a label key used for the name of a database
+	Database = "database"
+	// Collection is a label key used for the name of a collection
+	Collection = "collection"
 	// Result is a label key used for the result of an action (Success|Failed)
 	Result = "result"
 	// Success is a label value used for successful actions
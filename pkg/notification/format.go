@@ -0,0 +1,120 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+// Format of the webhook payload
+type Format string
+
+const (
+	// FormatGeneric sends the notification as plain JSON object
+	FormatGeneric Format = "generic"
+	// FormatSlack sends the notification as Slack incoming webhook message
+	FormatSlack Format = "slack"
+	// FormatCloudEvents sends the notification as CloudEvents 1.0 structured mode event
+	FormatCloudEvents Format = "cloudevents"
+
+	cloudEventsSpecVersion = "1.0"
+	cloudEventsTypePrefix  = "com.arangodb.kube."
+	cloudEventsSource      = "kube-arangodb"
+
+	contentTypeJSON        = "application/json"
+	contentTypeCloudEvents = "application/cloudevents+json"
+)
+
+// Validate returns an error if the format is not known
+func (f Format) Validate() error {
+	switch f {
+	case FormatGeneric, FormatSlack, FormatCloudEvents:
+		return nil
+	default:
+		return errors.Newf("unknown notification format %s", f)
+	}
+}
+
+// slackMessage is the payload of the Slack incoming webhook
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// cloudEvent is the structured mode representation of the CloudEvent
+type cloudEvent struct {
+	SpecVersion     string       `json:"specversion"`
+	ID              string       `json:"id"`
+	Source          string       `json:"source"`
+	Type            string       `json:"type"`
+	Subject         string       `json:"subject"`
+	Time            string       `json:"time"`
+	DataContentType string       `json:"datacontenttype"`
+	Data            Notification `json:"data"`
+}
+
+// Encode returns the content type and the body of the webhook request for the notification
+func (f Format) Encode(n Notification) (string, []byte, error) {
+	var obj interface{}
+	contentType := contentTypeJSON
+
+	switch f {
+	case FormatGeneric:
+		obj = n
+	case FormatSlack:
+		obj = slackMessage{Text: slackText(n)}
+	case FormatCloudEvents:
+		contentType = contentTypeCloudEvents
+		obj = cloudEvent{
+			SpecVersion:     cloudEventsSpecVersion,
+			ID:              string(uuid.NewUUID()),
+			Source:          fmt.Sprintf("%s/%s", cloudEventsSource, n.Namespace),
+			Type:            cloudEventsTypePrefix + string(n.Type),
+			Subject:         n.Subject(),
+			Time:            n.Time.Format(time.RFC3339Nano),
+			DataContentType: contentTypeJSON,
+			Data:            n,
+		}
+	default:
+		return "", nil, f.Validate()
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", nil, errors.WithStack(err)
+	}
+
+	return contentType, data, nil
+}
+
+// slackText returns the message text in the Slack markdown
+func slackText(n Notification) string {
+	icon := ":information_source:"
+	if n.Severity == "Warning" {
+		icon = ":warning:"
+	}
+
+	return fmt.Sprintf("%s *%s* %s `%s`: %s", icon, n.Reason, n.Kind, n.Subject(), n.Message)
+}
@@ -0,0 +1,174 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package notification
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	meta "k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	"github.com/arangodb/kube-arangodb/pkg/metrics"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+var (
+	notificationsSent    = metrics.MustRegisterCounter("notification", "sent", "Number of notifications delivered to the webhook")
+	notificationsFailed  = metrics.MustRegisterCounter("notification", "failed", "Number of notifications which could not be delivered to the webhook")
+	notificationsDropped = metrics.MustRegisterCounter("notification", "dropped", "Number of notifications dropped because the queue was full")
+)
+
+const (
+	// FilterNone disables all notifications of the resource when set in the notifications annotation
+	FilterNone = "none"
+)
+
+// Types returns all known notification types
+func Types() []k8sutil.EventNotification {
+	return []k8sutil.EventNotification{
+		k8sutil.EventNotificationDeploymentPhase,
+		k8sutil.EventNotificationMemberFailed,
+		k8sutil.EventNotificationUpgradeStarted,
+		k8sutil.EventNotificationUpgradeFinished,
+		k8sutil.EventNotificationBackupFailed,
+		k8sutil.EventNotificationReplicationUnhealthy,
+	}
+}
+
+// Notification is a single message sent to the webhook
+type Notification struct {
+	// Type of the notification
+	Type k8sutil.EventNotification `json:"type"`
+	// Severity is the type of the Kubernetes event (Normal or Warning)
+	Severity string `json:"severity"`
+	// Time when the notification was created
+	Time time.Time `json:"time"`
+	// Kind of the resource
+	Kind string `json:"kind"`
+	// Namespace of the resource
+	Namespace string `json:"namespace"`
+	// Name of the resource
+	Name string `json:"name"`
+	// Reason of the event
+	Reason string `json:"reason"`
+	// Message of the event
+	Message string `json:"message"`
+}
+
+// Subject returns namespace/name of the resource
+func (n Notification) Subject() string {
+	return n.Namespace + "/" + n.Name
+}
+
+var (
+	lock     sync.RWMutex
+	notifier *Notifier
+)
+
+// SetNotifier sets the notifier used to send notifications. Notifications are disabled when nil.
+func SetNotifier(n *Notifier) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if notifier != nil {
+		notifier.Close()
+	}
+
+	notifier = n
+}
+
+// Notify sends the notification for the given event if the event has a notification type,
+// the type is enabled in the operator and it is not filtered out by the resource.
+func Notify(evt *k8sutil.Event) {
+	if evt == nil || evt.Notification == k8sutil.EventNotificationNone {
+		return
+	}
+
+	lock.RLock()
+	defer lock.RUnlock()
+
+	if notifier == nil || !notifier.accepts(evt.Notification) {
+		return
+	}
+
+	n, ok := newNotification(evt)
+	if !ok {
+		return
+	}
+
+	notifier.enqueue(n)
+}
+
+// newNotification creates notification from the event. Returns false if the resource filters out the event.
+func newNotification(evt *k8sutil.Event) (Notification, bool) {
+	n := Notification{
+		Type:     evt.Notification,
+		Severity: evt.Type,
+		Time:     time.Now().UTC(),
+		Reason:   evt.Reason,
+		Message:  evt.Message,
+	}
+
+	if evt.InvolvedObject == nil {
+		return n, true
+	}
+
+	n.Kind = evt.InvolvedObject.GetObjectKind().GroupVersionKind().Kind
+	if n.Kind == "" {
+		n.Kind = reflect.Indirect(reflect.ValueOf(evt.InvolvedObject)).Type().Name()
+	}
+
+	obj, err := meta.Accessor(evt.InvolvedObject)
+	if err != nil {
+		log.Warn().Err(err).Msg("Unable to get metadata of the notification object")
+		return n, true
+	}
+
+	n.Namespace = obj.GetNamespace()
+	n.Name = obj.GetName()
+
+	return n, IsAllowed(obj.GetAnnotations(), evt.Notification)
+}
+
+// IsAllowed returns true if the notifications annotation of the resource allows the given notification type.
+// Without annotation all notification types are allowed.
+func IsAllowed(annotations map[string]string, t k8sutil.EventNotification) bool {
+	filter, ok := annotations[deployment.ArangoDeploymentNotificationsAnnotation]
+	if !ok {
+		return true
+	}
+
+	for _, f := range strings.Split(filter, ",") {
+		f = strings.TrimSpace(f)
+		if strings.EqualFold(f, FilterNone) {
+			return false
+		}
+		if strings.EqualFold(f, string(t)) {
+			return true
+		}
+	}
+
+	return false
+}
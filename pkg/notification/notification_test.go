@@ -0,0 +1,242 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package notification
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+type webhook struct {
+	lock     sync.Mutex
+	failures int
+	status   int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (w *webhook) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	body, _ := ioutil.ReadAll(req.Body)
+	w.requests = append(w.requests, req)
+	w.bodies = append(w.bodies, body)
+
+	if w.failures > 0 {
+		w.failures--
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if w.status != 0 {
+		rw.WriteHeader(w.status)
+		return
+	}
+
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (w *webhook) count() int {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return len(w.requests)
+}
+
+func newDeployment(annotations map[string]string) *api.ArangoDeployment {
+	return &api.ArangoDeployment{
+		ObjectMeta: meta.ObjectMeta{
+			Name:        "test",
+			Namespace:   "ns",
+			Annotations: annotations,
+		},
+	}
+}
+
+func Test_IsAllowed(t *testing.T) {
+	require.True(t, IsAllowed(nil, k8sutil.EventNotificationMemberFailed))
+	require.True(t, IsAllowed(map[string]string{deployment.ArangoDeploymentNotificationsAnnotation: "MemberFailed, UpgradeStarted"}, k8sutil.EventNotificationMemberFailed))
+	require.False(t, IsAllowed(map[string]string{deployment.ArangoDeploymentNotificationsAnnotation: "UpgradeStarted"}, k8sutil.EventNotificationMemberFailed))
+	require.False(t, IsAllowed(map[string]string{deployment.ArangoDeploymentNotificationsAnnotation: "none"}, k8sutil.EventNotificationMemberFailed))
+}
+
+func Test_Config_Validate(t *testing.T) {
+	require.NoError(t, Config{URL: "http://localhost", Format: FormatSlack}.Validate())
+	require.Error(t, Config{Format: FormatSlack}.Validate())
+	require.Error(t, Config{URL: "http://localhost", Format: "xml"}.Validate())
+	require.Error(t, Config{URL: "http://localhost", Format: FormatGeneric, Types: []k8sutil.EventNotification{"Unknown"}}.Validate())
+}
+
+func Test_Format_Encode(t *testing.T) {
+	evt := k8sutil.NewMemberFailedEvent(newDeployment(nil), "PRMR-1", "dbserver")
+	n, ok := newNotification(evt)
+	require.True(t, ok)
+	require.Equal(t, "ArangoDeployment", n.Kind)
+	require.Equal(t, "ns/test", n.Subject())
+
+	t.Run("Generic", func(t *testing.T) {
+		contentType, data, err := FormatGeneric.Encode(n)
+		require.NoError(t, err)
+		require.Equal(t, contentTypeJSON, contentType)
+
+		var out Notification
+		require.NoError(t, json.Unmarshal(data, &out))
+		require.Equal(t, k8sutil.EventNotificationMemberFailed, out.Type)
+		require.Equal(t, "test", out.Name)
+	})
+
+	t.Run("Slack", func(t *testing.T) {
+		_, data, err := FormatSlack.Encode(n)
+		require.NoError(t, err)
+
+		var out slackMessage
+		require.NoError(t, json.Unmarshal(data, &out))
+		require.Contains(t, out.Text, ":warning:")
+		require.Contains(t, out.Text, "ns/test")
+	})
+
+	t.Run("CloudEvents", func(t *testing.T) {
+		contentType, data, err := FormatCloudEvents.Encode(n)
+		require.NoError(t, err)
+		require.Equal(t, contentTypeCloudEvents, contentType)
+
+		var out cloudEvent
+		require.NoError(t, json.Unmarshal(data, &out))
+		require.Equal(t, "1.0", out.SpecVersion)
+		require.Equal(t, "com.arangodb.kube.MemberFailed", out.Type)
+		require.Equal(t, "ns/test", out.Subject)
+		require.NotEmpty(t, out.ID)
+	})
+}
+
+func Test_Notify(t *testing.T) {
+	w := &webhook{failures: 1}
+	server := httptest.NewServer(w)
+	defer server.Close()
+
+	n, err := NewNotifier(Config{
+		URL:          server.URL,
+		Format:       FormatGeneric,
+		Types:        []k8sutil.EventNotification{k8sutil.EventNotificationMemberFailed, k8sutil.EventNotificationUpgradeStarted},
+		RetryTimeout: time.Second * 10,
+	}, nil)
+	require.NoError(t, err)
+	SetNotifier(n)
+
+	// Not a notification
+	Notify(k8sutil.NewPodsSchedulingResolvedEvent(newDeployment(nil)))
+	// Type not enabled
+	Notify(k8sutil.NewUpgradeFinishedEvent(newDeployment(nil), "a", "b"))
+	// Filtered out by the deployment
+	Notify(k8sutil.NewUpgradeStartedEvent(newDeployment(map[string]string{deployment.ArangoDeploymentNotificationsAnnotation: "MemberFailed"}), "a", "b"))
+	// Sent after retry
+	Notify(k8sutil.NewMemberFailedEvent(newDeployment(nil), "PRMR-1", "dbserver"))
+
+	SetNotifier(nil)
+
+	require.Equal(t, 2, w.count())
+	for _, body := range w.bodies {
+		var out Notification
+		require.NoError(t, json.Unmarshal(body, &out))
+		require.Equal(t, k8sutil.EventNotificationMemberFailed, out.Type)
+	}
+}
+
+func Test_Notify_ClientError(t *testing.T) {
+	w := &webhook{status: http.StatusBadRequest}
+	server := httptest.NewServer(w)
+	defer server.Close()
+
+	n, err := NewNotifier(Config{
+		URL:          server.URL,
+		Format:       FormatSlack,
+		RetryTimeout: time.Second * 10,
+	}, nil)
+	require.NoError(t, err)
+	SetNotifier(n)
+
+	Notify(k8sutil.NewBackupFailedEvent(newDeployment(nil), "Failed", "error"))
+
+	SetNotifier(nil)
+
+	require.Equal(t, 1, w.count())
+}
+
+func Test_Notify_Parallel(t *testing.T) {
+	release := make(chan struct{})
+	received := make(chan string, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+
+		var out Notification
+		require.NoError(t, json.Unmarshal(body, &out))
+
+		if out.Type == k8sutil.EventNotificationMemberFailed {
+			// Slow endpoint for the first notification
+			<-release
+		}
+
+		received <- string(out.Type)
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	n, err := NewNotifier(Config{
+		URL:    server.URL,
+		Format: FormatGeneric,
+	}, nil)
+	require.NoError(t, err)
+
+	for _, evt := range []*k8sutil.Event{
+		k8sutil.NewMemberFailedEvent(newDeployment(nil), "PRMR-1", "dbserver"),
+		k8sutil.NewBackupFailedEvent(newDeployment(nil), "Failed", "error"),
+	} {
+		notification, ok := newNotification(evt)
+		require.True(t, ok)
+		n.enqueue(notification)
+	}
+
+	// Second notification is delivered while the first one is still pending
+	select {
+	case r := <-received:
+		require.Equal(t, string(k8sutil.EventNotificationBackupFailed), r)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "Notification blocked by the pending one")
+	}
+
+	close(release)
+	n.Close()
+
+	require.Equal(t, string(k8sutil.EventNotificationMemberFailed), <-received)
+}
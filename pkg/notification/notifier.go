@@ -0,0 +1,219 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package notification
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+	"github.com/arangodb/kube-arangodb/pkg/util/retry"
+)
+
+const (
+	// DefaultRetryTimeout is the time after which delivery of the notification is given up
+	DefaultRetryTimeout = time.Minute * 5
+
+	requestTimeout = time.Second * 10
+	queueSize      = 1024
+
+	// workers is the number of notifications delivered at the same time,
+	// so a notification which is retried does not hold back the other ones
+	workers = 4
+	// maxAttempts limits the number of deliveries of a single notification
+	maxAttempts = 5
+)
+
+// Config of the notifier
+type Config struct {
+	// URL of the webhook
+	URL string
+	// Format of the payload
+	Format Format
+	// Types of the notifications which are sent. All types are sent if empty
+	Types []k8sutil.EventNotification
+	// RetryTimeout is the time after which delivery of the notification is given up.
+	// Delivery is also given up after a fixed number of attempts.
+	RetryTimeout time.Duration
+}
+
+// Validate the notifier configuration
+func (c Config) Validate() error {
+	if c.URL == "" {
+		return errors.Newf("webhook URL is required")
+	}
+
+	if err := c.Format.Validate(); err != nil {
+		return err
+	}
+
+	for _, t := range c.Types {
+		if !isKnownType(t) {
+			return errors.Newf("unknown notification type %s", t)
+		}
+	}
+
+	if c.RetryTimeout < 0 {
+		return errors.Newf("retry timeout cannot be negative")
+	}
+
+	return nil
+}
+
+// isKnownType returns true if the type is one of Types
+func isKnownType(t k8sutil.EventNotification) bool {
+	for _, k := range Types() {
+		if k == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Notifier sends notifications to the webhook in the background
+type Notifier struct {
+	config Config
+	client *http.Client
+	types  map[k8sutil.EventNotification]bool
+
+	queue chan Notification
+	done  chan struct{}
+}
+
+// NewNotifier creates the notifier and starts the delivery of the queued notifications
+func NewNotifier(config Config, client *http.Client) (*Notifier, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	if config.RetryTimeout == 0 {
+		config.RetryTimeout = DefaultRetryTimeout
+	}
+
+	if client == nil {
+		client = &http.Client{Timeout: requestTimeout}
+	}
+
+	n := &Notifier{
+		config: config,
+		client: client,
+		queue:  make(chan Notification, queueSize),
+		done:   make(chan struct{}),
+	}
+
+	if len(config.Types) > 0 {
+		n.types = map[k8sutil.EventNotification]bool{}
+		for _, t := range config.Types {
+			n.types[t] = true
+		}
+	}
+
+	go n.run()
+
+	return n, nil
+}
+
+// Close stops the notifier after the queued notifications are delivered
+func (n *Notifier) Close() {
+	close(n.queue)
+	<-n.done
+}
+
+// accepts returns true if the notification type is enabled
+func (n *Notifier) accepts(t k8sutil.EventNotification) bool {
+	return n.types == nil || n.types[t]
+}
+
+// enqueue adds the notification to the queue. Notification is dropped if the queue is full.
+func (n *Notifier) enqueue(notification Notification) {
+	select {
+	case n.queue <- notification:
+	default:
+		notificationsDropped.Inc()
+		log.Warn().Str("type", string(notification.Type)).Msg("Notification queue is full, dropping notification")
+	}
+}
+
+// run delivers the queued notifications with a pool of workers. Notifications are not guaranteed
+// to be delivered in the order they were queued.
+func (n *Notifier) run() {
+	defer close(n.done)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for notification := range n.queue {
+				n.deliver(notification)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// deliver sends the notification with a bounded number of attempts
+func (n *Notifier) deliver(notification Notification) {
+	if err := retry.RetryAttempts(func() error {
+		return n.send(notification)
+	}, n.config.RetryTimeout, maxAttempts); err != nil {
+		notificationsFailed.Inc()
+		log.Warn().Err(err).Str("type", string(notification.Type)).Str("subject", notification.Subject()).Msg("Unable to send notification")
+		return
+	}
+
+	notificationsSent.Inc()
+}
+
+// send delivers the notification to the webhook. Client errors are not retried.
+func (n *Notifier) send(notification Notification) error {
+	contentType, data, err := n.config.Format.Encode(notification)
+	if err != nil {
+		return retry.Permanent(err)
+	}
+
+	resp, err := n.client.Post(n.config.URL, contentType, bytes.NewReader(data))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	_, _ = ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	err = errors.Newf("unexpected webhook response code %d", resp.StatusCode)
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+		return retry.Permanent(err)
+	}
+
+	return err
+}
@@ -24,15 +24,18 @@ package operator
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"time"
 
 	"github.com/arangodb/kube-arangodb/pkg/operator/scope"
+	"github.com/arangodb/kube-arangodb/pkg/operator/shard"
 
 	monitoringClient "github.com/coreos/prometheus-operator/pkg/client/versioned/typed/monitoring/v1"
 
 	"github.com/arangodb/kube-arangodb/pkg/backup/operator/event"
 	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -84,6 +87,7 @@ type Operator struct {
 
 type Config struct {
 	ID                          string
+	Version                     string
 	Namespace                   string
 	PodName                     string
 	ServiceAccount              string
@@ -98,6 +102,9 @@ type Config struct {
 	AllowChaos                  bool
 	SingleMode                  bool
 	Scope                       scope.Scope
+	Watch                       scope.Watch
+	LeaderElection              LeaderElectionConfig
+	Shard                       shard.Shard
 }
 
 type Dependencies struct {
@@ -131,7 +138,7 @@ func NewOperator(config Config, deps Dependencies) (*Operator, error) {
 func (o *Operator) Run() {
 	if o.Config.EnableDeployment {
 		if !o.Config.SingleMode {
-			go o.runLeaderElection("arango-deployment-operator", constants.LabelRole, o.onStartDeployment, o.Dependencies.DeploymentProbe)
+			go o.runLeaderElection(o.Config.Shard.LockName("arango-deployment-operator"), constants.LabelRole, o.onStartDeployment, o.Dependencies.DeploymentProbe)
 		} else {
 			go o.runWithoutLeaderElection("arango-deployment-operator", constants.LabelRole, o.onStartDeployment, o.Dependencies.DeploymentProbe)
 		}
@@ -214,13 +221,23 @@ func (o *Operator) onStartBackup(stop <-chan struct{}) {
 			time.Sleep(initRetryWaitTime)
 		}
 	}
-	operatorName := "arangodb-backup-operator"
-	operator := backupOper.NewOperator(operatorName, o.Namespace)
-
 	rand.Seed(time.Now().Unix())
 
 	zerolog.SetGlobalLevel(zerolog.DebugLevel)
 
+	o.Dependencies.BackupProbe.SetReady()
+	o.runInNamespaces(stop, o.runBackupsInNamespace, func(string) {})
+}
+
+// runBackupsInNamespace runs the backup controllers for the given namespace until the given channel is closed.
+func (o *Operator) runBackupsInNamespace(namespace string, stop <-chan struct{}) {
+	operatorName := "arangodb-backup-operator"
+	if o.Config.Scope.IsMultiNamespace() {
+		// Every namespace has its own controllers, which must be distinguishable in metrics
+		operatorName = fmt.Sprintf("%s-%s", operatorName, namespace)
+	}
+	operator := backupOper.NewOperator(operatorName, namespace)
+
 	restClient, err := rest.InClusterConfig()
 	if err != nil {
 		panic(err)
@@ -238,9 +255,9 @@ func (o *Operator) onStartBackup(stop <-chan struct{}) {
 
 	eventRecorder := event.NewEventRecorder(operatorName, kubeClientSet)
 
-	arangoInformer := arangoInformer.NewSharedInformerFactoryWithOptions(arangoClientSet, 10*time.Second, arangoInformer.WithNamespace(o.Namespace))
+	arangoInformer := arangoInformer.NewSharedInformerFactoryWithOptions(arangoClientSet, 10*time.Second, arangoInformer.WithNamespace(namespace), arangoInformer.WithTweakListOptions(o.Config.Watch.TweakListOptions))
 
-	if err = backup.RegisterInformer(operator, eventRecorder, arangoClientSet, kubeClientSet, arangoInformer); err != nil {
+	if err = backup.RegisterInformer(operator, eventRecorder, arangoClientSet, kubeClientSet, k8sutil.NewPodExecutor(restClient, kubeClientSet), arangoInformer); err != nil {
 		panic(err)
 	}
 
@@ -253,9 +270,12 @@ func (o *Operator) onStartBackup(stop <-chan struct{}) {
 	}
 
 	prometheus.MustRegister(operator)
+	defer prometheus.Unregister(operator)
 
-	operator.Start(8, stop)
-	o.Dependencies.BackupProbe.SetReady()
+	o.log.Debug().Str("namespace", namespace).Msg("Watching ArangoBackups and ArangoBackupPolicies")
+	if err := operator.Start(8, stop); err != nil {
+		o.log.Error().Err(err).Str("namespace", namespace).Msg("Failed to start backup controllers")
+	}
 
 	<-stop
 }
@@ -46,19 +46,26 @@ var (
 // run the deployments part of the operator.
 // This registers a listener and waits until the process stops.
 func (o *Operator) runDeployments(stop <-chan struct{}) {
-	rw := k8sutil.NewResourceWatcher(
+	o.Dependencies.DeploymentProbe.SetReady()
+	o.runInNamespaces(stop, o.runDeploymentsInNamespace, o.releaseArangoDeployments)
+}
+
+// runDeploymentsInNamespace watches deployments in the given namespace until the given channel is closed.
+func (o *Operator) runDeploymentsInNamespace(namespace string, stop <-chan struct{}) {
+	o.log.Debug().Str("namespace", namespace).Msg("Watching ArangoDeployments")
+	rw := k8sutil.NewFilteredResourceWatcher(
 		o.log,
 		o.Dependencies.CRCli.DatabaseV1().RESTClient(),
 		deploymentType.ArangoDeploymentResourcePlural,
-		o.Config.Namespace,
+		namespace,
 		&api.ArangoDeployment{},
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    o.onAddArangoDeployment,
 			UpdateFunc: o.onUpdateArangoDeployment,
 			DeleteFunc: o.onDeleteArangoDeployment,
-		})
+		},
+		o.Config.Watch.TweakListOptions)
 
-	o.Dependencies.DeploymentProbe.SetReady()
 	rw.Run(stop)
 }
 
@@ -71,6 +78,10 @@ func (o *Operator) onAddArangoDeployment(obj interface{}) {
 	o.log.Debug().
 		Str("name", apiObject.GetObjectMeta().GetName()).
 		Msg("ArangoDeployment added")
+	if !o.Config.Shard.Owns(apiObject) {
+		o.releaseArangoDeployment(apiObject)
+		return
+	}
 	o.syncArangoDeployment(apiObject)
 }
 
@@ -83,6 +94,10 @@ func (o *Operator) onUpdateArangoDeployment(oldObj, newObj interface{}) {
 	o.log.Debug().
 		Str("name", apiObject.GetObjectMeta().GetName()).
 		Msg("ArangoDeployment updated")
+	if !o.Config.Shard.Owns(apiObject) {
+		o.releaseArangoDeployment(apiObject)
+		return
+	}
 	o.syncArangoDeployment(apiObject)
 }
 
@@ -108,6 +123,9 @@ func (o *Operator) onDeleteArangoDeployment(obj interface{}) {
 	log.Debug().
 		Str("name", apiObject.GetObjectMeta().GetName()).
 		Msg("ArangoDeployment deleted")
+	if _, ok := o.deployments[resourceKey(apiObject)]; !ok && !o.Config.Shard.Owns(apiObject) {
+		return
+	}
 	ev := &Event{
 		Type:       kwatch.Deleted,
 		Deployment: apiObject,
@@ -130,7 +148,7 @@ func (o *Operator) syncArangoDeployment(apiObject *api.ArangoDeployment) {
 	// re-watch or restart could give ADD event.
 	// If for an ADD event the cluster spec is invalid then it is not added to the local cache
 	// so modifying that deployment will result in another ADD event
-	if _, ok := o.deployments[apiObject.Name]; ok {
+	if _, ok := o.deployments[resourceKey(apiObject)]; ok {
 		ev.Type = kwatch.Modified
 	}
 
@@ -142,6 +160,37 @@ func (o *Operator) syncArangoDeployment(apiObject *api.ArangoDeployment) {
 	//pt.stop()
 }
 
+// releaseArangoDeployment stops managing the given deployment when it is not owned by this operator shard.
+// Resources of the deployment are left untouched, so the operator which owns the shard can take it over.
+func (o *Operator) releaseArangoDeployment(apiObject *api.ArangoDeployment) {
+	depl, ok := o.deployments[resourceKey(apiObject)]
+	if !ok {
+		return
+	}
+	o.log.Info().
+		Str("name", apiObject.GetName()).
+		Str("shard", o.Config.Shard.String()).
+		Msg("ArangoDeployment moved out of operator shard")
+	depl.Delete()
+	delete(o.deployments, resourceKey(apiObject))
+	deploymentsCurrent.Set(float64(len(o.deployments)))
+}
+
+// releaseArangoDeployments stops managing all deployments in the namespace which is no longer watched.
+func (o *Operator) releaseArangoDeployments(namespace string) {
+	o.Dependencies.LivenessProbe.Lock()
+	defer o.Dependencies.LivenessProbe.Unlock()
+
+	o.log.Info().Str("namespace", namespace).Msg("Namespace is no longer watched")
+	for key, depl := range o.deployments {
+		if inNamespace(key, namespace) {
+			depl.Delete()
+			delete(o.deployments, key)
+		}
+	}
+	deploymentsCurrent.Set(float64(len(o.deployments)))
+}
+
 // handleDeploymentEvent processed the given event.
 func (o *Operator) handleDeploymentEvent(event *Event) error {
 	apiObject := event.Deployment
@@ -149,7 +198,7 @@ func (o *Operator) handleDeploymentEvent(event *Event) error {
 	if apiObject.Status.Phase.IsFailed() {
 		deploymentsFailed.Inc()
 		if event.Type == kwatch.Deleted {
-			delete(o.deployments, apiObject.Name)
+			delete(o.deployments, resourceKey(apiObject))
 			return nil
 		}
 		return errors.WithStack(errors.Newf("ignore failed deployment (%s). Please delete its CR", apiObject.Name))
@@ -157,10 +206,15 @@ func (o *Operator) handleDeploymentEvent(event *Event) error {
 
 	switch event.Type {
 	case kwatch.Added:
-		if _, ok := o.deployments[apiObject.Name]; ok {
+		if _, ok := o.deployments[resourceKey(apiObject)]; ok {
 			return errors.WithStack(errors.Newf("unsafe state. deployment (%s) was created before but we received event (%s)", apiObject.Name, event.Type))
 		}
 
+		// Take shape of the source deployment when cloning from a backup
+		if err := deployment.ApplyCloneDefaults(o.Dependencies.CRCli, apiObject); err != nil {
+			return errors.WithStack(err)
+		}
+
 		// Fill in defaults
 		apiObject.Spec.SetDefaults(apiObject.GetName())
 		// Validate deployment spec
@@ -173,13 +227,13 @@ func (o *Operator) handleDeploymentEvent(event *Event) error {
 		if err != nil {
 			return errors.WithStack(errors.Newf("failed to create deployment: %s", err))
 		}
-		o.deployments[apiObject.Name] = nc
+		o.deployments[resourceKey(apiObject)] = nc
 
 		deploymentsCreated.Inc()
 		deploymentsCurrent.Set(float64(len(o.deployments)))
 
 	case kwatch.Modified:
-		depl, ok := o.deployments[apiObject.Name]
+		depl, ok := o.deployments[resourceKey(apiObject)]
 		if !ok {
 			return errors.WithStack(errors.Newf("unsafe state. deployment (%s) was never created but we received event (%s)", apiObject.Name, event.Type))
 		}
@@ -187,12 +241,12 @@ func (o *Operator) handleDeploymentEvent(event *Event) error {
 		deploymentsModified.Inc()
 
 	case kwatch.Deleted:
-		depl, ok := o.deployments[apiObject.Name]
+		depl, ok := o.deployments[resourceKey(apiObject)]
 		if !ok {
 			return errors.WithStack(errors.Newf("unsafe state. deployment (%s) was never created but we received event (%s)", apiObject.Name, event.Type))
 		}
 		depl.Delete()
-		delete(o.deployments, apiObject.Name)
+		delete(o.deployments, resourceKey(apiObject))
 		deploymentsDeleted.Inc()
 		deploymentsCurrent.Set(float64(len(o.deployments)))
 	}
@@ -208,6 +262,7 @@ func (o *Operator) makeDeploymentConfigAndDeps(apiObject *api.ArangoDeployment)
 		MetricsExporterImage:  o.MetricsExporterImage,
 		ArangoImage:           o.ArangoImage,
 		AllowChaos:            o.Config.AllowChaos,
+		OperatorVersion:       o.Config.Version,
 		Scope:                 o.Scope,
 	}
 	deps := deployment.Dependencies{
@@ -46,22 +46,43 @@ var (
 // run the deployment replications part of the operator.
 // This registers a listener and waits until the process stops.
 func (o *Operator) runDeploymentReplications(stop <-chan struct{}) {
-	rw := k8sutil.NewResourceWatcher(
+	o.Dependencies.DeploymentReplicationProbe.SetReady()
+	o.runInNamespaces(stop, o.runDeploymentReplicationsInNamespace, o.releaseArangoDeploymentReplications)
+}
+
+// runDeploymentReplicationsInNamespace watches deployment replications in the given namespace until the given channel is closed.
+func (o *Operator) runDeploymentReplicationsInNamespace(namespace string, stop <-chan struct{}) {
+	o.log.Debug().Str("namespace", namespace).Msg("Watching ArangoDeploymentReplications")
+	rw := k8sutil.NewFilteredResourceWatcher(
 		o.log,
 		o.Dependencies.CRCli.ReplicationV1().RESTClient(),
 		replication2.ArangoDeploymentReplicationResourcePlural,
-		o.Config.Namespace,
+		namespace,
 		&api.ArangoDeploymentReplication{},
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    o.onAddArangoDeploymentReplication,
 			UpdateFunc: o.onUpdateArangoDeploymentReplication,
 			DeleteFunc: o.onDeleteArangoDeploymentReplication,
-		})
+		},
+		o.Config.Watch.TweakListOptions)
 
-	o.Dependencies.DeploymentReplicationProbe.SetReady()
 	rw.Run(stop)
 }
 
+// releaseArangoDeploymentReplications stops managing all deployment replications in the namespace which is no longer watched.
+func (o *Operator) releaseArangoDeploymentReplications(namespace string) {
+	o.Dependencies.LivenessProbe.Lock()
+	defer o.Dependencies.LivenessProbe.Unlock()
+
+	for key, repl := range o.deploymentReplications {
+		if inNamespace(key, namespace) {
+			repl.Delete()
+			delete(o.deploymentReplications, key)
+		}
+	}
+	deploymentReplicationsCurrent.Set(float64(len(o.deploymentReplications)))
+}
+
 // onAddArangoDeploymentReplication deployment replication addition callback
 func (o *Operator) onAddArangoDeploymentReplication(obj interface{}) {
 	o.Dependencies.LivenessProbe.Lock()
@@ -130,7 +151,7 @@ func (o *Operator) syncArangoDeploymentReplication(apiObject *api.ArangoDeployme
 	// re-watch or restart could give ADD event.
 	// If for an ADD event the cluster spec is invalid then it is not added to the local cache
 	// so modifying that deployment will result in another ADD event
-	if _, ok := o.deploymentReplications[apiObject.Name]; ok {
+	if _, ok := o.deploymentReplications[resourceKey(apiObject)]; ok {
 		ev.Type = kwatch.Modified
 	}
 
@@ -149,7 +170,7 @@ func (o *Operator) handleDeploymentReplicationEvent(event *Event) error {
 	if apiObject.Status.Phase.IsFailed() {
 		deploymentReplicationsFailed.Inc()
 		if event.Type == kwatch.Deleted {
-			delete(o.deploymentReplications, apiObject.Name)
+			delete(o.deploymentReplications, resourceKey(apiObject))
 			return nil
 		}
 		return errors.WithStack(errors.Newf("ignore failed deployment replication (%s). Please delete its CR", apiObject.Name))
@@ -157,7 +178,7 @@ func (o *Operator) handleDeploymentReplicationEvent(event *Event) error {
 
 	switch event.Type {
 	case kwatch.Added:
-		if _, ok := o.deploymentReplications[apiObject.Name]; ok {
+		if _, ok := o.deploymentReplications[resourceKey(apiObject)]; ok {
 			return errors.WithStack(errors.Newf("unsafe state. deployment replication (%s) was created before but we received event (%s)", apiObject.Name, event.Type))
 		}
 
@@ -173,13 +194,13 @@ func (o *Operator) handleDeploymentReplicationEvent(event *Event) error {
 		if err != nil {
 			return errors.WithStack(errors.Newf("failed to create deployment: %s", err))
 		}
-		o.deploymentReplications[apiObject.Name] = nc
+		o.deploymentReplications[resourceKey(apiObject)] = nc
 
 		deploymentReplicationsCreated.Inc()
 		deploymentReplicationsCurrent.Set(float64(len(o.deploymentReplications)))
 
 	case kwatch.Modified:
-		repl, ok := o.deploymentReplications[apiObject.Name]
+		repl, ok := o.deploymentReplications[resourceKey(apiObject)]
 		if !ok {
 			return errors.WithStack(errors.Newf("unsafe state. deployment replication (%s) was never created but we received event (%s)", apiObject.Name, event.Type))
 		}
@@ -187,12 +208,12 @@ func (o *Operator) handleDeploymentReplicationEvent(event *Event) error {
 		deploymentReplicationsModified.Inc()
 
 	case kwatch.Deleted:
-		repl, ok := o.deploymentReplications[apiObject.Name]
+		repl, ok := o.deploymentReplications[resourceKey(apiObject)]
 		if !ok {
 			return errors.WithStack(errors.Newf("unsafe state. deployment replication (%s) was never created but we received event (%s)", apiObject.Name, event.Type))
 		}
 		repl.Delete()
-		delete(o.deploymentReplications, apiObject.Name)
+		delete(o.deploymentReplications, resourceKey(apiObject))
 		deploymentReplicationsDeleted.Inc()
 		deploymentReplicationsCurrent.Set(float64(len(o.deploymentReplications)))
 	}
@@ -43,6 +43,71 @@ import (
 	"github.com/arangodb/kube-arangodb/pkg/util/retry"
 )
 
+const (
+	DefaultLeaseDuration = 15 * time.Second
+	DefaultRenewDeadline = 10 * time.Second
+	DefaultRetryPeriod   = 2 * time.Second
+)
+
+// LeaderElectionConfig keeps lock type and timings of the leader election.
+type LeaderElectionConfig struct {
+	// LockType is the type of the lock resource, EndpointsResourceLock or LeasesResourceLock.
+	// Replicas using different lock types do not see each other, so the type can only be changed
+	// when no replica with the old type is running (e.g. with the Operator scaled to zero).
+	LockType      string
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+}
+
+// GetLockType returns lock type or LeasesResourceLock if not set.
+func (l LeaderElectionConfig) GetLockType() string {
+	if l.LockType == "" {
+		return resourcelock.LeasesResourceLock
+	}
+	return l.LockType
+}
+
+// GetLeaseDuration returns lease duration or default if not set.
+func (l LeaderElectionConfig) GetLeaseDuration() time.Duration {
+	if l.LeaseDuration <= 0 {
+		return DefaultLeaseDuration
+	}
+	return l.LeaseDuration
+}
+
+// GetRenewDeadline returns renew deadline or default if not set.
+func (l LeaderElectionConfig) GetRenewDeadline() time.Duration {
+	if l.RenewDeadline <= 0 {
+		return DefaultRenewDeadline
+	}
+	return l.RenewDeadline
+}
+
+// GetRetryPeriod returns retry period or default if not set.
+func (l LeaderElectionConfig) GetRetryPeriod() time.Duration {
+	if l.RetryPeriod <= 0 {
+		return DefaultRetryPeriod
+	}
+	return l.RetryPeriod
+}
+
+// Validate checks that lock type and timings are accepted by the leader election.
+func (l LeaderElectionConfig) Validate() error {
+	switch t := l.GetLockType(); t {
+	case resourcelock.EndpointsResourceLock, resourcelock.LeasesResourceLock:
+	default:
+		return errors.Newf("lock type must be %s or %s, got %s", resourcelock.EndpointsResourceLock, resourcelock.LeasesResourceLock, t)
+	}
+	if l.GetLeaseDuration() <= l.GetRenewDeadline() {
+		return errors.Newf("lease duration (%s) must be greater than renew deadline (%s)", l.GetLeaseDuration(), l.GetRenewDeadline())
+	}
+	if l.GetRenewDeadline() <= l.GetRetryPeriod() {
+		return errors.Newf("renew deadline (%s) must be greater than retry period (%s)", l.GetRenewDeadline(), l.GetRetryPeriod())
+	}
+	return nil
+}
+
 // runLeaderElection performs a leader election on a lock with given name in
 // the namespace that the operator is deployed in.
 // When the leader election is won, the given callback is called.
@@ -59,7 +124,7 @@ func (o *Operator) runLeaderElection(lockName, label string, onStart func(stop <
 			o.Dependencies.EventRecorder.Event(eventTarget, v1.EventTypeNormal, reason, message)
 		}
 	}
-	rl, err := resourcelock.New(resourcelock.EndpointsResourceLock,
+	rl, err := resourcelock.New(o.Config.LeaderElection.GetLockType(),
 		namespace,
 		lockName,
 		kubecli.CoreV1(),
@@ -75,9 +140,9 @@ func (o *Operator) runLeaderElection(lockName, label string, onStart func(stop <
 	ctx := context.Background()
 	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
 		Lock:          rl,
-		LeaseDuration: 15 * time.Second,
-		RenewDeadline: 10 * time.Second,
-		RetryPeriod:   2 * time.Second,
+		LeaseDuration: o.Config.LeaderElection.GetLeaseDuration(),
+		RenewDeadline: o.Config.LeaderElection.GetRenewDeadline(),
+		RetryPeriod:   o.Config.LeaderElection.GetRetryPeriod(),
 		Callbacks: leaderelection.LeaderCallbacks{
 			OnStartedLeading: func(ctx context.Context) {
 				recordEvent("Leader Election Won", fmt.Sprintf("Pod %s is running as leader", o.Config.PodName))
@@ -44,7 +44,7 @@ var (
 // run the local storages part of the operator.
 // This registers a listener and waits until the process stops.
 func (o *Operator) runLocalStorages(stop <-chan struct{}) {
-	rw := k8sutil.NewResourceWatcher(
+	rw := k8sutil.NewFilteredResourceWatcher(
 		o.log,
 		o.Dependencies.CRCli.StorageV1alpha().RESTClient(),
 		api.ArangoLocalStorageResourcePlural,
@@ -54,7 +54,8 @@ func (o *Operator) runLocalStorages(stop <-chan struct{}) {
 			AddFunc:    o.onAddArangoLocalStorage,
 			UpdateFunc: o.onUpdateArangoLocalStorage,
 			DeleteFunc: o.onDeleteArangoLocalStorage,
-		})
+		},
+		o.Config.Watch.TweakListOptions)
 
 	o.Dependencies.StorageProbe.SetReady()
 	rw.Run(stop)
@@ -0,0 +1,133 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package operator
+
+import (
+	"strings"
+	"sync"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// resourceKey returns the key under which a namespaced resource is kept by the operator.
+func resourceKey(obj meta.Object) string {
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// inNamespace returns true if the resource key belongs to the given namespace.
+func inNamespace(key, namespace string) bool {
+	return strings.HasPrefix(key, namespace+"/")
+}
+
+// runInNamespaces calls start for every namespace watched by the operator and waits until the given channel is closed.
+// When namespaces are selected by labels, start is called when a namespace starts to match the selector.
+// Once it stops to match (or is removed), its channel is closed and release is called.
+func (o *Operator) runInNamespaces(stop <-chan struct{}, start func(namespace string, stop <-chan struct{}), release func(namespace string)) {
+	if !o.Config.Scope.IsMultiNamespace() || !o.Config.Watch.IsDynamic() {
+		for _, namespace := range o.Config.Watch.GetNamespaces(o.Config.Namespace) {
+			go start(namespace, stop)
+		}
+		<-stop
+		return
+	}
+
+	nw := &namespaceWatcher{
+		stops:   map[string]chan struct{}{},
+		start:   start,
+		release: release,
+	}
+
+	onNamespace := func(obj interface{}) {
+		if ns, ok := obj.(*core.Namespace); ok {
+			nw.set(ns.GetName(), o.Config.Watch.MatchesNamespace(ns))
+		}
+	}
+
+	rw := k8sutil.NewResourceWatcher(
+		o.log,
+		o.Dependencies.KubeCli.CoreV1().RESTClient(),
+		"namespaces",
+		meta.NamespaceAll,
+		&core.Namespace{},
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: onNamespace,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				onNamespace(newObj)
+			},
+			DeleteFunc: func(obj interface{}) {
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				if ns, ok := obj.(*core.Namespace); ok {
+					nw.set(ns.GetName(), false)
+				}
+			},
+		})
+
+	rw.Run(stop)
+	nw.stopAll()
+}
+
+// namespaceWatcher keeps track of the namespaces which are currently watched.
+type namespaceWatcher struct {
+	lock sync.Mutex
+
+	stops   map[string]chan struct{}
+	start   func(namespace string, stop <-chan struct{})
+	release func(namespace string)
+}
+
+// set starts or stops watching of the given namespace.
+func (n *namespaceWatcher) set(namespace string, enabled bool) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	stop, running := n.stops[namespace]
+	if enabled == running {
+		return
+	}
+
+	if enabled {
+		stop = make(chan struct{})
+		n.stops[namespace] = stop
+		go n.start(namespace, stop)
+		return
+	}
+
+	close(stop)
+	delete(n.stops, namespace)
+	n.release(namespace)
+}
+
+// stopAll stops watching of all namespaces.
+func (n *namespaceWatcher) stopAll() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	for namespace, stop := range n.stops {
+		close(stop)
+		delete(n.stops, namespace)
+	}
+}
@@ -28,6 +28,8 @@ func AsScope(s string) (Scope, bool) {
 		return LegacyScope, true
 	case NamespacedScope.String():
 		return NamespacedScope, true
+	case MultiNamespaceScope.String():
+		return MultiNamespaceScope, true
 	}
 
 	return "", false
@@ -43,9 +45,14 @@ func (s Scope) IsNamespaced() bool {
 	return s == NamespacedScope
 }
 
+func (s Scope) IsMultiNamespace() bool {
+	return s == MultiNamespaceScope
+}
+
 const (
-	LegacyScope     Scope = "legacy"
-	NamespacedScope Scope = "namespaced"
+	LegacyScope         Scope = "legacy"
+	NamespacedScope     Scope = "namespaced"
+	MultiNamespaceScope Scope = "multi-namespace"
 
 	DefaultScope = LegacyScope
 )
@@ -0,0 +1,123 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package scope
+
+import (
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Watch defines namespaces and resources handled by the Operator.
+type Watch struct {
+	// Namespaces is the explicit list of watched namespaces
+	Namespaces []string
+	// NamespaceSelector selects watched namespaces by their labels
+	NamespaceSelector labels.Selector
+	// ResourceSelector selects watched custom resources by their labels
+	ResourceSelector labels.Selector
+}
+
+// NewWatch creates watch definition for the given scope.
+// Namespaces and namespace selector are allowed only in multi-namespace scope, where one of them is required.
+func NewWatch(s Scope, namespaces []string, namespaceSelector, resourceSelector string) (Watch, error) {
+	var w Watch
+
+	if s.IsMultiNamespace() {
+		if len(namespaces) == 0 && namespaceSelector == "" {
+			return Watch{}, errors.Newf("namespaces or namespace selector is required in %s scope", s)
+		}
+		if len(namespaces) > 0 && namespaceSelector != "" {
+			return Watch{}, errors.Newf("namespaces and namespace selector are mutually exclusive")
+		}
+	} else if len(namespaces) > 0 || namespaceSelector != "" {
+		return Watch{}, errors.Newf("namespaces and namespace selector are supported only in %s scope", MultiNamespaceScope)
+	}
+
+	seen := map[string]bool{}
+	for _, ns := range namespaces {
+		if ns == "" {
+			return Watch{}, errors.Newf("namespace name cannot be empty")
+		}
+		if seen[ns] {
+			continue
+		}
+		seen[ns] = true
+		w.Namespaces = append(w.Namespaces, ns)
+	}
+
+	if namespaceSelector != "" {
+		sel, err := labels.Parse(namespaceSelector)
+		if err != nil {
+			return Watch{}, errors.Wrapf(err, "invalid namespace selector %s", namespaceSelector)
+		}
+		w.NamespaceSelector = sel
+	}
+
+	if resourceSelector != "" {
+		sel, err := labels.Parse(resourceSelector)
+		if err != nil {
+			return Watch{}, errors.Wrapf(err, "invalid resource selector %s", resourceSelector)
+		}
+		w.ResourceSelector = sel
+	}
+
+	return w, nil
+}
+
+// IsDynamic returns true if watched namespaces are selected by labels.
+func (w Watch) IsDynamic() bool {
+	return w.NamespaceSelector != nil
+}
+
+// GetNamespaces returns the list of statically watched namespaces.
+// Operator namespace is returned if no explicit list is defined.
+func (w Watch) GetNamespaces(operatorNamespace string) []string {
+	if len(w.Namespaces) == 0 {
+		return []string{operatorNamespace}
+	}
+	return w.Namespaces
+}
+
+// MatchesNamespace returns true if namespace with given labels is selected.
+func (w Watch) MatchesNamespace(namespace meta.Object) bool {
+	if w.NamespaceSelector == nil {
+		return false
+	}
+	return w.NamespaceSelector.Matches(labels.Set(namespace.GetLabels()))
+}
+
+// MatchesResource returns true if resource is selected by the resource selector.
+func (w Watch) MatchesResource(obj meta.Object) bool {
+	if w.ResourceSelector == nil {
+		return true
+	}
+	return w.ResourceSelector.Matches(labels.Set(obj.GetLabels()))
+}
+
+// TweakListOptions applies resource selector to the list and watch requests.
+func (w Watch) TweakListOptions(options *meta.ListOptions) {
+	if w.ResourceSelector == nil {
+		return
+	}
+	options.LabelSelector = w.ResourceSelector.String()
+}
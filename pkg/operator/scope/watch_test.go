@@ -0,0 +1,86 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package scope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_NewWatch(t *testing.T) {
+	_, err := NewWatch(LegacyScope, []string{"a"}, "", "")
+	require.Error(t, err)
+
+	_, err = NewWatch(NamespacedScope, nil, "team=a", "")
+	require.Error(t, err)
+
+	_, err = NewWatch(MultiNamespaceScope, nil, "", "")
+	require.Error(t, err)
+
+	_, err = NewWatch(MultiNamespaceScope, []string{"a"}, "team=a", "")
+	require.Error(t, err)
+
+	_, err = NewWatch(MultiNamespaceScope, []string{""}, "", "")
+	require.Error(t, err)
+
+	_, err = NewWatch(LegacyScope, nil, "", "a in (")
+	require.Error(t, err)
+
+	w, err := NewWatch(MultiNamespaceScope, []string{"a", "b", "a"}, "", "")
+	require.NoError(t, err)
+	assert.False(t, w.IsDynamic())
+	assert.Equal(t, []string{"a", "b"}, w.GetNamespaces("operator"))
+
+	w, err = NewWatch(LegacyScope, nil, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"operator"}, w.GetNamespaces("operator"))
+}
+
+func Test_WatchNamespaceSelector(t *testing.T) {
+	w, err := NewWatch(MultiNamespaceScope, nil, "arangodb=enabled", "")
+	require.NoError(t, err)
+	assert.True(t, w.IsDynamic())
+
+	assert.True(t, w.MatchesNamespace(&meta.ObjectMeta{Name: "a", Labels: map[string]string{"arangodb": "enabled"}}))
+	assert.False(t, w.MatchesNamespace(&meta.ObjectMeta{Name: "b"}))
+}
+
+func Test_WatchResourceSelector(t *testing.T) {
+	w, err := NewWatch(LegacyScope, nil, "", "")
+	require.NoError(t, err)
+
+	var opts meta.ListOptions
+	w.TweakListOptions(&opts)
+	assert.Empty(t, opts.LabelSelector)
+	assert.True(t, w.MatchesResource(&meta.ObjectMeta{Name: "a"}))
+
+	w, err = NewWatch(LegacyScope, nil, "", "operator=v2")
+	require.NoError(t, err)
+
+	w.TweakListOptions(&opts)
+	assert.Equal(t, "operator=v2", opts.LabelSelector)
+	assert.True(t, w.MatchesResource(&meta.ObjectMeta{Name: "a", Labels: map[string]string{"operator": "v2"}}))
+	assert.False(t, w.MatchesResource(&meta.ObjectMeta{Name: "b", Labels: map[string]string{"operator": "v1"}}))
+}
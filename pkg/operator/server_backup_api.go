@@ -0,0 +1,142 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package operator
+
+import (
+	"sort"
+	"time"
+
+	backupApi "github.com/arangodb/kube-arangodb/pkg/apis/backup/v1"
+	"github.com/arangodb/kube-arangodb/pkg/server"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupOperator provides access to the backup catalog.
+func (o *Operator) BackupOperator() server.BackupOperator {
+	if !o.Config.EnableBackup {
+		return nil
+	}
+	return o
+}
+
+// GetBackups returns all backups in the namespaces watched by the operator
+func (o *Operator) GetBackups() ([]server.Backup, error) {
+	namespace := meta.NamespaceAll
+	if o.Scope.IsNamespaced() {
+		namespace = o.Namespace
+	}
+
+	var opts meta.ListOptions
+	o.Config.Watch.TweakListOptions(&opts)
+
+	backups, err := o.Dependencies.CRCli.BackupV1().ArangoBackups(namespace).List(opts)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	result := make([]server.Backup, 0, len(backups.Items))
+	for i := range backups.Items {
+		result = append(result, backupCatalogEntry{backup: &backups.Items[i]})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result, nil
+}
+
+// backupCatalogEntry implements server.Backup for an ArangoBackup
+type backupCatalogEntry struct {
+	backup *backupApi.ArangoBackup
+}
+
+func (b backupCatalogEntry) Name() string {
+	return b.backup.GetName()
+}
+
+func (b backupCatalogEntry) Namespace() string {
+	return b.backup.GetNamespace()
+}
+
+func (b backupCatalogEntry) DeploymentName() string {
+	return b.backup.Spec.Deployment.Name
+}
+
+func (b backupCatalogEntry) ID() string {
+	if d := b.backup.Status.Backup; d != nil {
+		return d.ID
+	}
+	return ""
+}
+
+func (b backupCatalogEntry) Type() string {
+	return string(b.backup.Spec.GetType())
+}
+
+func (b backupCatalogEntry) State() string {
+	return string(b.backup.Status.State)
+}
+
+func (b backupCatalogEntry) Available() bool {
+	return b.backup.Status.Available
+}
+
+func (b backupCatalogEntry) Imported() bool {
+	if d := b.backup.Status.Backup; d != nil && d.Imported != nil {
+		return *d.Imported
+	}
+	return false
+}
+
+func (b backupCatalogEntry) Uploaded() bool {
+	if d := b.backup.Status.Backup; d != nil && d.Uploaded != nil {
+		return *d.Uploaded
+	}
+	return false
+}
+
+func (b backupCatalogEntry) RepositoryURL() string {
+	if d := b.backup.Status.Backup; d != nil {
+		return d.RepositoryURL
+	}
+	return ""
+}
+
+func (b backupCatalogEntry) Version() string {
+	if d := b.backup.Status.Backup; d != nil {
+		return d.Version
+	}
+	return ""
+}
+
+func (b backupCatalogEntry) SizeInBytes() uint64 {
+	if d := b.backup.Status.Backup; d != nil {
+		return d.SizeInBytes
+	}
+	return 0
+}
+
+func (b backupCatalogEntry) CreatedAt() time.Time {
+	if d := b.backup.Status.Backup; d != nil && !d.CreationTimestamp.IsZero() {
+		return d.CreationTimestamp.Time
+	}
+	return b.backup.GetCreationTimestamp().Time
+}
@@ -0,0 +1,125 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package shard
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Shard describes the part of the resources which is owned by single operator replica.
+// Resources are split into Count partitions by hash of namespace and name, and optionally
+// filtered by label selector.
+type Shard struct {
+	Index    int
+	Count    int
+	Selector labels.Selector
+}
+
+// New creates shard definition. Empty selector matches all objects.
+func New(index, count int, selector string) (Shard, error) {
+	if count < 1 {
+		return Shard{}, errors.Newf("shard count must be at least 1, got %d", count)
+	}
+
+	if index < 0 || index >= count {
+		return Shard{}, errors.Newf("shard index must be in range [0, %d), got %d", count, index)
+	}
+
+	s := Shard{
+		Index: index,
+		Count: count,
+	}
+
+	if selector != "" {
+		sel, err := labels.Parse(selector)
+		if err != nil {
+			return Shard{}, errors.Wrapf(err, "invalid shard selector %s", selector)
+		}
+
+		s.Selector = sel
+	}
+
+	return s, nil
+}
+
+// IsEnabled returns true when resources are split between operator replicas.
+func (s Shard) IsEnabled() bool {
+	return s.Count > 1 || s.Selector != nil
+}
+
+// Owns returns true if object belongs to this shard.
+func (s Shard) Owns(obj meta.Object) bool {
+	if s.Selector != nil && !s.Selector.Matches(labels.Set(obj.GetLabels())) {
+		return false
+	}
+
+	if s.Count <= 1 {
+		return true
+	}
+
+	return Index(obj.GetNamespace(), obj.GetName(), s.Count) == s.Index
+}
+
+// LockName returns name of the leader election lock which needs to be acquired to work on this shard.
+// Replicas with different selectors work on different resources, so the hash of the selector
+// is part of the name.
+func (s Shard) LockName(name string) string {
+	if s.Selector != nil {
+		h := fnv.New32a()
+		h.Write([]byte(s.Selector.String()))
+		name = fmt.Sprintf("%s-%08x", name, h.Sum32())
+	}
+
+	if s.Count <= 1 {
+		return name
+	}
+
+	return fmt.Sprintf("%s-shard-%d", name, s.Index)
+}
+
+// String returns shard representation used in logs.
+func (s Shard) String() string {
+	if s.Selector == nil {
+		return fmt.Sprintf("%d/%d", s.Index, s.Count)
+	}
+
+	return fmt.Sprintf("%d/%d (%s)", s.Index, s.Count, s.Selector.String())
+}
+
+// Index returns index of the shard which owns object with given namespace and name.
+func Index(namespace, name string, count int) int {
+	if count <= 1 {
+		return 0
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(namespace))
+	h.Write([]byte{0})
+	h.Write([]byte(name))
+
+	return int(h.Sum32() % uint32(count))
+}
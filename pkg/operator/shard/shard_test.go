@@ -0,0 +1,101 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package shard
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_New(t *testing.T) {
+	_, err := New(0, 0, "")
+	require.Error(t, err)
+
+	_, err = New(2, 2, "")
+	require.Error(t, err)
+
+	_, err = New(-1, 2, "")
+	require.Error(t, err)
+
+	_, err = New(0, 1, "a in (")
+	require.Error(t, err)
+
+	s, err := New(1, 3, "team=a")
+	require.NoError(t, err)
+	assert.True(t, s.IsEnabled())
+	assert.Equal(t, "arango-deployment-operator-0703e3e6-shard-1", s.LockName("arango-deployment-operator"))
+
+	s, err = New(1, 3, "")
+	require.NoError(t, err)
+	assert.Equal(t, "arango-deployment-operator-shard-1", s.LockName("arango-deployment-operator"))
+
+	// Replicas with different selectors must not share the lock
+	s, err = New(0, 1, "team=a")
+	require.NoError(t, err)
+	assert.True(t, s.IsEnabled())
+	assert.Equal(t, "arango-deployment-operator-0703e3e6", s.LockName("arango-deployment-operator"))
+
+	s, err = New(0, 1, "team=b")
+	require.NoError(t, err)
+	assert.Equal(t, "arango-deployment-operator-0603e253", s.LockName("arango-deployment-operator"))
+
+	s, err = New(0, 1, "")
+	require.NoError(t, err)
+	assert.False(t, s.IsEnabled())
+	assert.Equal(t, "arango-deployment-operator", s.LockName("arango-deployment-operator"))
+}
+
+func Test_Owns(t *testing.T) {
+	count := 3
+	shards := make([]Shard, count)
+	for i := 0; i < count; i++ {
+		s, err := New(i, count, "")
+		require.NoError(t, err)
+		shards[i] = s
+	}
+
+	for i := 0; i < 100; i++ {
+		obj := &meta.ObjectMeta{Namespace: "default", Name: fmt.Sprintf("deployment-%d", i)}
+
+		owners := 0
+		for _, s := range shards {
+			if s.Owns(obj) {
+				owners++
+			}
+		}
+
+		assert.Equal(t, 1, owners, obj.Name)
+	}
+}
+
+func Test_OwnsSelector(t *testing.T) {
+	s, err := New(0, 1, "team=a")
+	require.NoError(t, err)
+
+	assert.True(t, s.Owns(&meta.ObjectMeta{Name: "a", Labels: map[string]string{"team": "a"}}))
+	assert.False(t, s.Owns(&meta.ObjectMeta{Name: "b", Labels: map[string]string{"team": "b"}}))
+	assert.False(t, s.Owns(&meta.ObjectMeta{Name: "c"}))
+}
@@ -0,0 +1,53 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package replication
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/audit"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+const (
+	auditActionReplication = "Replication"
+	auditActionSwitchover  = "Switchover"
+)
+
+// newAuditRecord returns an audit record of the given action for this deployment replication.
+func (dr *DeploymentReplication) newAuditRecord(phase audit.Phase, action string) audit.Record {
+	r := audit.Record{
+		Time:       time.Now().UTC(),
+		Source:     audit.SourceReplication,
+		Phase:      phase,
+		Action:     action,
+		Namespace:  dr.apiObject.GetNamespace(),
+		Deployment: dr.apiObject.Spec.Destination.GetDeploymentName(),
+		Resource:   dr.apiObject.GetName(),
+	}
+
+	if data, err := json.Marshal(dr.apiObject.Spec); err == nil {
+		r.SpecHash = util.SHA256(data)
+	}
+
+	return r
+}
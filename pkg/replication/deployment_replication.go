@@ -36,7 +36,9 @@ import (
 
 	"github.com/arangodb/arangosync-client/client"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/replication/v1"
+	"github.com/arangodb/kube-arangodb/pkg/audit"
 	"github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned"
+	"github.com/arangodb/kube-arangodb/pkg/notification"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 	"github.com/arangodb/kube-arangodb/pkg/util/retry"
 	"github.com/arangodb/kube-arangodb/pkg/util/trigger"
@@ -88,6 +90,8 @@ type DeploymentReplication struct {
 	inspectTrigger         trigger.Trigger
 	recentInspectionErrors int
 	clientCache            client.ClientCache
+	lagMetricKeys          map[lagMetricKey]struct{}
+	databaseClientFactory  databaseClientFactory
 }
 
 // New creates a new DeploymentReplication from the given API object.
@@ -159,6 +163,7 @@ func (dr *DeploymentReplication) run() {
 		select {
 		case <-dr.stopCh:
 			// We're being stopped.
+			dr.removeLagMetrics()
 			return
 
 		case event := <-dr.eventCh:
@@ -241,6 +246,7 @@ func (dr *DeploymentReplication) handleArangoDeploymentReplicationUpdatedEvent(e
 // On error, the error is logged.
 func (dr *DeploymentReplication) createEvent(evt *k8sutil.Event) {
 	dr.deps.EventRecorder.Event(evt.InvolvedObject, evt.Type, evt.Reason, evt.Message)
+	notification.Notify(evt)
 }
 
 // Update the status of the API object from the internal status
@@ -323,6 +329,13 @@ func (dr *DeploymentReplication) failOnError(err error, msg string) {
 	log := dr.deps.Log
 	log.Error().Err(err).Msg(msg)
 	dr.status.Reason = err.Error()
+	if audit.Enabled() {
+		r := dr.newAuditRecord(audit.PhaseFailed, auditActionReplication)
+		r.Reason = msg
+		r.Error = err.Error()
+		audit.Emit(r)
+	}
+	dr.createEvent(k8sutil.NewReplicationUnhealthyEvent(dr.apiObject, "Failed", err.Error()))
 	dr.reportFailedStatus()
 }
 
@@ -94,7 +94,7 @@ func (dr *DeploymentReplication) inspectFinalizerDeplReplStopSync(ctx context.Co
 	// Inspect phase
 	if p.Status.Phase.IsFailed() {
 		log.Debug().Msg("Deployment replication is already failed, safe to remove stop-sync finalizer")
-		return nil
+		return dr.restorePausedDestination(ctx, p.Spec)
 	}
 
 	// Inspect deployment deletion state in source
@@ -162,6 +162,11 @@ func (dr *DeploymentReplication) inspectFinalizerDeplReplStopSync(ctx context.Co
 			}
 			return errors.WithStack(err)
 		}
+		// A paused synchronization has left the destination read-only
+		if err := dr.restorePausedDestination(ctx, p.Spec); err != nil {
+			log.Warn().Err(err).Msg("Failed to switch the destination back to the default server mode")
+			return errors.WithStack(err)
+		}
 		return nil
 	}
 }
@@ -0,0 +1,108 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package replication
+
+import (
+	"context"
+
+	driver "github.com/arangodb/go-driver"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/replication/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// The syncmaster API has no way to suspend a synchronization, so a pause cancels it.
+// To prevent writes into the destination while it is not synchronized, its coordinators
+// are switched to read-only before the synchronization is cancelled, and switched back
+// to the default server mode before the synchronization is configured again on resume.
+
+// inspectPause switches the destination into read-only mode and then cancels the synchronization.
+// Returns true (as first value) when the synchronization must be cancelled
+// and true (as second value) when the status has been changed.
+func (dr *DeploymentReplication) inspectPause(ctx context.Context, spec api.DeploymentReplicationSpec, syncActive bool) (bool, bool, error) {
+	readOnly, err := dr.ensureServerMode(ctx, spec.Destination, driver.ServerModeReadOnly)
+	if err != nil {
+		changed := dr.status.Conditions.Update(api.ConditionTypePaused, true, "Pausing", "Destination could not be switched to read-only")
+		return false, changed, errors.WithStack(err)
+	}
+
+	if !readOnly {
+		changed := dr.status.Conditions.Update(api.ConditionTypePaused, true, "Pausing", "Switching destination to read-only")
+		return false, changed, nil
+	}
+
+	if syncActive {
+		changed := dr.status.Conditions.Update(api.ConditionTypePaused, true, "Pausing", "Synchronization is being cancelled")
+		return true, changed, nil
+	}
+
+	changed := dr.status.Conditions.Update(api.ConditionTypePaused, true, "Paused", "Synchronization is cancelled, destination is read-only")
+	return false, changed, nil
+}
+
+// inspectResume switches the destination of a paused synchronization back to the default server mode.
+// Returns true (as first value) once the synchronization can be configured again
+// and true (as second value) when the status has been changed.
+func (dr *DeploymentReplication) inspectResume(ctx context.Context, spec api.DeploymentReplicationSpec) (bool, bool, error) {
+	if !dr.status.Conditions.IsTrue(api.ConditionTypePaused) {
+		return true, false, nil
+	}
+
+	writable, err := dr.ensureServerMode(ctx, spec.Destination, driver.ServerModeDefault)
+	if err != nil {
+		changed := dr.status.Conditions.Update(api.ConditionTypePaused, true, "Resuming", "Destination could not be switched back to the default server mode")
+		return false, changed, errors.WithStack(err)
+	}
+
+	if !writable {
+		changed := dr.status.Conditions.Update(api.ConditionTypePaused, true, "Resuming", "Switching destination back to the default server mode")
+		return false, changed, nil
+	}
+
+	changed := dr.status.Conditions.Update(api.ConditionTypePaused, false, "Resumed", "Synchronization is resumed")
+	return true, changed, nil
+}
+
+// restorePausedDestination switches the destination of a paused synchronization back to the default server mode,
+// so it is not left read-only when the replication is removed.
+// Returns nil once the destination accepts writes again or when its deployment is gone.
+func (dr *DeploymentReplication) restorePausedDestination(ctx context.Context, spec api.DeploymentReplicationSpec) error {
+	if !dr.status.Conditions.IsTrue(api.ConditionTypePaused) {
+		return nil
+	}
+
+	writable, err := dr.ensureServerMode(ctx, spec.Destination, driver.ServerModeDefault)
+	if err != nil && !k8sutil.IsNotFound(err) {
+		return errors.WithStack(err)
+	}
+
+	if err == nil && !writable {
+		return errors.WithStack(errors.Newf("Destination is not yet switched back to the default server mode"))
+	}
+
+	dr.status.Conditions.Remove(api.ConditionTypePaused)
+	if err := dr.updateCRStatus(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
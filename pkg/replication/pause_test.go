@@ -0,0 +1,121 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package replication
+
+import (
+	"context"
+	"testing"
+
+	driver "github.com/arangodb/go-driver"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/replication/v1"
+	"github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/fake"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+)
+
+// serverModeClient is a database client which only keeps track of the server mode.
+type serverModeClient struct {
+	driver.Client
+
+	mode     driver.ServerMode
+	setCalls int
+}
+
+func (c *serverModeClient) ServerMode(ctx context.Context) (driver.ServerMode, error) {
+	return c.mode, nil
+}
+
+func (c *serverModeClient) SetServerMode(ctx context.Context, mode driver.ServerMode) error {
+	c.setCalls++
+	c.mode = mode
+	return nil
+}
+
+func newPausedDeploymentReplication(t *testing.T, factory databaseClientFactory) *DeploymentReplication {
+	apiObject := &api.ArangoDeploymentReplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "repl",
+			Namespace: "test",
+		},
+		Spec: api.DeploymentReplicationSpec{
+			Source:      api.EndpointSpec{DeploymentName: util.NewString("source")},
+			Destination: api.EndpointSpec{DeploymentName: util.NewString("destination")},
+			Paused:      util.NewBool(true),
+		},
+	}
+	apiObject.Status.Conditions.Update(api.ConditionTypePaused, true, "Paused", "Synchronization is cancelled, destination is read-only")
+
+	crCli := fake.NewSimpleClientset(apiObject)
+	return &DeploymentReplication{
+		apiObject:             apiObject,
+		status:                *apiObject.Status.DeepCopy(),
+		deps:                  Dependencies{Log: zerolog.Nop(), CRCli: crCli},
+		databaseClientFactory: factory,
+	}
+}
+
+func TestRestorePausedDestination(t *testing.T) {
+	ctx := context.Background()
+	c := &serverModeClient{mode: driver.ServerModeReadOnly}
+	dr := newPausedDeploymentReplication(t, func(ctx context.Context, ep api.EndpointSpec) (driver.Client, error) {
+		assert.Equal(t, "destination", ep.GetDeploymentName())
+		return c, nil
+	})
+
+	// The finalizer must be kept until the destination reports the default server mode
+	require.Error(t, dr.restorePausedDestination(ctx, dr.apiObject.Spec))
+	assert.Equal(t, 1, c.setCalls)
+	assert.Equal(t, driver.ServerModeDefault, c.mode)
+	assert.True(t, dr.status.Conditions.IsTrue(api.ConditionTypePaused))
+
+	require.NoError(t, dr.restorePausedDestination(ctx, dr.apiObject.Spec))
+	assert.Equal(t, 1, c.setCalls)
+	assert.False(t, dr.status.Conditions.IsTrue(api.ConditionTypePaused))
+
+	stored, err := dr.deps.CRCli.ReplicationV1().ArangoDeploymentReplications("test").Get("repl", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.False(t, stored.Status.Conditions.IsTrue(api.ConditionTypePaused))
+}
+
+func TestRestorePausedDestinationNotPaused(t *testing.T) {
+	dr := newPausedDeploymentReplication(t, func(ctx context.Context, ep api.EndpointSpec) (driver.Client, error) {
+		t.Fatal("Destination must not be contacted when the synchronization is not paused")
+		return nil, nil
+	})
+	dr.status.Conditions.Remove(api.ConditionTypePaused)
+
+	require.NoError(t, dr.restorePausedDestination(context.Background(), dr.apiObject.Spec))
+}
+
+func TestRestorePausedDestinationGone(t *testing.T) {
+	dr := newPausedDeploymentReplication(t, func(ctx context.Context, ep api.EndpointSpec) (driver.Client, error) {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "arangodeployments"}, ep.GetDeploymentName())
+	})
+
+	require.NoError(t, dr.restorePausedDestination(context.Background(), dr.apiObject.Spec))
+	assert.False(t, dr.status.Conditions.IsTrue(api.ConditionTypePaused))
+}
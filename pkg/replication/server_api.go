@@ -49,6 +49,11 @@ func (dr *DeploymentReplication) StateColor() server.StateColor {
 	return server.StateYellow
 }
 
+// Paused returns true when the synchronization is paused.
+func (dr *DeploymentReplication) Paused() bool {
+	return dr.apiObject.Spec.IsPaused()
+}
+
 // Source provides info on the source of the replication
 func (dr *DeploymentReplication) Source() server.Endpoint {
 	return serverEndpoint{
@@ -39,6 +39,12 @@ func (ep serverEndpoint) DeploymentName() string {
 
 // MasterEndpoint returns the URLs of the custom master endpoint
 func (ep serverEndpoint) MasterEndpoint() []string {
+	if spec := ep.getSpec(); spec.HasAccessPackage() {
+		if bundle, err := ep.dr.getAccessPackage(spec); err == nil {
+			return bundle.MasterEndpoint
+		}
+		return nil
+	}
 	return ep.getSpec().MasterEndpoint
 }
 
@@ -56,7 +62,7 @@ func (ep serverEndpoint) AuthUserSecretName() string {
 
 // TLSCACert returns a PEM encoded TLS CA certificate of the syncmaster at this endpoint
 func (ep serverEndpoint) TLSCACert() string {
-	tlsCASecretName := ep.getSpec().TLS.GetCASecretName()
+	tlsCASecretName := ep.TLSCACertSecretName()
 	secrets := ep.dr.deps.KubeCli.CoreV1().Secrets(ep.dr.apiObject.GetNamespace())
 	caCert, err := k8sutil.GetCACertficateSecret(secrets, tlsCASecretName)
 	if err != nil {
@@ -67,5 +73,8 @@ func (ep serverEndpoint) TLSCACert() string {
 
 // TLSCACertSecretName returns the name of a Secret containing the TLS CA certificate of the syncmaster at this endpoint
 func (ep serverEndpoint) TLSCACertSecretName() string {
+	if spec := ep.getSpec(); spec.HasAccessPackage() {
+		return spec.GetAccessPackageSecretName()
+	}
 	return ep.getSpec().TLS.GetCASecretName()
 }
@@ -0,0 +1,305 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package replication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	driver "github.com/arangodb/go-driver"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/arangosync-client/client"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/replication/v1"
+	"github.com/arangodb/kube-arangodb/pkg/audit"
+	"github.com/arangodb/kube-arangodb/pkg/util/arangod"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+const (
+	switchoverCancelWaitTimeout  = time.Minute * 3
+	switchoverCancelForceTimeout = time.Minute * 2
+)
+
+// isSwitchoverActive returns true when the switchover flow replaces the regular configuration
+// of the synchronization.
+func (dr *DeploymentReplication) isSwitchoverActive(spec api.DeploymentReplicationSpec) bool {
+	return spec.Switchover != nil || dr.status.Switchover.IsInProgress()
+}
+
+// inspectSwitchover moves the switchover requested in the spec through its phases.
+// A Planned switchover waits until all shards of the destination are in sync (WaitingForSync),
+// then switches the coordinators of the source deployment to read-only and stops the synchronization (StoppingSync).
+// When it is given up after the source may have been switched to read-only, the source is switched back
+// to the default server mode (Restoring) before the switchover is marked as failed.
+// A Failover stops the synchronization forcefully right away.
+// Once stopped, source and destination are swapped in the spec (Completed), after which the regular
+// inspection configures the synchronization in the reversed direction.
+// Returns true when the status has been changed.
+func (dr *DeploymentReplication) inspectSwitchover(ctx context.Context, spec api.DeploymentReplicationSpec, destClient client.API) (bool, error) {
+	status := dr.status.Switchover
+
+	if status.IsInProgress() && status.Phase == api.SwitchoverPhaseRestoring {
+		return dr.inspectSwitchoverRestoring(ctx, spec)
+	}
+
+	if spec.Switchover == nil {
+		if status.IsInProgress() {
+			if status.Phase == api.SwitchoverPhaseStoppingSync && status.Mode != api.SwitchoverModeFailover {
+				// Source may already be read-only
+				dr.setSwitchoverPhase(api.SwitchoverPhaseRestoring, "Switchover canceled by removal of spec.switchover")
+				return true, nil
+			}
+			dr.setSwitchoverPhase(api.SwitchoverPhaseFailed, "Switchover canceled by removal of spec.switchover")
+			return true, nil
+		}
+		return false, nil
+	}
+
+	if status == nil || status.Phase == "" || status.Phase.IsFinished() {
+		return true, dr.startSwitchover(spec)
+	}
+
+	failover := spec.Switchover.IsFailover()
+	timeout := spec.Switchover.GetTimeout()
+	timedOut := !failover && time.Since(status.StartTime.Time) > timeout
+
+	switch status.Phase {
+	case api.SwitchoverPhaseWaitingForSync:
+		if timedOut {
+			return true, dr.finishSwitchover(withoutSwitchover(spec), api.SwitchoverPhaseFailed,
+				fmt.Sprintf("Shards did not get in sync within %s", timeout))
+		}
+		if !dr.status.Conditions.IsTrue(api.ConditionTypeConfigured) {
+			// Wait for the destination to report its status
+			return false, nil
+		}
+		lag := dr.status.Destination.Lag
+		if lag == nil || lag.GetShardsNotInSync() > 0 {
+			return false, nil
+		}
+		dr.setSwitchoverPhase(api.SwitchoverPhaseStoppingSync, "All shards are in sync, stopping synchronization and writes in the source")
+		return true, nil
+	case api.SwitchoverPhaseStoppingSync:
+		if !failover {
+			// Stop incoming writes in the source before cancelling, so no data is lost
+			if readOnly, err := dr.ensureServerMode(ctx, spec.Source, driver.ServerModeReadOnly); err != nil || !readOnly {
+				if timedOut {
+					dr.setSwitchoverPhase(api.SwitchoverPhaseRestoring,
+						fmt.Sprintf("Source could not be switched to read-only within %s", timeout))
+					return true, nil
+				}
+				return false, errors.WithStack(err)
+			}
+		}
+		req := client.CancelSynchronizationRequest{
+			WaitTimeout:  switchoverCancelWaitTimeout,
+			Force:        failover,
+			ForceTimeout: switchoverCancelForceTimeout,
+		}
+		if _, err := destClient.Master().CancelSynchronization(ctx, req); err != nil && !client.IsPreconditionFailed(err) {
+			if timedOut {
+				dr.setSwitchoverPhase(api.SwitchoverPhaseRestoring,
+					fmt.Sprintf("Synchronization could not be stopped within %s: %s", timeout, err.Error()))
+				return true, nil
+			}
+			return false, errors.WithStack(err)
+		}
+
+		message := "Synchronization stopped, direction of the replication reversed"
+		if failover {
+			message = "Synchronization stopped forcefully (data loss accepted), direction of the replication reversed"
+		}
+		if dr.status.Conditions.IsTrue(api.ConditionTypePaused) {
+			// The former destination has been made read-only by the pause, it must accept writes as new source
+			writable, err := dr.ensureServerMode(ctx, spec.Destination, driver.ServerModeDefault)
+			if err != nil {
+				return false, errors.WithStack(err)
+			}
+			if !writable {
+				return false, nil
+			}
+			dr.status.Conditions.Remove(api.ConditionTypePaused)
+		}
+		dr.status.Conditions.Update(api.ConditionTypeConfigured, false, "Switchover", "Direction of the replication has been reversed")
+		return true, dr.finishSwitchover(spec.Reversed(), api.SwitchoverPhaseCompleted, message)
+	}
+
+	return false, nil
+}
+
+// databaseClientFactory creates a client for the coordinators of the ArangoDeployment of the given endpoint.
+type databaseClientFactory func(ctx context.Context, ep api.EndpointSpec) (driver.Client, error)
+
+// ensureServerMode switches the coordinators of the deployment of the given endpoint into the given server mode.
+// Returns true once the deployment reports the requested mode.
+func (dr *DeploymentReplication) ensureServerMode(ctx context.Context, ep api.EndpointSpec, mode driver.ServerMode) (bool, error) {
+	factory := dr.databaseClientFactory
+	if factory == nil {
+		factory = dr.createDatabaseClient
+	}
+
+	c, err := factory(ctx, ep)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	current, err := c.ServerMode(ctx)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	if current == mode {
+		return true, nil
+	}
+
+	if err := c.SetServerMode(ctx, mode); err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	// Wait for the mode to be reported on the next inspection
+	return false, nil
+}
+
+// inspectSwitchoverRestoring switches the source deployment of a given up planned switchover back
+// to the default server mode. The switchover is marked as failed once the source accepts writes again.
+// Returns true when the status has been changed.
+func (dr *DeploymentReplication) inspectSwitchoverRestoring(ctx context.Context, spec api.DeploymentReplicationSpec) (bool, error) {
+	writable, err := dr.ensureServerMode(ctx, spec.Source, driver.ServerModeDefault)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	if !writable {
+		return false, nil
+	}
+
+	message := "Source switched back to the default server mode"
+	if steps := dr.status.Switchover.Steps; len(steps) > 0 {
+		message = fmt.Sprintf("%s, source switched back to the default server mode", steps[len(steps)-1].Message)
+	}
+	return true, dr.finishSwitchover(withoutSwitchover(spec), api.SwitchoverPhaseFailed, message)
+}
+
+// createDatabaseClient creates a client for the coordinators of the ArangoDeployment of the given endpoint.
+func (dr *DeploymentReplication) createDatabaseClient(ctx context.Context, ep api.EndpointSpec) (driver.Client, error) {
+	if !ep.HasDeploymentName() {
+		return nil, errors.WithStack(errors.Newf("Endpoint is not an ArangoDeployment in namespace %s", dr.apiObject.GetNamespace()))
+	}
+
+	depl, err := dr.deps.CRCli.DatabaseV1().ArangoDeployments(dr.apiObject.GetNamespace()).Get(ep.GetDeploymentName(), metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	c, err := arangod.CreateArangodDatabaseClient(ctx, dr.deps.KubeCli.CoreV1(), depl, true)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return c, nil
+}
+
+// startSwitchover initializes the switchover status for the switchover requested in the spec.
+func (dr *DeploymentReplication) startSwitchover(spec api.DeploymentReplicationSpec) error {
+	mode := spec.Switchover.GetMode()
+	dr.status.Switchover = &api.SwitchoverStatus{
+		Mode:      mode,
+		StartTime: metav1.Now(),
+	}
+
+	if err := spec.Reversed().Validate(); err != nil {
+		return dr.finishSwitchover(withoutSwitchover(spec), api.SwitchoverPhaseFailed,
+			fmt.Sprintf("Replication cannot be reversed: %s", err.Error()))
+	}
+
+	if spec.Switchover.IsFailover() {
+		dr.setSwitchoverPhase(api.SwitchoverPhaseStoppingSync, "Failover requested, stopping synchronization (data loss accepted)")
+	} else {
+		dr.setSwitchoverPhase(api.SwitchoverPhaseWaitingForSync, "Switchover requested, waiting for all shards to be in sync")
+	}
+	return nil
+}
+
+// setSwitchoverPhase moves the switchover into the given phase and reports it.
+func (dr *DeploymentReplication) setSwitchoverPhase(phase api.SwitchoverPhase, message string) {
+	dr.status.Switchover.SetPhase(phase, message)
+	dr.reportSwitchoverPhase(phase, message)
+}
+
+// finishSwitchover moves the switchover into the given final phase and stores the given spec
+// (which must have its switchover cleared) together with the status.
+func (dr *DeploymentReplication) finishSwitchover(newSpec api.DeploymentReplicationSpec, phase api.SwitchoverPhase, message string) error {
+	previous := dr.status.Switchover.DeepCopy()
+	dr.status.Switchover.SetPhase(phase, message)
+	if err := dr.updateCRSpec(newSpec); err != nil {
+		// Retry on next inspection
+		dr.status.Switchover = previous
+		return errors.WithStack(err)
+	}
+	dr.reportSwitchoverPhase(phase, message)
+	return nil
+}
+
+// reportSwitchoverPhase logs and records an event for the given switchover phase.
+func (dr *DeploymentReplication) reportSwitchoverPhase(phase api.SwitchoverPhase, message string) {
+	mode := string(dr.status.Switchover.Mode)
+	dr.deps.Log.Info().Str("mode", mode).Str("phase", string(phase)).Msg(message)
+	dr.createEvent(k8sutil.NewSwitchoverEvent(dr.apiObject, mode, string(phase), message, phase == api.SwitchoverPhaseFailed))
+	dr.auditSwitchoverPhase(phase, message)
+}
+
+// auditSwitchoverPhase writes the audit record for the start and the end of the switchover.
+func (dr *DeploymentReplication) auditSwitchoverPhase(phase api.SwitchoverPhase, message string) {
+	if !audit.Enabled() {
+		return
+	}
+
+	s := dr.status.Switchover
+	var auditPhase audit.Phase
+	switch {
+	case phase == api.SwitchoverPhaseCompleted:
+		auditPhase = audit.PhaseFinished
+	case phase == api.SwitchoverPhaseFailed:
+		auditPhase = audit.PhaseFailed
+	case len(s.Steps) == 1:
+		auditPhase = audit.PhaseStarted
+	default:
+		return
+	}
+
+	r := dr.newAuditRecord(auditPhase, fmt.Sprintf("%s%s", auditActionSwitchover, s.Mode))
+	r.Reason = message
+	if auditPhase == audit.PhaseFailed {
+		r.Error = message
+	}
+	if auditPhase != audit.PhaseStarted {
+		r.SetDuration(s.StartTime.Time)
+	}
+	audit.Emit(r)
+}
+
+// withoutSwitchover returns a copy of the given spec with the switchover request cleared.
+func withoutSwitchover(spec api.DeploymentReplicationSpec) api.DeploymentReplicationSpec {
+	result := *spec.DeepCopy()
+	result.Switchover = nil
+	return result
+}
@@ -0,0 +1,206 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package replication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arangodb/arangosync-client/client"
+	driver "github.com/arangodb/go-driver"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/replication/v1"
+	"github.com/arangodb/kube-arangodb/pkg/generated/clientset/versioned/fake"
+	"github.com/arangodb/kube-arangodb/pkg/util"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// cancelSyncClient is a syncmaster client which accepts the cancellation of the synchronization.
+type cancelSyncClient struct {
+	client.API
+}
+
+func (c cancelSyncClient) Master() client.MasterAPI {
+	return cancelSyncMaster{}
+}
+
+type cancelSyncMaster struct {
+	client.MasterAPI
+}
+
+func (m cancelSyncMaster) CancelSynchronization(ctx context.Context, input client.CancelSynchronizationRequest) (client.CancelSynchronizationResponse, error) {
+	return client.CancelSynchronizationResponse{}, nil
+}
+
+func newSwitchoverDeploymentReplication(spec api.DeploymentReplicationSpec, status *api.SwitchoverStatus, factory databaseClientFactory) *DeploymentReplication {
+	apiObject := &api.ArangoDeploymentReplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "repl",
+			Namespace: "test",
+		},
+		Spec: spec,
+	}
+	apiObject.Status.Switchover = status
+
+	return &DeploymentReplication{
+		apiObject: apiObject,
+		status:    *apiObject.Status.DeepCopy(),
+		deps: Dependencies{
+			Log:           zerolog.Nop(),
+			CRCli:         fake.NewSimpleClientset(apiObject),
+			EventRecorder: record.NewFakeRecorder(100),
+		},
+		databaseClientFactory: factory,
+	}
+}
+
+func newSwitchoverSpec(switchover *api.SwitchoverSpec) api.DeploymentReplicationSpec {
+	return api.DeploymentReplicationSpec{
+		Source:      api.EndpointSpec{DeploymentName: util.NewString("source")},
+		Destination: api.EndpointSpec{DeploymentName: util.NewString("destination")},
+		Switchover:  switchover,
+	}
+}
+
+func TestSwitchoverRemovedWhileStoppingSync(t *testing.T) {
+	ctx := context.Background()
+	c := &serverModeClient{mode: driver.ServerModeReadOnly}
+	status := &api.SwitchoverStatus{
+		Mode:      api.SwitchoverModePlanned,
+		Phase:     api.SwitchoverPhaseStoppingSync,
+		StartTime: metav1.Now(),
+	}
+	dr := newSwitchoverDeploymentReplication(newSwitchoverSpec(nil), status, func(ctx context.Context, ep api.EndpointSpec) (driver.Client, error) {
+		assert.Equal(t, "source", ep.GetDeploymentName())
+		return c, nil
+	})
+
+	changed, err := dr.inspectSwitchover(ctx, dr.apiObject.Spec, nil)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, api.SwitchoverPhaseRestoring, dr.status.Switchover.Phase)
+	assert.True(t, dr.isSwitchoverActive(dr.apiObject.Spec))
+
+	// Switchover stays in Restoring until the source reports the default server mode
+	changed, err = dr.inspectSwitchover(ctx, dr.apiObject.Spec, nil)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, api.SwitchoverPhaseRestoring, dr.status.Switchover.Phase)
+	assert.Equal(t, driver.ServerModeDefault, c.mode)
+
+	changed, err = dr.inspectSwitchover(ctx, dr.apiObject.Spec, nil)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, api.SwitchoverPhaseFailed, dr.status.Switchover.Phase)
+	assert.False(t, dr.isSwitchoverActive(dr.apiObject.Spec))
+}
+
+func TestSwitchoverRemovedWhileWaitingForSync(t *testing.T) {
+	status := &api.SwitchoverStatus{
+		Mode:      api.SwitchoverModePlanned,
+		Phase:     api.SwitchoverPhaseWaitingForSync,
+		StartTime: metav1.Now(),
+	}
+	dr := newSwitchoverDeploymentReplication(newSwitchoverSpec(nil), status, func(ctx context.Context, ep api.EndpointSpec) (driver.Client, error) {
+		t.Fatal("Source must not be contacted when it has not been switched to read-only")
+		return nil, nil
+	})
+
+	changed, err := dr.inspectSwitchover(context.Background(), dr.apiObject.Spec, nil)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, api.SwitchoverPhaseFailed, dr.status.Switchover.Phase)
+}
+
+func TestSwitchoverTimeoutRetriesRestoring(t *testing.T) {
+	ctx := context.Background()
+	var available bool
+	c := &serverModeClient{mode: driver.ServerModeReadOnly}
+	status := &api.SwitchoverStatus{
+		Mode:      api.SwitchoverModePlanned,
+		Phase:     api.SwitchoverPhaseStoppingSync,
+		StartTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+	}
+	dr := newSwitchoverDeploymentReplication(newSwitchoverSpec(&api.SwitchoverSpec{}), status, func(ctx context.Context, ep api.EndpointSpec) (driver.Client, error) {
+		if !available {
+			return nil, errors.Newf("source is not reachable")
+		}
+		return c, nil
+	})
+
+	changed, err := dr.inspectSwitchover(ctx, dr.apiObject.Spec, nil)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, api.SwitchoverPhaseRestoring, dr.status.Switchover.Phase)
+
+	// Unreachable source keeps the switchover in Restoring
+	_, err = dr.inspectSwitchover(ctx, dr.apiObject.Spec, nil)
+	require.Error(t, err)
+	assert.Equal(t, api.SwitchoverPhaseRestoring, dr.status.Switchover.Phase)
+
+	available = true
+	_, err = dr.inspectSwitchover(ctx, dr.apiObject.Spec, nil)
+	require.NoError(t, err)
+	changed, err = dr.inspectSwitchover(ctx, dr.apiObject.Spec, nil)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, api.SwitchoverPhaseFailed, dr.status.Switchover.Phase)
+	assert.Nil(t, dr.apiObject.Spec.Switchover)
+}
+
+func TestPausedFailoverRestoresDestination(t *testing.T) {
+	ctx := context.Background()
+	c := &serverModeClient{mode: driver.ServerModeReadOnly}
+	failover := api.SwitchoverModeFailover
+	spec := newSwitchoverSpec(&api.SwitchoverSpec{Mode: &failover, AcceptDataLoss: util.NewBool(true)})
+	spec.Paused = util.NewBool(true)
+	status := &api.SwitchoverStatus{
+		Mode:      api.SwitchoverModeFailover,
+		Phase:     api.SwitchoverPhaseStoppingSync,
+		StartTime: metav1.Now(),
+	}
+	dr := newSwitchoverDeploymentReplication(spec, status, func(ctx context.Context, ep api.EndpointSpec) (driver.Client, error) {
+		assert.Equal(t, "destination", ep.GetDeploymentName())
+		return c, nil
+	})
+	dr.status.Conditions.Update(api.ConditionTypePaused, true, "Paused", "Synchronization is cancelled, destination is read-only")
+
+	// Switchover is not completed while the former destination is read-only
+	changed, err := dr.inspectSwitchover(ctx, dr.apiObject.Spec, cancelSyncClient{})
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, api.SwitchoverPhaseStoppingSync, dr.status.Switchover.Phase)
+	assert.True(t, dr.status.Conditions.IsTrue(api.ConditionTypePaused))
+
+	changed, err = dr.inspectSwitchover(ctx, dr.apiObject.Spec, cancelSyncClient{})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, api.SwitchoverPhaseCompleted, dr.status.Switchover.Phase)
+	assert.False(t, dr.status.Conditions.IsTrue(api.ConditionTypePaused))
+	assert.Equal(t, "destination", dr.apiObject.Spec.Source.GetDeploymentName())
+	assert.False(t, dr.apiObject.Spec.IsPaused())
+}
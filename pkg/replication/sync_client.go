@@ -34,6 +34,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/arangodb/kube-arangodb/pkg/apis/replication/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/arangosync/accesspackage"
 	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
@@ -105,6 +106,13 @@ func (dr *DeploymentReplication) createSyncMasterClient(epSpec api.EndpointSpec)
 
 // createArangoSyncEndpoint creates the endpoints for the given spec.
 func (dr *DeploymentReplication) createArangoSyncEndpoint(epSpec api.EndpointSpec) (client.Endpoint, error) {
+	if epSpec.HasAccessPackage() {
+		bundle, err := dr.getAccessPackage(epSpec)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return client.Endpoint(bundle.MasterEndpoint), nil
+	}
 	if epSpec.HasDeploymentName() {
 		deploymentName := epSpec.GetDeploymentName()
 		depls := dr.deps.CRCli.DatabaseV1().ArangoDeployments(dr.apiObject.GetNamespace())
@@ -164,6 +172,14 @@ func (dr *DeploymentReplication) createArangoSyncTLSAuthentication(spec api.Depl
 func (dr *DeploymentReplication) getEndpointSecretNames(epSpec api.EndpointSpec) (clientAuthCertKeyfileSecretName, userSecretName, jwtSecretName, tlsCASecretName string, err error) {
 	clientAuthCertKeyfileSecretName = epSpec.Authentication.GetKeyfileSecretName()
 	userSecretName = epSpec.Authentication.GetUserSecretName()
+	if epSpec.HasAccessPackage() {
+		// Access package secret contains both keyfile & TLS CA certificate
+		apSecretName := epSpec.GetAccessPackageSecretName()
+		if clientAuthCertKeyfileSecretName == "" {
+			clientAuthCertKeyfileSecretName = apSecretName
+		}
+		return clientAuthCertKeyfileSecretName, userSecretName, "", apSecretName, nil
+	}
 	if epSpec.HasDeploymentName() {
 		deploymentName := epSpec.GetDeploymentName()
 		depls := dr.deps.CRCli.DatabaseV1().ArangoDeployments(dr.apiObject.GetNamespace())
@@ -176,3 +192,28 @@ func (dr *DeploymentReplication) getEndpointSecretNames(epSpec api.EndpointSpec)
 	}
 	return clientAuthCertKeyfileSecretName, userSecretName, "", epSpec.TLS.GetCASecretName(), nil
 }
+
+// getAccessPackage loads the access package referenced by the given spec and verifies its signature
+// against the CA certificate in the Secret referenced by accessPackageCASecretName.
+// Both Secrets are loaded from the namespace of the replication.
+func (dr *DeploymentReplication) getAccessPackage(epSpec api.EndpointSpec) (accesspackage.Bundle, error) {
+	apSecretName := epSpec.GetAccessPackageSecretName()
+	secrets := dr.deps.KubeCli.CoreV1().Secrets(dr.apiObject.GetNamespace())
+	secret, err := secrets.Get(apSecretName, metav1.GetOptions{})
+	if err != nil {
+		dr.deps.Log.Debug().Err(err).Str("secret-name", apSecretName).Msg("Failed to get access package")
+		return accesspackage.Bundle{}, errors.WithStack(err)
+	}
+	caSecretName := epSpec.GetAccessPackageCASecretName()
+	trustedCACert, err := k8sutil.GetCACertficateSecret(secrets, caSecretName)
+	if err != nil {
+		dr.deps.Log.Debug().Err(err).Str("secret-name", caSecretName).Msg("Failed to get access package CA certificate")
+		return accesspackage.Bundle{}, errors.WithStack(err)
+	}
+	bundle, err := accesspackage.FromSecretData(secret.Data, trustedCACert)
+	if err != nil {
+		dr.deps.Log.Warn().Err(err).Str("secret-name", apSecretName).Msg("Invalid access package")
+		return accesspackage.Bundle{}, errors.WithStack(err)
+	}
+	return bundle, nil
+}
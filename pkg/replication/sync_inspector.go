@@ -24,6 +24,7 @@ package replication
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 
 	"github.com/arangodb/arangosync-client/client"
 	api "github.com/arangodb/kube-arangodb/pkg/apis/replication/v1"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
 )
 
 // inspectDeploymentReplication inspects the entire deployment replication
@@ -76,6 +78,7 @@ func (dr *DeploymentReplication) inspectDeploymentReplication(lastInterval time.
 			updateStatusNeeded := false
 			configureSyncNeeded := false
 			cancelSyncNeeded := false
+			syncActive := false
 			destEndpoint, err := destClient.Master().GetEndpoints(ctx)
 			if err != nil {
 				log.Warn().Err(err).Msg("Failed to fetch endpoints from destination syncmaster")
@@ -86,6 +89,7 @@ func (dr *DeploymentReplication) inspectDeploymentReplication(lastInterval time.
 			} else {
 				// Inspect destination status
 				if destStatus.Status.IsActive() {
+					syncActive = true
 					isIncomingEndpoint, err := dr.isIncomingEndpoint(destStatus, spec.Source)
 					if err != nil {
 						log.Warn().Err(err).Msg("Failed to check is-incoming-endpoint")
@@ -95,6 +99,8 @@ func (dr *DeploymentReplication) inspectDeploymentReplication(lastInterval time.
 							dr.status.Conditions.Update(api.ConditionTypeConfigured, true, "Active", "Destination syncmaster is configured correctly and active")
 							// Fetch shard status
 							dr.status.Destination = createEndpointStatus(destStatus, "")
+							dr.updateLagMetrics(dr.status.Destination)
+							dr.updateLagCondition(spec, dr.status.Destination)
 							updateStatusNeeded = true
 						} else {
 							// Sync is active, but from different source
@@ -139,6 +145,49 @@ func (dr *DeploymentReplication) inspectDeploymentReplication(lastInterval time.
 				}
 			}
 
+			// Pause cancels the synchronization, while keeping the destination read-only
+			if spec.IsPaused() {
+				configureSyncNeeded = false
+				cancel, changed, err := dr.inspectPause(ctx, spec, syncActive)
+				if err != nil {
+					log.Warn().Err(err).Msg("Failed to pause synchronization")
+					hasError = true
+				}
+				cancelSyncNeeded = cancel
+				if changed {
+					updateStatusNeeded = true
+				}
+			} else {
+				ready, changed, err := dr.inspectResume(ctx, spec)
+				if err != nil {
+					log.Warn().Err(err).Msg("Failed to resume synchronization")
+					hasError = true
+				}
+				if !ready {
+					configureSyncNeeded = false
+				}
+				if changed {
+					updateStatusNeeded = true
+				}
+			}
+
+			// Switchover replaces the regular configuration of the synchronization
+			if dr.isSwitchoverActive(spec) {
+				configureSyncNeeded = false
+				cancelSyncNeeded = false
+				changed, err := dr.inspectSwitchover(ctx, spec, destClient)
+				if err != nil {
+					log.Warn().Err(err).Msg("Failed to inspect switchover")
+					hasError = true
+				}
+				if changed {
+					updateStatusNeeded = true
+				}
+				if dr.status.Switchover.IsInProgress() {
+					nextInterval = time.Second * 10
+				}
+			}
+
 			// Update status if needed
 			if updateStatusNeeded {
 				if err := dr.updateCRStatus(); err != nil {
@@ -289,6 +338,12 @@ func createEndpointStatusFromShards(shards []client.ShardSyncInfo) api.EndpointS
 
 		// Add current shard
 		col.Shards = append(col.Shards, api.ShardStatus{Status: string(s.Status)})
+
+		// Add progress of current shard
+		if col.Lag == nil {
+			col.Lag = &api.LagStatus{}
+		}
+		col.Lag.Add(s.Status == client.SyncStatusRunning, s.LastMessage, s.Delay)
 	}
 
 	// Sort result
@@ -297,5 +352,55 @@ func createEndpointStatusFromShards(shards []client.ShardSyncInfo) api.EndpointS
 		sort.Slice(db.Collections, func(i, j int) bool { return db.Collections[i].Name < db.Collections[j].Name })
 		result.Databases[i] = db
 	}
+
+	// Aggregate progress of collections and databases
+	aggregateLag(&result)
 	return result
 }
+
+// aggregateLag fills the lag of all databases and of the endpoint from the lag of the collections.
+func aggregateLag(status *api.EndpointStatus) {
+	status.Lag = nil
+	for i := range status.Databases {
+		db := &status.Databases[i]
+		db.Lag = &api.LagStatus{}
+		for _, col := range db.Collections {
+			db.Lag.Merge(col.Lag)
+		}
+		if status.Lag == nil {
+			status.Lag = &api.LagStatus{}
+		}
+		status.Lag.Merge(db.Lag)
+	}
+}
+
+// updateLagCondition updates the LagHealthy condition from the lag of the given endpoint status.
+// Returns true when the condition has changed.
+func (dr *DeploymentReplication) updateLagCondition(spec api.DeploymentReplicationSpec, status api.EndpointStatus) bool {
+	if !spec.IsLagThresholdEnabled() {
+		return dr.status.Conditions.Remove(api.ConditionTypeLagHealthy)
+	}
+
+	if exceeded, msg := isLagThresholdExceeded(spec, status); exceeded {
+		if !dr.status.Conditions.Update(api.ConditionTypeLagHealthy, false, "LagExceeded", msg) {
+			return false
+		}
+		dr.createEvent(k8sutil.NewReplicationUnhealthyEvent(dr.apiObject, "LagExceeded", msg))
+		return true
+	}
+	return dr.status.Conditions.Update(api.ConditionTypeLagHealthy, true, "LagWithinThreshold", "Replication delay is within the configured threshold")
+}
+
+// isLagThresholdExceeded returns true (with a message) when the delay of the given endpoint status
+// exceeds the configured threshold.
+func isLagThresholdExceeded(spec api.DeploymentReplicationSpec, status api.EndpointStatus) (bool, string) {
+	threshold := spec.GetLagThreshold()
+	if threshold <= 0 {
+		return false, ""
+	}
+
+	if delay := status.Lag.GetDelay(); delay > threshold {
+		return true, fmt.Sprintf("Replication delay %s exceeds threshold %s (%d shards not in sync)", delay, threshold, status.Lag.GetShardsNotInSync())
+	}
+	return false, ""
+}
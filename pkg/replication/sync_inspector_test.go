@@ -0,0 +1,92 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package replication
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/arangodb/kube-arangodb/pkg/apis/replication/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newCollectionLag(notInSync int, lastSync time.Time, delay time.Duration) *api.LagStatus {
+	l := &api.LagStatus{}
+	for i := 0; i < notInSync; i++ {
+		l.Add(false, time.Time{}, 0)
+	}
+	l.Add(true, lastSync, delay)
+	return l
+}
+
+func TestAggregateLag(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	status := api.EndpointStatus{
+		Databases: []api.DatabaseStatus{
+			{
+				Name: "db1",
+				Collections: []api.CollectionStatus{
+					{Name: "a", Lag: newCollectionLag(1, now.Add(-time.Minute), 5*time.Second)},
+					{Name: "b", Lag: newCollectionLag(2, now, time.Second)},
+				},
+			},
+			{
+				Name: "db2",
+				Collections: []api.CollectionStatus{
+					{Name: "c", Lag: newCollectionLag(0, now.Add(-time.Hour), 30*time.Second)},
+				},
+			},
+		},
+	}
+
+	aggregateLag(&status)
+
+	assert.Equal(t, 3, status.Databases[0].Lag.GetShardsNotInSync())
+	assert.Equal(t, 5*time.Second, status.Databases[0].Lag.GetDelay())
+	assert.True(t, status.Databases[0].Lag.LastSyncTime.Time.Equal(now))
+
+	assert.Equal(t, 0, status.Databases[1].Lag.GetShardsNotInSync())
+	assert.Equal(t, 30*time.Second, status.Databases[1].Lag.GetDelay())
+
+	assert.Equal(t, 3, status.Lag.GetShardsNotInSync())
+	assert.Equal(t, 30*time.Second, status.Lag.GetDelay())
+	assert.True(t, status.Lag.LastSyncTime.Time.Equal(now))
+}
+
+func TestIsLagThresholdExceeded(t *testing.T) {
+	status := api.EndpointStatus{
+		Lag: newCollectionLag(1, time.Now(), time.Minute),
+	}
+
+	var spec api.DeploymentReplicationSpec
+	exceeded, _ := isLagThresholdExceeded(spec, status)
+	assert.False(t, exceeded)
+
+	spec.LagThreshold = &metav1.Duration{Duration: 2 * time.Minute}
+	exceeded, _ = isLagThresholdExceeded(spec, status)
+	assert.False(t, exceeded)
+
+	spec.LagThreshold = &metav1.Duration{Duration: 30 * time.Second}
+	exceeded, msg := isLagThresholdExceeded(spec, status)
+	assert.True(t, exceeded)
+	assert.Contains(t, msg, "1 shards not in sync")
+}
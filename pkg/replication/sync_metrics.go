@@ -0,0 +1,83 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package replication
+
+import (
+	api "github.com/arangodb/kube-arangodb/pkg/apis/replication/v1"
+	"github.com/arangodb/kube-arangodb/pkg/metrics"
+)
+
+const (
+	metricsComponent = "deployment_replication"
+)
+
+var (
+	shardsNotInSyncGauges = metrics.MustRegisterGaugeVec(metricsComponent, "shards_not_in_sync", "Number of shards of a collection which are not in sync", metrics.DeploymentReplicationName, metrics.Database, metrics.Collection)
+	delayGauges           = metrics.MustRegisterGaugeVec(metricsComponent, "delay_seconds", "Estimated replication delay of a collection (in sec)", metrics.DeploymentReplicationName, metrics.Database, metrics.Collection)
+	lastSyncGauges        = metrics.MustRegisterGaugeVec(metricsComponent, "last_sync_timestamp_seconds", "Time of the most recent message received for a collection (unix timestamp)", metrics.DeploymentReplicationName, metrics.Database, metrics.Collection)
+)
+
+// lagMetricKey identifies the collection for which lag metrics are reported.
+type lagMetricKey struct {
+	database   string
+	collection string
+}
+
+// updateLagMetrics publishes the lag of all collections in the given endpoint status.
+// Metrics of collections which are no longer reported are removed.
+func (dr *DeploymentReplication) updateLagMetrics(status api.EndpointStatus) {
+	name := dr.apiObject.GetName()
+	reported := make(map[lagMetricKey]struct{})
+
+	for _, db := range status.Databases {
+		for _, col := range db.Collections {
+			reported[lagMetricKey{database: db.Name, collection: col.Name}] = struct{}{}
+
+			shardsNotInSyncGauges.WithLabelValues(name, db.Name, col.Name).Set(float64(col.Lag.GetShardsNotInSync()))
+			delayGauges.WithLabelValues(name, db.Name, col.Name).Set(col.Lag.GetDelay().Seconds())
+			if col.Lag != nil && col.Lag.LastSyncTime != nil {
+				lastSyncGauges.WithLabelValues(name, db.Name, col.Name).Set(float64(col.Lag.LastSyncTime.Unix()))
+			}
+		}
+	}
+
+	for key := range dr.lagMetricKeys {
+		if _, ok := reported[key]; !ok {
+			deleteLagMetrics(name, key)
+		}
+	}
+	dr.lagMetricKeys = reported
+}
+
+// removeLagMetrics removes all lag metrics of the deployment replication.
+func (dr *DeploymentReplication) removeLagMetrics() {
+	name := dr.apiObject.GetName()
+	for key := range dr.lagMetricKeys {
+		deleteLagMetrics(name, key)
+	}
+	dr.lagMetricKeys = nil
+}
+
+func deleteLagMetrics(name string, key lagMetricKey) {
+	shardsNotInSyncGauges.DeleteLabelValues(name, key.database, key.collection)
+	delayGauges.DeleteLabelValues(name, key.database, key.collection)
+	lastSyncGauges.DeleteLabelValues(name, key.database, key.collection)
+}
@@ -33,6 +33,7 @@ import (
 var (
 	NotFoundError     = errors.New("not found")
 	UnauthorizedError = errors.New("unauthorized")
+	BadRequestError   = errors.New("bad request")
 )
 
 func isNotFound(err error) bool {
@@ -43,6 +44,10 @@ func isUnauthorized(err error) bool {
 	return err == UnauthorizedError || errors.Cause(err) == UnauthorizedError
 }
 
+func isBadRequest(err error) bool {
+	return err == BadRequestError || errors.Cause(err) == BadRequestError
+}
+
 // sendError sends an error on the given context
 func sendError(c *gin.Context, err error) {
 	// TODO proper status handling
@@ -51,6 +56,8 @@ func sendError(c *gin.Context, err error) {
 		code = http.StatusNotFound
 	} else if isUnauthorized(err) {
 		code = http.StatusUnauthorized
+	} else if isBadRequest(err) {
+		code = http.StatusBadRequest
 	}
 	c.JSON(code, gin.H{
 		"error": err.Error(),
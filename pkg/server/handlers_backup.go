@@ -0,0 +1,216 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package server
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	driver "github.com/arangodb/go-driver"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Backup is the API implemented by an ArangoBackup.
+type Backup interface {
+	Name() string
+	Namespace() string
+	DeploymentName() string
+	ID() string
+	Type() string
+	State() string
+	Available() bool
+	Imported() bool
+	Uploaded() bool
+	RepositoryURL() string
+	Version() string
+	SizeInBytes() uint64
+	CreatedAt() time.Time
+}
+
+// BackupOperator is the API implemented by the backup operator.
+type BackupOperator interface {
+	// GetBackups returns basic information for all backups known to the operator, across all watched namespaces
+	GetBackups() ([]Backup, error)
+}
+
+// BackupInfo is the information returned per backup.
+type BackupInfo struct {
+	Name           string    `json:"name"`
+	Namespace      string    `json:"namespace"`
+	DeploymentName string    `json:"deployment_name"`
+	ID             string    `json:"id"`
+	Type           string    `json:"type"`
+	State          string    `json:"state"`
+	Available      bool      `json:"available"`
+	Imported       bool      `json:"imported"`
+	Uploaded       bool      `json:"uploaded"`
+	RepositoryURL  string    `json:"repository_url,omitempty"`
+	Version        string    `json:"version"`
+	SizeInBytes    uint64    `json:"size_in_bytes"`
+	CreatedAt      time.Time `json:"created_at"`
+	Age            string    `json:"age"`
+}
+
+// newBackupInfo initializes a BackupInfo for the given backup.
+func newBackupInfo(b Backup, now time.Time) BackupInfo {
+	return BackupInfo{
+		Name:           b.Name(),
+		Namespace:      b.Namespace(),
+		DeploymentName: b.DeploymentName(),
+		ID:             b.ID(),
+		Type:           b.Type(),
+		State:          b.State(),
+		Available:      b.Available(),
+		Imported:       b.Imported(),
+		Uploaded:       b.Uploaded(),
+		RepositoryURL:  b.RepositoryURL(),
+		Version:        b.Version(),
+		SizeInBytes:    b.SizeInBytes(),
+		CreatedAt:      b.CreatedAt(),
+		Age:            now.Sub(b.CreatedAt()).Round(time.Second).String(),
+	}
+}
+
+// backupFilter selects backups from the catalog
+type backupFilter struct {
+	Namespace, DeploymentName, Version string
+	MinAge, MaxAge                     time.Duration
+}
+
+// newBackupFilter creates filter from the query of the request
+func newBackupFilter(c *gin.Context) (backupFilter, error) {
+	f := backupFilter{
+		Namespace:      c.Query("namespace"),
+		DeploymentName: c.Query("deployment"),
+		Version:        c.Query("version"),
+	}
+
+	for param, target := range map[string]*time.Duration{"minAge": &f.MinAge, "maxAge": &f.MaxAge} {
+		if v := c.Query(param); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				return f, errors.Wrapf(BadRequestError, "invalid %s: %s", param, err.Error())
+			}
+			*target = d
+		}
+	}
+
+	return f, nil
+}
+
+func (f backupFilter) match(b Backup, now time.Time) bool {
+	if f.Namespace != "" && f.Namespace != b.Namespace() {
+		return false
+	}
+
+	if f.DeploymentName != "" && f.DeploymentName != b.DeploymentName() {
+		return false
+	}
+
+	if f.Version != "" && f.Version != b.Version() {
+		return false
+	}
+
+	age := now.Sub(b.CreatedAt())
+
+	if f.MinAge > 0 && age < f.MinAge {
+		return false
+	}
+
+	if f.MaxAge > 0 && age > f.MaxAge {
+		return false
+	}
+
+	return true
+}
+
+// sortBackups sorts backups by given key. Newest and largest backups go first.
+func sortBackups(backups []BackupInfo, by string) error {
+	var less func(a, b BackupInfo) bool
+
+	switch by {
+	case "", "name":
+		less = func(a, b BackupInfo) bool {
+			if a.Namespace != b.Namespace {
+				return a.Namespace < b.Namespace
+			}
+			return a.Name < b.Name
+		}
+	case "age":
+		less = func(a, b BackupInfo) bool {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+	case "size":
+		less = func(a, b BackupInfo) bool {
+			return a.SizeInBytes > b.SizeInBytes
+		}
+	case "version":
+		less = func(a, b BackupInfo) bool {
+			return driver.Version(a.Version).CompareTo(driver.Version(b.Version)) > 0
+		}
+	default:
+		return errors.Wrapf(BadRequestError, "unknown sort key %s", by)
+	}
+
+	sort.SliceStable(backups, func(i, j int) bool {
+		return less(backups[i], backups[j])
+	})
+
+	return nil
+}
+
+// Handle a GET /api/backup request
+func (s *Server) handleGetBackups(c *gin.Context) {
+	if o := s.deps.Operators.BackupOperator(); o != nil {
+		filter, err := newBackupFilter(c)
+		if err != nil {
+			sendError(c, err)
+			return
+		}
+
+		// Fetch backups
+		backups, err := o.GetBackups()
+		if err != nil {
+			sendError(c, err)
+			return
+		}
+
+		now := time.Now()
+		result := make([]BackupInfo, 0, len(backups))
+		for _, b := range backups {
+			if filter.match(b, now) {
+				result = append(result, newBackupInfo(b, now))
+			}
+		}
+
+		if err := sortBackups(result, c.Query("sort")); err != nil {
+			sendError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"backups": result,
+		})
+	}
+}
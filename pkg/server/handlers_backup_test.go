@@ -0,0 +1,326 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type testBackup struct {
+	name, namespace, deployment, version string
+	size                                 uint64
+	createdAt                            time.Time
+}
+
+func (b testBackup) Name() string           { return b.name }
+func (b testBackup) Namespace() string      { return b.namespace }
+func (b testBackup) DeploymentName() string { return b.deployment }
+func (b testBackup) ID() string             { return b.name }
+func (b testBackup) Type() string           { return "Hot" }
+func (b testBackup) State() string          { return "Ready" }
+func (b testBackup) Available() bool        { return true }
+func (b testBackup) Imported() bool         { return false }
+func (b testBackup) Uploaded() bool         { return false }
+func (b testBackup) RepositoryURL() string  { return "" }
+func (b testBackup) Version() string        { return b.version }
+func (b testBackup) SizeInBytes() uint64    { return b.size }
+func (b testBackup) CreatedAt() time.Time   { return b.createdAt }
+
+type testBackupOperator struct {
+	backups []Backup
+	err     error
+}
+
+func (o testBackupOperator) GetBackups() ([]Backup, error) {
+	return o.backups, o.err
+}
+
+type testOperators struct {
+	Operators
+
+	backup BackupOperator
+}
+
+func (o testOperators) BackupOperator() BackupOperator {
+	return o.backup
+}
+
+func newTestBackupContext(query string) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/backup"+query, nil)
+	return c, w
+}
+
+func newTestBackups(now time.Time) []Backup {
+	return []Backup{
+		testBackup{name: "b", namespace: "ns1", deployment: "db1", version: "3.7.10", size: 10, createdAt: now.Add(-time.Hour)},
+		testBackup{name: "a", namespace: "ns1", deployment: "db2", version: "3.8.0", size: 30, createdAt: now.Add(-3 * time.Hour)},
+		testBackup{name: "c", namespace: "ns2", deployment: "db1", version: "3.7.2", size: 20, createdAt: now.Add(-2 * time.Hour)},
+	}
+}
+
+func Test_NewBackupFilter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		query    string
+		expected backupFilter
+		err      bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "all fields",
+			query:    "?namespace=ns1&deployment=db1&version=3.7.10&minAge=1h&maxAge=2h30m",
+			expected: backupFilter{Namespace: "ns1", DeploymentName: "db1", Version: "3.7.10", MinAge: time.Hour, MaxAge: 150 * time.Minute},
+		},
+		{
+			name:  "invalid min age",
+			query: "?minAge=yesterday",
+			err:   true,
+		},
+		{
+			name:  "invalid max age",
+			query: "?maxAge=1",
+			err:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c, _ := newTestBackupContext(testCase.query)
+
+			f, err := newBackupFilter(c)
+			if testCase.err {
+				require.Error(t, err)
+				require.True(t, isBadRequest(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, f)
+		})
+	}
+}
+
+func Test_BackupFilter_Match(t *testing.T) {
+	now := time.Now()
+	backups := newTestBackups(now)
+
+	testCases := []struct {
+		name     string
+		filter   backupFilter
+		expected []string
+	}{
+		{
+			name:     "empty",
+			expected: []string{"b", "a", "c"},
+		},
+		{
+			name:     "namespace",
+			filter:   backupFilter{Namespace: "ns1"},
+			expected: []string{"b", "a"},
+		},
+		{
+			name:     "deployment",
+			filter:   backupFilter{DeploymentName: "db1"},
+			expected: []string{"b", "c"},
+		},
+		{
+			name:     "version",
+			filter:   backupFilter{Version: "3.8.0"},
+			expected: []string{"a"},
+		},
+		{
+			name:     "age",
+			filter:   backupFilter{MinAge: 90 * time.Minute, MaxAge: 150 * time.Minute},
+			expected: []string{"c"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var names []string
+			for _, b := range backups {
+				if testCase.filter.match(b, now) {
+					names = append(names, b.Name())
+				}
+			}
+
+			require.Equal(t, testCase.expected, names)
+		})
+	}
+}
+
+func Test_SortBackups(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name     string
+		by       string
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "default",
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "name",
+			by:       "name",
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "age",
+			by:       "age",
+			expected: []string{"b", "c", "a"},
+		},
+		{
+			name:     "size",
+			by:       "size",
+			expected: []string{"a", "c", "b"},
+		},
+		{
+			name:     "version",
+			by:       "version",
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name: "unknown",
+			by:   "id",
+			err:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var infos []BackupInfo
+			for _, b := range newTestBackups(now) {
+				infos = append(infos, newBackupInfo(b, now))
+			}
+
+			err := sortBackups(infos, testCase.by)
+			if testCase.err {
+				require.Error(t, err)
+				require.True(t, isBadRequest(err))
+				return
+			}
+
+			require.NoError(t, err)
+
+			var names []string
+			for _, i := range infos {
+				names = append(names, i.Name)
+			}
+
+			require.Equal(t, testCase.expected, names)
+		})
+	}
+}
+
+func Test_HandleGetBackups(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name     string
+		query    string
+		operator testBackupOperator
+		code     int
+		expected []string
+	}{
+		{
+			name:     "all backups",
+			operator: testBackupOperator{backups: newTestBackups(now)},
+			code:     http.StatusOK,
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "filtered and sorted",
+			query:    "?deployment=db1&sort=size",
+			operator: testBackupOperator{backups: newTestBackups(now)},
+			code:     http.StatusOK,
+			expected: []string{"c", "b"},
+		},
+		{
+			name:     "no backups",
+			operator: testBackupOperator{},
+			code:     http.StatusOK,
+			expected: []string{},
+		},
+		{
+			name:     "invalid filter",
+			query:    "?minAge=old",
+			operator: testBackupOperator{backups: newTestBackups(now)},
+			code:     http.StatusBadRequest,
+		},
+		{
+			name:     "invalid sort",
+			query:    "?sort=id",
+			operator: testBackupOperator{backups: newTestBackups(now)},
+			code:     http.StatusBadRequest,
+		},
+		{
+			name:     "operator error",
+			operator: testBackupOperator{err: errors.Newf("unavailable")},
+			code:     http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := &Server{
+				deps: Dependencies{
+					Operators: testOperators{backup: testCase.operator},
+				},
+			}
+
+			c, w := newTestBackupContext(testCase.query)
+			s.handleGetBackups(c)
+
+			require.Equal(t, testCase.code, w.Code)
+			if testCase.code != http.StatusOK {
+				return
+			}
+
+			var response struct {
+				Backups []BackupInfo `json:"backups"`
+			}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+			names := []string{}
+			for _, b := range response.Backups {
+				names = append(names, b.Name)
+			}
+
+			require.Equal(t, testCase.expected, names)
+		})
+	}
+}
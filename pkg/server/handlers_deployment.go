@@ -47,6 +47,8 @@ type Deployment interface {
 	DatabaseURL() string
 	DatabaseVersion() (string, string)
 	Members() map[api.ServerGroup][]Member
+	UpgradeReport(image string) (*api.DeploymentUpgradeReport, error)
+	Compatibility() *api.DeploymentCompatibilityStatus
 }
 
 // Member is the API implemented by a member of an ArangoDeployment.
@@ -209,3 +211,53 @@ func (s *Server) handleGetDeploymentDetails(c *gin.Context) {
 		}
 	}
 }
+
+// DeploymentCompatibilityInfo is the pod rendering compatibility returned per deployment.
+type DeploymentCompatibilityInfo struct {
+	Name          string                             `json:"name"`
+	Namespace     string                             `json:"namespace"`
+	Compatibility *api.DeploymentCompatibilityStatus `json:"compatibility,omitempty"`
+}
+
+// Handle a GET /api/compatibility request
+func (s *Server) handleGetDeploymentsCompatibility(c *gin.Context) {
+	if do := s.deps.Operators.DeploymentOperator(); do != nil {
+		// Fetch deployments
+		depls, err := do.GetDeployments()
+		if err != nil {
+			sendError(c, err)
+		} else {
+			result := make([]DeploymentCompatibilityInfo, len(depls))
+			for i, d := range depls {
+				result[i] = DeploymentCompatibilityInfo{
+					Name:          d.Name(),
+					Namespace:     d.Namespace(),
+					Compatibility: d.Compatibility(),
+				}
+			}
+			c.JSON(http.StatusOK, gin.H{
+				"latest_level": api.DeploymentCompatibilityLevelLatest,
+				"deployments":  result,
+			})
+		}
+	}
+}
+
+// Handle a GET /api/deployment/:name/upgrade-report request
+func (s *Server) handleGetDeploymentUpgradeReport(c *gin.Context) {
+	if do := s.deps.Operators.DeploymentOperator(); do != nil {
+		// Fetch deployment
+		depl, err := do.GetDeployment(c.Params.ByName("name"))
+		if err != nil {
+			sendError(c, err)
+			return
+		}
+
+		report, err := depl.UpgradeReport(c.Query("image"))
+		if err != nil {
+			sendError(c, err)
+		} else {
+			c.JSON(http.StatusOK, report)
+		}
+	}
+}
@@ -33,6 +33,7 @@ type DeploymentReplication interface {
 	Name() string
 	Namespace() string
 	StateColor() StateColor
+	Paused() bool
 	Source() Endpoint
 	Destination() Endpoint
 }
@@ -50,6 +51,7 @@ type DeploymentReplicationInfo struct {
 	Name        string       `json:"name"`
 	Namespace   string       `json:"namespace"`
 	StateColor  StateColor   `json:"state_color"`
+	Paused      bool         `json:"paused"`
 	Source      EndpointInfo `json:"source"`
 	Destination EndpointInfo `json:"destination"`
 }
@@ -60,6 +62,7 @@ func newDeploymentReplicationInfo(dr DeploymentReplication) DeploymentReplicatio
 		Name:        dr.Name(),
 		Namespace:   dr.Namespace(),
 		StateColor:  dr.StateColor(),
+		Paused:      dr.Paused(),
 		Source:      newEndpointInfo(dr.Source()),
 		Destination: newEndpointInfo(dr.Destination()),
 	}
@@ -80,6 +80,8 @@ type Operators interface {
 	DeploymentReplicationOperator() DeploymentReplicationOperator
 	// Return the local storage operator (if any)
 	StorageOperator() StorageOperator
+	// Return the backup operator (if any)
+	BackupOperator() BackupOperator
 	// FindOtherOperators looks up references to other operators in the same Kubernetes cluster.
 	FindOtherOperators() []OperatorReference
 }
@@ -179,6 +181,8 @@ func NewServer(cli corev1.CoreV1Interface, cfg Config, deps Dependencies) (*Serv
 		// Deployment operator
 		api.GET("/deployment", s.handleGetDeployments)
 		api.GET("/deployment/:name", s.handleGetDeploymentDetails)
+		api.GET("/deployment/:name/upgrade-report", s.handleGetDeploymentUpgradeReport)
+		api.GET("/compatibility", s.handleGetDeploymentsCompatibility)
 
 		// Deployment replication operator
 		api.GET("/deployment-replication", s.handleGetDeploymentReplications)
@@ -187,6 +191,9 @@ func NewServer(cli corev1.CoreV1Interface, cfg Config, deps Dependencies) (*Serv
 		// Local storage operator
 		api.GET("/storage", s.handleGetLocalStorages)
 		api.GET("/storage/:name", s.handleGetLocalStorageDetails)
+
+		// Backup operator
+		api.GET("/backup", s.handleGetBackups)
 	}
 	// Dashboard
 	r.GET("/", createAssetFileHandler(dashboard.Assets.Files["index.html"]))
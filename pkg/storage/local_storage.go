@@ -237,6 +237,10 @@ func (ls *LocalStorage) run() {
 				hasError = true
 				ls.createEvent(k8sutil.NewErrorEvent("PV inspection failed", err, ls.apiObject))
 			}
+			if err := ls.inspectDrain(); err != nil {
+				hasError = true
+				ls.createEvent(k8sutil.NewErrorEvent("Node drain inspection failed", err, ls.apiObject))
+			}
 			if len(unboundPVCs) == 0 {
 				pvsNeededSince = nil
 			} else if len(unboundPVCs) > 0 {
@@ -0,0 +1,176 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package storage
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/arangodb/kube-arangodb/pkg/apis/deployment"
+	api "github.com/arangodb/kube-arangodb/pkg/apis/storage/v1alpha"
+	"github.com/arangodb/kube-arangodb/pkg/util/k8sutil"
+)
+
+// getDrainNodes returns the names of all nodes which volumes should be evacuated.
+// Nodes are drained when they are annotated directly or listed in the annotation of the local storage.
+func (ls *LocalStorage) getDrainNodes() (map[string]struct{}, error) {
+	nodes := make(map[string]struct{})
+	for _, nodeName := range ls.apiObject.GetDrainNodes() {
+		nodes[nodeName] = struct{}{}
+	}
+
+	list, err := ls.deps.KubeCli.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for i := range list.Items {
+		if api.IsNodeDrained(&list.Items[i]) {
+			nodes[list.Items[i].GetName()] = struct{}{}
+		}
+	}
+
+	return nodes, nil
+}
+
+// inspectDrain evacuates PersistentVolumes from drained nodes.
+// Claims bound to volumes on drained nodes are annotated, so the deployment operator replaces
+// the members which are using them. Released and unclaimed volumes are handed over to the cleaner.
+func (ls *LocalStorage) inspectDrain() error {
+	log := ls.deps.Log
+	nodes, err := ls.getDrainNodes()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if len(nodes) == 0 && len(ls.status.Drain) == 0 {
+		return nil
+	}
+
+	list, err := ls.deps.KubeCli.CoreV1().PersistentVolumes().List(metav1.ListOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	volumes := make(map[string][]string, len(nodes))
+	for nodeName := range nodes {
+		volumes[nodeName] = nil
+	}
+
+	spec := ls.apiObject.Spec
+	for _, pv := range list.Items {
+		if pv.Spec.StorageClassName != spec.StorageClass.Name || !ls.isOwnerOf(&pv) {
+			continue
+		}
+
+		nodeName := pv.GetAnnotations()[nodeNameAnnotation]
+		if _, ok := nodes[nodeName]; !ok {
+			continue
+		}
+
+		volumes[nodeName] = append(volumes[nodeName], pv.GetName())
+
+		switch pv.Status.Phase {
+		case v1.VolumeReleased:
+			// Released volumes are cleaned by the PV inspection
+		case v1.VolumeAvailable, v1.VolumeBound:
+			if pv.Spec.ClaimRef == nil {
+				log.Debug().Str("name", pv.GetName()).Str("node", nodeName).Msg("Added PersistentVolume from drained node to cleaner")
+				ls.pvCleaner.Add(pv)
+				continue
+			}
+
+			if err := ls.markClaimForEvacuation(pv.Spec.ClaimRef); err != nil {
+				log.Warn().Err(err).Str("name", pv.GetName()).Msg("Failed to mark PersistentVolumeClaim for evacuation")
+			}
+		}
+	}
+
+	status := make(api.LocalStorageDrainStatusList, 0, len(volumes))
+	for nodeName, names := range volumes {
+		sort.Strings(names)
+		status = append(status, api.LocalStorageDrainStatus{
+			NodeName: nodeName,
+			Volumes:  names,
+			Drained:  len(names) == 0,
+		})
+	}
+	sort.Slice(status, func(i, j int) bool {
+		return status[i].NodeName < status[j].NodeName
+	})
+	if len(status) == 0 {
+		status = nil
+	}
+
+	if reflect.DeepEqual(ls.status.Drain, status) {
+		return nil
+	}
+
+	for _, s := range status {
+		if !s.Drained {
+			continue
+		}
+		if prev, ok := ls.status.Drain.Get(s.NodeName); !ok || !prev.Drained {
+			log.Info().Str("node", s.NodeName).Msg("All PersistentVolumes evacuated from node")
+		}
+	}
+
+	ls.status.Drain = status
+	return ls.updateCRStatus()
+}
+
+// markClaimForEvacuation annotates the given claim, so the owning deployment moves its data to a different volume.
+func (ls *LocalStorage) markClaimForEvacuation(ref *v1.ObjectReference) error {
+	pvcs := ls.deps.KubeCli.CoreV1().PersistentVolumeClaims(ref.Namespace)
+
+	pvc, err := pvcs.Get(ref.Name, metav1.GetOptions{})
+	if err != nil {
+		if k8sutil.IsNotFound(err) {
+			return nil
+		}
+		return errors.WithStack(err)
+	}
+
+	if ref.UID != "" && pvc.GetUID() != ref.UID {
+		// Claim has been recreated in the meantime
+		return nil
+	}
+
+	if _, ok := pvc.GetAnnotations()[deployment.ArangoDeploymentPVCEvacuateAnnotation]; ok {
+		return nil
+	}
+
+	if pvc.Annotations == nil {
+		pvc.Annotations = map[string]string{}
+	}
+	pvc.Annotations[deployment.ArangoDeploymentPVCEvacuateAnnotation] = "true"
+
+	if _, err := pvcs.Update(pvc); err != nil {
+		return errors.WithStack(err)
+	}
+
+	ls.deps.Log.Info().Str("pvc-name", pvc.GetName()).Str("namespace", pvc.GetNamespace()).Msg("Marked PersistentVolumeClaim for evacuation")
+	return nil
+}
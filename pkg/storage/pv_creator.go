@@ -72,12 +72,30 @@ func (ls *LocalStorage) createPVs(ctx context.Context, apiObject *api.ArangoLoca
 		// No provisioners available
 		return errors.WithStack(errors.Newf("No ready provisioner endpoints found"))
 	}
+
+	var nodeClientMap map[string]provisioner.API
+	if drainNodes, err := ls.getDrainNodes(); err != nil {
+		return errors.WithStack(err)
+	} else if len(drainNodes) > 0 {
+		// Do not create new volumes on drained nodes
+		nodeClientMap = createNodeClientMap(ctx, clients)
+		clients = make([]provisioner.API, 0, len(nodeClientMap))
+		for nodeName, c := range nodeClientMap {
+			if _, drained := drainNodes[nodeName]; drained {
+				delete(nodeClientMap, nodeName)
+				continue
+			}
+			clients = append(clients, c)
+		}
+		if len(clients) == 0 {
+			return errors.WithStack(errors.Newf("No ready provisioner endpoints found on nodes which are not drained"))
+		}
+	}
 	// Randomize list
 	rand.Shuffle(len(clients), func(i, j int) {
 		clients[i], clients[j] = clients[j], clients[i]
 	})
 
-	var nodeClientMap map[string]provisioner.API
 	for i, claim := range unboundClaims {
 		// Find deployment name & role in the claim (if any)
 		deplName, role, enforceAniAffinity := getDeploymentInfo(claim)
@@ -205,6 +205,9 @@ func createArangodClientForDNSName(ctx context.Context, cli corev1.CoreV1Interfa
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	if err := addArangodClientCertificate(cli, apiObject, &connConfig); err != nil {
+		return nil, errors.WithStack(err)
+	}
 	// TODO deal with TLS with proper CA checking
 	conn, err := http.NewConnection(connConfig)
 	if err != nil {
@@ -251,6 +254,36 @@ func createArangodHTTPConfigForDNSNames(ctx context.Context, apiObject *api.Aran
 	return connConfig, nil
 }
 
+// addArangodClientCertificate adds the operator client certificate to the connection config
+// when client certificate authentication is enabled in the given deployment.
+func addArangodClientCertificate(cli corev1.CoreV1Interface, apiObject *api.ArangoDeployment, connConfig *http.ConnectionConfig) error {
+	if cli == nil || apiObject == nil || !apiObject.Spec.IsSecure() || !apiObject.Spec.TLS.ClientAuth.IsEnabled() {
+		return nil
+	}
+
+	transport, ok := connConfig.Transport.(*nhttp.Transport)
+	if !ok {
+		return nil
+	}
+
+	keyfile, err := k8sutil.GetTLSKeyfileSecret(cli.Secrets(apiObject.GetNamespace()), k8sutil.CreateTLSClientKeyfileSecretName(apiObject.GetName()))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	cert, err := tls.X509KeyPair([]byte(keyfile), []byte(keyfile))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Shared transports must not be modified
+	transport = transport.Clone()
+	transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	connConfig.Transport = transport
+
+	return nil
+}
+
 // createArangodClientAuthentication creates a go-driver authentication for the servers in the given deployment.
 func createArangodClientAuthentication(ctx context.Context, cli corev1.CoreV1Interface, apiObject *api.ArangoDeployment) (driver.Authentication, error) {
 	if apiObject != nil && apiObject.Spec.IsAuthenticated() {
@@ -0,0 +1,167 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package accesspackage
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/constants"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+// Bundle holds everything needed to reach and authenticate at the syncmasters of a deployment.
+type Bundle struct {
+	// MasterEndpoint holds the URLs of the syncmasters
+	MasterEndpoint []string
+	// CACertificate holds the PEM encoded CA certificate of the syncmaster TLS certificates
+	CACertificate string
+	// Keyfile holds the client authentication certificate & key
+	Keyfile string
+	// ClientCACertificate holds the PEM encoded CA certificate which issued the client authentication certificate
+	// and which signs the bundle.
+	// It is informational only, bundles are verified against a CA certificate obtained independently.
+	ClientCACertificate string
+}
+
+// payload returns the data covered by the signature.
+func (b Bundle) payload() []byte {
+	return []byte(strings.Join([]string{
+		strings.Join(b.MasterEndpoint, "\n"),
+		strings.TrimSpace(b.CACertificate),
+		strings.TrimSpace(b.Keyfile),
+		strings.TrimSpace(b.ClientCACertificate),
+	}, "\n---\n"))
+}
+
+// Sign returns the signature of the bundle, created with the given client authentication CA private key.
+func (b Bundle) Sign(caKey crypto.Signer) ([]byte, error) {
+	digest := sha256.Sum256(b.payload())
+	sig, err := caKey.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return sig, nil
+}
+
+// Verify checks that the given signature is created by the given trusted client authentication CA
+// and that the client authentication certificate is issued by that CA.
+func (b Bundle) Verify(signature []byte, trustedCACertificate string) error {
+	ca, err := parseCertificate(trustedCACertificate)
+	if err != nil {
+		return errors.Wrapf(err, "Invalid trusted client authentication CA certificate")
+	}
+
+	var algorithm x509.SignatureAlgorithm
+	switch ca.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		algorithm = x509.ECDSAWithSHA256
+	case *rsa.PublicKey:
+		algorithm = x509.SHA256WithRSA
+	default:
+		return errors.Newf("Unsupported public key type of client authentication CA")
+	}
+
+	if err := ca.CheckSignature(algorithm, b.payload(), signature); err != nil {
+		return errors.Wrapf(err, "Invalid access package signature")
+	}
+
+	cert, err := parseCertificate(b.Keyfile)
+	if err != nil {
+		return errors.Wrapf(err, "Invalid client authentication keyfile")
+	}
+	if err := cert.CheckSignatureFrom(ca); err != nil {
+		return errors.Wrapf(err, "Client authentication certificate is not issued by the client authentication CA")
+	}
+	return nil
+}
+
+// ToSecretData returns the bundle with its signature as Secret data.
+func (b Bundle) ToSecretData(signature []byte) map[string][]byte {
+	return map[string][]byte{
+		constants.SecretAccessPackageMasterEndpoint:      []byte(strings.Join(b.MasterEndpoint, "\n")),
+		constants.SecretCACertificate:                    []byte(b.CACertificate),
+		constants.SecretTLSKeyfile:                       []byte(b.Keyfile),
+		constants.SecretAccessPackageClientCACertificate: []byte(b.ClientCACertificate),
+		constants.SecretAccessPackageSignature:           signature,
+	}
+}
+
+// IsSigned returns true when the given Secret data contains a signed bundle.
+func IsSigned(data map[string][]byte) bool {
+	_, ok := data[constants.SecretAccessPackageSignature]
+	return ok
+}
+
+// FromSecretData loads the bundle from the given Secret data and verifies its signature
+// against the given trusted client authentication CA certificate.
+func FromSecretData(data map[string][]byte, trustedCACertificate string) (Bundle, error) {
+	signature, ok := data[constants.SecretAccessPackageSignature]
+	if !ok {
+		return Bundle{}, errors.Newf("Access package is not signed")
+	}
+
+	var endpoints []string
+	for _, ep := range strings.Split(string(data[constants.SecretAccessPackageMasterEndpoint]), "\n") {
+		if ep = strings.TrimSpace(ep); ep != "" {
+			endpoints = append(endpoints, ep)
+		}
+	}
+	if len(endpoints) == 0 {
+		return Bundle{}, errors.Newf("Access package contains no master endpoint")
+	}
+
+	b := Bundle{
+		MasterEndpoint:      endpoints,
+		CACertificate:       string(data[constants.SecretCACertificate]),
+		Keyfile:             string(data[constants.SecretTLSKeyfile]),
+		ClientCACertificate: string(data[constants.SecretAccessPackageClientCACertificate]),
+	}
+	if err := b.Verify(signature, trustedCACertificate); err != nil {
+		return Bundle{}, errors.WithStack(err)
+	}
+	return b, nil
+}
+
+// parseCertificate parses the first certificate in the given PEM data.
+func parseCertificate(data string) (*x509.Certificate, error) {
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, errors.Newf("No certificate found")
+		}
+		if block.Type == "CERTIFICATE" {
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			return cert, nil
+		}
+	}
+}
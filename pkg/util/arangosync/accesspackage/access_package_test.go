@@ -0,0 +1,118 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package accesspackage
+
+import (
+	"crypto"
+	"strings"
+	"testing"
+	"time"
+
+	certificates "github.com/arangodb-helper/go-certificates"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestCA(t *testing.T) (string, certificates.CA) {
+	cert, key, err := certificates.CreateCertificate(certificates.CreateCertificateOptions{
+		CommonName:   "Test Client Authentication Root Certificate",
+		ValidFor:     time.Hour,
+		IsCA:         true,
+		IsClientAuth: true,
+		ECDSACurve:   "P256",
+	}, nil)
+	require.NoError(t, err)
+
+	ca, err := certificates.LoadCAFromPEM(cert, key)
+	require.NoError(t, err)
+	return cert, ca
+}
+
+func createTestBundle(t *testing.T, caCert string, ca certificates.CA) Bundle {
+	cert, key, err := certificates.CreateCertificate(certificates.CreateCertificateOptions{
+		ValidFor:     time.Hour,
+		IsClientAuth: true,
+		ECDSACurve:   "P256",
+	}, &ca)
+	require.NoError(t, err)
+
+	return Bundle{
+		MasterEndpoint:      []string{"https://dc1.example.com:8629"},
+		CACertificate:       caCert,
+		Keyfile:             strings.TrimSpace(cert) + "\n" + strings.TrimSpace(key),
+		ClientCACertificate: caCert,
+	}
+}
+
+func TestBundleSignVerify(t *testing.T) {
+	caCert, ca := createTestCA(t)
+	b := createTestBundle(t, caCert, ca)
+
+	signature, err := b.Sign(ca.PrivateKey.(crypto.Signer))
+	require.NoError(t, err)
+
+	data := b.ToSecretData(signature)
+	require.True(t, IsSigned(data))
+
+	loaded, err := FromSecretData(data, caCert)
+	require.NoError(t, err)
+	require.Equal(t, b.MasterEndpoint, loaded.MasterEndpoint)
+	require.Equal(t, b.Keyfile, loaded.Keyfile)
+
+	// Tampered endpoint
+	data["masterEndpoint"] = []byte("https://attacker.example.com:8629")
+	_, err = FromSecretData(data, caCert)
+	require.Error(t, err)
+
+	// Not signed
+	delete(data, "signature")
+	require.False(t, IsSigned(data))
+	_, err = FromSecretData(data, caCert)
+	require.Error(t, err)
+}
+
+func TestBundleVerifyForgedBundle(t *testing.T) {
+	caCert, _ := createTestCA(t)
+	forgedCACert, forgedCA := createTestCA(t)
+
+	// Bundle which is consistent in itself, but created with another CA than the trusted one
+	b := createTestBundle(t, forgedCACert, forgedCA)
+	signature, err := b.Sign(forgedCA.PrivateKey.(crypto.Signer))
+	require.NoError(t, err)
+
+	require.NoError(t, b.Verify(signature, forgedCACert))
+	require.Error(t, b.Verify(signature, caCert))
+
+	_, err = FromSecretData(b.ToSecretData(signature), caCert)
+	require.Error(t, err)
+}
+
+func TestBundleVerifyForeignKeyfile(t *testing.T) {
+	caCert, ca := createTestCA(t)
+	otherCACert, otherCA := createTestCA(t)
+
+	// Keyfile issued by another CA, signed by the bundle CA
+	b := createTestBundle(t, otherCACert, otherCA)
+	b.ClientCACertificate = caCert
+
+	signature, err := b.Sign(ca.PrivateKey.(crypto.Signer))
+	require.NoError(t, err)
+	require.Error(t, b.Verify(signature, caCert))
+}
@@ -36,15 +36,22 @@ const (
 	SecretEncryptionKey = "key"   // Key in a Secret.Data used to store an 32-byte encryption key
 	SecretKeyToken      = "token" // Key inside a Secret used to hold a JWT or monitoring token
 
+	SecretEncryptionWrappedKey = "wrappedKey" // Key in a Secret.Data used to store an encryption key wrapped by the KMS
+	SecretEncryptionKeyID      = "keyID"      // Key in a Secret.Data used to store the ID of the wrapped encryption key
+
 	SecretCACertificate = "ca.crt" // Key in Secret.data used to store a PEM encoded CA certificate (public key)
 	SecretCAKey         = "ca.key" // Key in Secret.data used to store a PEM encoded CA private key
 
 	SecretTLSKeyfile = "tls.keyfile" // Key in Secret.data used to store a PEM encoded TLS certificate in the format used by ArangoDB (`--ssl.keyfile`)
+	SecretTLSKey     = "tls.key"     // Key in Secret.data used to store a PEM encoded TLS private key of a pending certificate request
 
 	SecretUsername = "username" // Key in Secret.data used to store a username used for basic authentication
 	SecretPassword = "password" // Key in Secret.data used to store a password used for basic authentication
 
-	SecretAccessPackageYaml = "accessPackage.yaml" // Key in Secret.data used to store a YAML encoded access package
+	SecretAccessPackageYaml                = "accessPackage.yaml" // Key in Secret.data used to store a YAML encoded access package
+	SecretAccessPackageMasterEndpoint      = "masterEndpoint"     // Key in Secret.data used to store the newline separated syncmaster endpoints of an access package
+	SecretAccessPackageClientCACertificate = "clientAuthCA.crt"   // Key in Secret.data used to store the PEM encoded client authentication CA certificate of an access package
+	SecretAccessPackageSignature           = "signature"          // Key in Secret.data used to store the signature of an access package
 
 	FinalizerDeplRemoveChildFinalizers = "database.arangodb.com/remove-child-finalizers" // Finalizer added to ArangoDeployment, indicating the need to remove finalizers from all children
 	FinalizerDeplReplStopSync          = "replication.database.arangodb.com/stop-sync"   // Finalizer added to ArangoDeploymentReplication, indicating the need to stop synchronization
@@ -25,6 +25,7 @@ package k8sutil
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	driver "github.com/arangodb/go-driver"
 	upgraderules "github.com/arangodb/go-upgrade-rules"
@@ -33,12 +34,27 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// EventNotification defines the type of the notification sent for an event.
+// Events without notification type are only recorded in Kubernetes.
+type EventNotification string
+
+const (
+	EventNotificationNone                 EventNotification = ""
+	EventNotificationDeploymentPhase      EventNotification = "DeploymentPhaseChanged"
+	EventNotificationMemberFailed         EventNotification = "MemberFailed"
+	EventNotificationUpgradeStarted       EventNotification = "UpgradeStarted"
+	EventNotificationUpgradeFinished      EventNotification = "UpgradeFinished"
+	EventNotificationBackupFailed         EventNotification = "BackupFailed"
+	EventNotificationReplicationUnhealthy EventNotification = "ReplicationUnhealthy"
+)
+
 // Event is used to create events using an EventRecorder.
 type Event struct {
 	InvolvedObject runtime.Object
 	Type           string
 	Reason         string
 	Message        string
+	Notification   EventNotification
 }
 
 // APIObject helps to abstract an object from our custom API.
@@ -200,6 +216,25 @@ func NewCannotChangeStorageClassEvent(apiObject APIObject, memberID, role, subRe
 	return event
 }
 
+// NewCannotEvacuateVolumeEvent creates an event indicating that an item would need to move its volume out of
+// a drained node, but this is not possible for the given reason.
+func NewCannotEvacuateVolumeEvent(apiObject APIObject, memberID, role, subReason string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeNormal
+	event.Reason = fmt.Sprintf("%s Member Volume Cannot Evacuate", strings.Title(role))
+	event.Message = fmt.Sprintf("Member %s with role %s should move its volume out of a drained node, but is cannot because: %s", memberID, role, subReason)
+	return event
+}
+
+// NewCertificateExpiryEvent creates an event indicating that a TLS certificate is about to expire.
+func NewCertificateExpiryEvent(apiObject APIObject, certType, secretName string, notAfter time.Time) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeWarning
+	event.Reason = fmt.Sprintf("%s Certificate Expiring", certType)
+	event.Message = fmt.Sprintf("%s certificate in secret %s expires at %s (in %s)", certType, secretName, notAfter.UTC().Format(time.RFC3339), time.Until(notAfter).Round(time.Minute))
+	return event
+}
+
 // NewDowntimeNotAllowedEvent creates an event indicating that an operation cannot be executed because downtime
 // is currently not allowed.
 func NewDowntimeNotAllowedEvent(apiObject APIObject, operation string) *Event {
@@ -258,6 +293,105 @@ func NewUpgradeNotAllowedEvent(apiObject APIObject,
 	return event
 }
 
+// NewUpgradeRolledBackEvent creates an event indicating that a failed upgrade of the deployment
+// was rolled back to the previous image.
+func NewUpgradeRolledBackEvent(apiObject APIObject, fromImage, toImage, message string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeWarning
+	event.Reason = "Upgrade Rolled Back"
+	event.Message = fmt.Sprintf("Upgrade from image %s to image %s was rolled back: %s", fromImage, toImage, message)
+	return event
+}
+
+// NewSwitchoverEvent creates an event indicating that a switchover of a deployment replication
+// has entered the given phase.
+func NewSwitchoverEvent(apiObject APIObject, mode, phase, message string, failed bool) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeNormal
+	if failed {
+		event.Type = v1.EventTypeWarning
+	}
+	event.Reason = fmt.Sprintf("%s Switchover %s", mode, phase)
+	event.Message = message
+	return event
+}
+
+// NewCloneEvent creates an event indicating that the clone of a deployment
+// from a backup has entered the given phase.
+func NewCloneEvent(apiObject APIObject, phase, message string, failed bool) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeNormal
+	if failed {
+		event.Type = v1.EventTypeWarning
+	}
+	event.Reason = fmt.Sprintf("Clone %s", phase)
+	event.Message = message
+	return event
+}
+
+// NewDeploymentPhaseChangedEvent creates an event indicating that the phase of the deployment has changed.
+func NewDeploymentPhaseChangedEvent(apiObject APIObject, from, to string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeNormal
+	if to == "Failed" {
+		event.Type = v1.EventTypeWarning
+	}
+	event.Reason = "Deployment Phase Changed"
+	event.Message = fmt.Sprintf("Deployment phase changed from %s to %s", phaseOrNone(from), to)
+	event.Notification = EventNotificationDeploymentPhase
+	return event
+}
+
+// NewMemberFailedEvent creates an event indicating that a member has been marked as failed.
+func NewMemberFailedEvent(apiObject APIObject, memberID, role string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeWarning
+	event.Reason = fmt.Sprintf("%s Failed", strings.Title(role))
+	event.Message = fmt.Sprintf("Member %s of group %s has been marked as failed", memberID, role)
+	event.Notification = EventNotificationMemberFailed
+	return event
+}
+
+// NewUpgradeStartedEvent creates an event indicating that the upgrade of the deployment has started.
+func NewUpgradeStartedEvent(apiObject APIObject, fromImage, toImage string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeNormal
+	event.Reason = "Upgrade Started"
+	event.Message = fmt.Sprintf("Upgrade from image %s to image %s started", fromImage, toImage)
+	event.Notification = EventNotificationUpgradeStarted
+	return event
+}
+
+// NewUpgradeFinishedEvent creates an event indicating that all members of the deployment run the new image.
+func NewUpgradeFinishedEvent(apiObject APIObject, fromImage, toImage string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeNormal
+	event.Reason = "Upgrade Finished"
+	event.Message = fmt.Sprintf("Upgrade from image %s to image %s finished", fromImage, toImage)
+	event.Notification = EventNotificationUpgradeFinished
+	return event
+}
+
+// NewBackupFailedEvent creates an event indicating that the backup has moved into a failed state.
+func NewBackupFailedEvent(apiObject APIObject, state, message string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeWarning
+	event.Reason = fmt.Sprintf("Backup %s", state)
+	event.Message = message
+	event.Notification = EventNotificationBackupFailed
+	return event
+}
+
+// NewReplicationUnhealthyEvent creates an event indicating that the deployment replication is not healthy.
+func NewReplicationUnhealthyEvent(apiObject APIObject, reason, message string) *Event {
+	event := newDeploymentEvent(apiObject)
+	event.Type = v1.EventTypeWarning
+	event.Reason = fmt.Sprintf("Replication %s", reason)
+	event.Message = message
+	event.Notification = EventNotificationReplicationUnhealthy
+	return event
+}
+
 // NewErrorEvent creates an even of type error.
 func NewErrorEvent(reason string, err error, apiObject APIObject) *Event {
 	event := newDeploymentEvent(apiObject)
@@ -267,6 +401,14 @@ func NewErrorEvent(reason string, err error, apiObject APIObject) *Event {
 	return event
 }
 
+// phaseOrNone returns the given phase or "None" if it is empty.
+func phaseOrNone(phase string) string {
+	if phase == "" {
+		return "None"
+	}
+	return phase
+}
+
 // newDeploymentEvent creates a new event for the given api object & owner.
 func newDeploymentEvent(apiObject runtime.Object) *Event {
 	return &Event{
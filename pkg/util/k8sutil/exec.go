@@ -0,0 +1,145 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package k8sutil
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// PodExecutor runs commands in containers of running pods
+type PodExecutor interface {
+	// Exec runs command in the container and returns its standard output.
+	// Error is returned when command fails or does not finish within the timeout.
+	Exec(namespace, pod, container string, command []string, timeout time.Duration) (string, error)
+}
+
+// NewPodExecutor creates PodExecutor which uses exec subresource of the pods
+func NewPodExecutor(config *rest.Config, kubeCli kubernetes.Interface) PodExecutor {
+	return &podExecutor{
+		config:  config,
+		kubeCli: kubeCli,
+	}
+}
+
+type podExecutor struct {
+	config  *rest.Config
+	kubeCli kubernetes.Interface
+}
+
+func (p *podExecutor) Exec(namespace, pod, container string, command []string, timeout time.Duration) (string, error) {
+	req := p.kubeCli.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(pod).
+		Namespace(namespace).
+		SubResource("exec").
+		VersionedParams(&core.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	transport, upgrader, err := spdy.RoundTripperFor(p.config)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	conn := &closableUpgrader{Upgrader: upgrader}
+
+	exec, err := remotecommand.NewSPDYExecutorForTransports(transport, conn, "POST", req.URL())
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	done := make(chan error, 1)
+	go func() {
+		done <- exec.Stream(remotecommand.StreamOptions{
+			Stdout: &stdout,
+			Stderr: &stderr,
+		})
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return "", errors.Wrapf(err, "command failed: %s", strings.TrimSpace(stderr.String()))
+		}
+		return stdout.String(), nil
+	case <-time.After(timeout):
+		// Closing the connection ends the stream, so the goroutine does not outlive the call
+		conn.Close()
+		return "", errors.Newf("command did not finish within %s", timeout)
+	}
+}
+
+// closableUpgrader keeps the connection created for the stream, so it can be closed from outside
+type closableUpgrader struct {
+	spdy.Upgrader
+
+	lock   sync.Mutex
+	conn   httpstream.Connection
+	closed bool
+}
+
+func (c *closableUpgrader) NewConnection(resp *http.Response) (httpstream.Connection, error) {
+	conn, err := c.Upgrader.NewConnection(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.closed {
+		conn.Close()
+		return nil, errors.Newf("connection closed")
+	}
+
+	c.conn = conn
+	return conn, nil
+}
+
+// Close closes the connection if it is already created, or prevents it from being created
+func (c *closableUpgrader) Close() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.closed = true
+	if c.conn != nil {
+		c.conn.Close()
+	}
+}
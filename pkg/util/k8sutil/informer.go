@@ -24,6 +24,7 @@ package k8sutil
 
 import (
 	"github.com/rs/zerolog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
@@ -39,11 +40,23 @@ type ResourceWatcher struct {
 // If wraps the given handler functions, such that panics are caught and logged.
 func NewResourceWatcher(log zerolog.Logger, getter cache.Getter, resource, namespace string,
 	objType runtime.Object, h cache.ResourceEventHandlerFuncs) *ResourceWatcher {
-	source := cache.NewListWatchFromClient(
+	return NewFilteredResourceWatcher(log, getter, resource, namespace, objType, h, nil)
+}
+
+// NewFilteredResourceWatcher creates a helper that watches for changes in a resource of a specific type.
+// List and watch requests can be narrowed down (e.g. with label selector) by the given modifier.
+func NewFilteredResourceWatcher(log zerolog.Logger, getter cache.Getter, resource, namespace string,
+	objType runtime.Object, h cache.ResourceEventHandlerFuncs, optionsModifier func(options *metav1.ListOptions)) *ResourceWatcher {
+	source := cache.NewFilteredListWatchFromClient(
 		getter,
 		resource,
 		namespace,
-		fields.Everything())
+		func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.Everything().String()
+			if optionsModifier != nil {
+				optionsModifier(options)
+			}
+		})
 
 	_, informer := cache.NewIndexerInformer(source, objType, 0, cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
@@ -0,0 +1,38 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package arangoclientcertificate
+
+import api "github.com/arangodb/kube-arangodb/pkg/apis/deployment/v1"
+
+type Inspector interface {
+	ArangoClientCertificate(name string) (*api.ArangoClientCertificate, bool)
+	IterateArangoClientCertificates(action Action, filters ...Filter) error
+}
+
+type Filter func(cert *api.ArangoClientCertificate) bool
+type Action func(cert *api.ArangoClientCertificate) error
+
+// FilterByDeploymentName returns a filter which selects client certificates of the deployment with the given name.
+func FilterByDeploymentName(name string) Filter {
+	return func(cert *api.ArangoClientCertificate) bool {
+		return cert.Spec.DeploymentName == name
+	}
+}
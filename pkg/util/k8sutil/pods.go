@@ -48,6 +48,8 @@ const (
 	ArangodVolumeName               = "arangod-data"
 	TlsKeyfileVolumeName            = "tls-keyfile"
 	ClientAuthCAVolumeName          = "client-auth-ca"
+	TLSClientCAVolumeName           = "tls-client-ca"
+	TLSClientKeyfileVolumeName      = "tls-client-keyfile"
 	ClusterJWTSecretVolumeName      = "cluster-jwt"
 	MasterJWTSecretVolumeName       = "master-jwt"
 	RocksdbEncryptionVolumeName     = "rocksdb-encryption"
@@ -57,6 +59,8 @@ const (
 	TLSKeyfileVolumeMountDir        = "/secrets/tls"
 	TLSSNIKeyfileVolumeMountDir     = "/secrets/sni"
 	ClientAuthCAVolumeMountDir      = "/secrets/client-auth/ca"
+	TLSClientCAVolumeMountDir       = "/secrets/tls-client/ca"
+	TLSClientKeyfileVolumeMountDir  = "/secrets/tls-client/keyfile"
 	ClusterJWTSecretVolumeMountDir  = "/secrets/cluster/jwt"
 	ExporterJWTVolumeMountDir       = "/secrets/exporter/jwt"
 	MasterJWTSecretVolumeMountDir   = "/secrets/master/jwt"
@@ -197,11 +201,28 @@ func CreateTLSKeyfileSecretName(deploymentName, role, id string) string {
 	return AppendTLSKeyfileSecretPostfix(CreatePodName(deploymentName, role, id, ""))
 }
 
+// CreateTLSClientTruststoreSecretName returns the name of the Secret with CA certificates used
+// by servers to verify client certificates.
+func CreateTLSClientTruststoreSecretName(deploymentName string) string {
+	return fmt.Sprintf("%s-client-truststore", deploymentName)
+}
+
+// CreateTLSClientKeyfileSecretName returns the name of the Secret with client certificate
+// used by the operator to connect to servers which verify client certificates.
+func CreateTLSClientKeyfileSecretName(deploymentName string) string {
+	return fmt.Sprintf("%s-client-operator", deploymentName)
+}
+
 // AppendTLSKeyfileSecretPostfix returns the name of the Secret extended with TLS keyfile postfix.
 func AppendTLSKeyfileSecretPostfix(name string) string {
 	return fmt.Sprintf("%s-tls-keyfile", name)
 }
 
+// AppendTLSRequestSecretPostfix returns the name of the Secret extended with TLS certificate request postfix.
+func AppendTLSRequestSecretPostfix(name string) string {
+	return fmt.Sprintf("%s-tls-request", name)
+}
+
 // ArangodVolumeMount creates a volume mount structure for arangod.
 func ArangodVolumeMount() core.VolumeMount {
 	return core.VolumeMount{
@@ -227,6 +248,24 @@ func ClientAuthCACertificateVolumeMount() core.VolumeMount {
 	}
 }
 
+// TLSClientCAVolumeMount creates a volume mount structure for CA certificates which verify client certificates (ca.crt).
+func TLSClientCAVolumeMount() core.VolumeMount {
+	return core.VolumeMount{
+		Name:      TLSClientCAVolumeName,
+		MountPath: TLSClientCAVolumeMountDir,
+		ReadOnly:  true,
+	}
+}
+
+// TLSClientKeyfileVolumeMount creates a volume mount structure for a client certificate keyfile (tls.keyfile).
+func TLSClientKeyfileVolumeMount() core.VolumeMount {
+	return core.VolumeMount{
+		Name:      TLSClientKeyfileVolumeName,
+		MountPath: TLSClientKeyfileVolumeMountDir,
+		ReadOnly:  true,
+	}
+}
+
 // MasterJWTVolumeMount creates a volume mount structure for a master JWT secret (token).
 func MasterJWTVolumeMount() core.VolumeMount {
 	return core.VolumeMount{
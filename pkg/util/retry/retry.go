@@ -68,8 +68,8 @@ func isPermanent(err error) (*permanentError, bool) {
 }
 
 // retry the given operation until it succeeds,
-// has a permanent failure or times out.
-func retry(ctx context.Context, op func() error, timeout time.Duration) error {
+// has a permanent failure, times out or reaches the given number of attempts (unlimited if 0).
+func retry(ctx context.Context, op func() error, timeout time.Duration, attempts int) error {
 	var failure error
 	wrappedOp := func() error {
 		if err := op(); err == nil {
@@ -89,11 +89,12 @@ func retry(ctx context.Context, op func() error, timeout time.Duration) error {
 	eb.MaxElapsedTime = timeout
 	eb.MaxInterval = timeout / 3
 
-	var b backoff.BackOff
+	var b backoff.BackOff = eb
+	if attempts > 0 {
+		b = backoff.WithMaxRetries(b, uint64(attempts-1))
+	}
 	if ctx != nil {
-		b = backoff.WithContext(eb, ctx)
-	} else {
-		b = eb
+		b = backoff.WithContext(b, ctx)
 	}
 
 	if err := backoff.Retry(wrappedOp, b); err != nil {
@@ -108,7 +109,13 @@ func retry(ctx context.Context, op func() error, timeout time.Duration) error {
 // Retry the given operation until it succeeds,
 // has a permanent failure or times out.
 func Retry(op func() error, timeout time.Duration) error {
-	return retry(nil, op, timeout)
+	return retry(nil, op, timeout, 0)
+}
+
+// RetryAttempts retries the given operation until it succeeds,
+// has a permanent failure, times out or was called the given number of times.
+func RetryAttempts(op func() error, timeout time.Duration, attempts int) error {
+	return retry(nil, op, timeout, attempts)
 }
 
 // RetryWithContext retries the given operation until it succeeds,
@@ -138,7 +145,7 @@ func RetryWithContext(ctx context.Context, op func(ctx context.Context) error, t
 		}
 		return nil
 	}
-	if err := retry(ctx, ctxOp, timeout); err != nil {
+	if err := retry(ctx, ctxOp, timeout, 0); err != nil {
 		return errors.WithStack(err)
 	}
 	return nil
@@ -0,0 +1,294 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/arangodb/kube-arangodb/pkg/metrics"
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+var (
+	spansExported = metrics.MustRegisterCounter("tracing", "spans_exported", "Number of spans exported to the OTLP collector")
+	spansDropped  = metrics.MustRegisterCounter("tracing", "spans_dropped", "Number of spans dropped because the queue was full or the export failed")
+)
+
+const (
+	// DefaultServiceName is the name of the service reported with the spans
+	DefaultServiceName = "kube-arangodb"
+
+	otlpTracesPath     = "/v1/traces"
+	otlpRequestTimeout = time.Second * 10
+	otlpStatusError    = 2
+
+	exportQueueSize     = 2048
+	exportBatchSize     = 512
+	exportFlushInterval = time.Second * 5
+)
+
+// Exporter sends finished spans to the tracing backend
+type Exporter interface {
+	// Export queues the span. It must not block.
+	Export(s SpanData)
+	// Close flushes queued spans and stops the exporter
+	Close()
+}
+
+// NewOTLPExporter creates an exporter which sends spans in batches to the OTLP/HTTP collector using JSON encoding.
+// Traces path is added to the endpoint if missing.
+func NewOTLPExporter(endpoint, serviceName string, client *http.Client) (Exporter, error) {
+	if endpoint == "" {
+		return nil, errors.Newf("OTLP endpoint is required")
+	}
+
+	if !strings.HasSuffix(endpoint, otlpTracesPath) {
+		endpoint = strings.TrimSuffix(endpoint, "/") + otlpTracesPath
+	}
+
+	if serviceName == "" {
+		serviceName = DefaultServiceName
+	}
+
+	if client == nil {
+		client = &http.Client{Timeout: otlpRequestTimeout}
+	}
+
+	e := &otlpExporter{
+		endpoint:    endpoint,
+		serviceName: serviceName,
+		client:      client,
+		queue:       make(chan SpanData, exportQueueSize),
+		done:        make(chan struct{}),
+	}
+
+	go e.run()
+
+	return e, nil
+}
+
+type otlpExporter struct {
+	endpoint, serviceName string
+	client                *http.Client
+
+	queue chan SpanData
+	done  chan struct{}
+}
+
+func (e *otlpExporter) Export(s SpanData) {
+	select {
+	case e.queue <- s:
+	default:
+		spansDropped.Inc()
+	}
+}
+
+func (e *otlpExporter) Close() {
+	close(e.queue)
+	<-e.done
+}
+
+func (e *otlpExporter) run() {
+	defer close(e.done)
+
+	ticker := time.NewTicker(exportFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]SpanData, 0, exportBatchSize)
+
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+
+		if err := e.send(batch); err != nil {
+			spansDropped.Add(float64(len(batch)))
+			log.Warn().Err(err).Int("spans", len(batch)).Msg("Unable to export spans")
+		} else {
+			spansExported.Add(float64(len(batch)))
+		}
+
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case s, ok := <-e.queue:
+			if !ok {
+				flush()
+				return
+			}
+
+			batch = append(batch, s)
+			if len(batch) >= exportBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+func (e *otlpExporter) send(spans []SpanData) error {
+	data, err := json.Marshal(newOTLPRequest(e.serviceName, spans))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	resp, err := e.client.Post(e.endpoint, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	_, _ = ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Newf("unexpected OTLP collector response code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// OTLP/HTTP JSON encoding of the ExportTraceServiceRequest
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              Kind            `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            *otlpStatus     `json:"status,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+}
+
+func newOTLPRequest(serviceName string, spans []SpanData) otlpRequest {
+	out := make([]otlpSpan, len(spans))
+	for id, s := range spans {
+		out[id] = otlpSpan{
+			TraceID:           s.TraceID.String(),
+			SpanID:            s.SpanID.String(),
+			Name:              s.Name,
+			Kind:              s.Kind,
+			StartTimeUnixNano: strconv.FormatInt(s.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.End.UnixNano(), 10),
+			Attributes:        newOTLPAttributes(s.Attributes...),
+		}
+
+		if s.ParentID.IsValid() {
+			out[id].ParentSpanID = s.ParentID.String()
+		}
+
+		if s.Error != "" {
+			out[id].Status = &otlpStatus{Code: otlpStatusError, Message: s.Error}
+		}
+	}
+
+	return otlpRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: newOTLPAttributes(String("service.name", serviceName)),
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: DefaultServiceName},
+						Spans: out,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newOTLPAttributes(attrs ...Attribute) []otlpAttribute {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	out := make([]otlpAttribute, len(attrs))
+	for id, a := range attrs {
+		out[id].Key = a.Key
+
+		switch v := a.Value.(type) {
+		case string:
+			out[id].Value.StringValue = &v
+		case int64:
+			i := strconv.FormatInt(v, 10)
+			out[id].Value.IntValue = &i
+		case bool:
+			out[id].Value.BoolValue = &v
+		default:
+			s := fmt.Sprintf("%v", v)
+			out[id].Value.StringValue = &s
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,242 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// Kind of the span
+type Kind int
+
+const (
+	// KindInternal is used for spans of operations within the operator
+	KindInternal Kind = 1
+	// KindClient is used for spans of outgoing requests
+	KindClient Kind = 3
+)
+
+// Attribute is a key-value pair attached to the span
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// String creates string attribute
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Int creates integer attribute
+func Int(key string, value int) Attribute {
+	return Attribute{Key: key, Value: int64(value)}
+}
+
+// Bool creates boolean attribute
+func Bool(key string, value bool) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// TraceID identifies the trace
+type TraceID [16]byte
+
+// String returns hex representation of the trace ID
+func (t TraceID) String() string {
+	return hex.EncodeToString(t[:])
+}
+
+// SpanID identifies the span within the trace
+type SpanID [8]byte
+
+// String returns hex representation of the span ID
+func (s SpanID) String() string {
+	return hex.EncodeToString(s[:])
+}
+
+// IsValid returns true if the span ID is set
+func (s SpanID) IsValid() bool {
+	return s != SpanID{}
+}
+
+// Span represents a single timed operation
+type Span interface {
+	// SetAttributes adds attributes to the span
+	SetAttributes(attrs ...Attribute)
+	// SetError marks the span as failed if the error is not nil
+	SetError(err error)
+	// End finishes the span and sends it to the exporter
+	End()
+	// TraceParent returns the W3C traceparent header value, empty for not recorded spans
+	TraceParent() string
+}
+
+// noopSpan is returned when tracing is disabled
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(...Attribute) {}
+
+func (noopSpan) SetError(error) {}
+
+func (noopSpan) End() {}
+
+func (noopSpan) TraceParent() string {
+	return ""
+}
+
+// SpanData is the finished span passed to the exporter
+type SpanData struct {
+	TraceID    TraceID
+	SpanID     SpanID
+	ParentID   SpanID
+	Name       string
+	Kind       Kind
+	Start      time.Time
+	End        time.Time
+	Attributes []Attribute
+	Error      string
+}
+
+// span is the recorded span
+type span struct {
+	lock     sync.Mutex
+	data     SpanData
+	ended    bool
+	exporter Exporter
+}
+
+func (s *span) SetAttributes(attrs ...Attribute) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.data.Attributes = append(s.data.Attributes, attrs...)
+}
+
+func (s *span) SetError(err error) {
+	if err == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.data.Error = err.Error()
+}
+
+func (s *span) End() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.ended {
+		return
+	}
+
+	s.ended = true
+	s.data.End = time.Now()
+	s.exporter.Export(s.data)
+}
+
+func (s *span) TraceParent() string {
+	return "00-" + s.data.TraceID.String() + "-" + s.data.SpanID.String() + "-01"
+}
+
+type spanContextKey struct{}
+
+var (
+	lock     sync.RWMutex
+	exporter Exporter
+)
+
+// SetExporter sets the exporter of finished spans. Tracing is disabled (no-op) when nil.
+func SetExporter(e Exporter) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if exporter != nil {
+		exporter.Close()
+	}
+
+	exporter = e
+}
+
+// Enabled returns true if spans are recorded
+func Enabled() bool {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	return exporter != nil
+}
+
+// Start creates the span of internal kind as a child of the span in the context.
+// Returned context holds the new span.
+func Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	return StartWithKind(ctx, KindInternal, name, attrs...)
+}
+
+// StartWithKind creates the span of the given kind as a child of the span in the context.
+func StartWithKind(ctx context.Context, kind Kind, name string, attrs ...Attribute) (context.Context, Span) {
+	lock.RLock()
+	e := exporter
+	lock.RUnlock()
+
+	if e == nil {
+		return ctx, noopSpan{}
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	s := &span{
+		exporter: e,
+		data: SpanData{
+			SpanID:     newSpanID(),
+			Name:       name,
+			Kind:       kind,
+			Start:      time.Now(),
+			Attributes: attrs,
+		},
+	}
+
+	if parent, ok := ctx.Value(spanContextKey{}).(*span); ok {
+		s.data.TraceID = parent.data.TraceID
+		s.data.ParentID = parent.data.SpanID
+	} else {
+		s.data.TraceID = newTraceID()
+	}
+
+	return context.WithValue(ctx, spanContextKey{}, s), s
+}
+
+func newTraceID() TraceID {
+	var t TraceID
+	_, _ = rand.Read(t[:])
+	return t
+}
+
+func newSpanID() SpanID {
+	var s SpanID
+	_, _ = rand.Read(s[:])
+	return s
+}
@@ -0,0 +1,175 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+type memoryExporter struct {
+	lock  sync.Mutex
+	spans []SpanData
+}
+
+func (m *memoryExporter) Export(s SpanData) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.spans = append(m.spans, s)
+}
+
+func (m *memoryExporter) Close() {}
+
+func Test_Start_Noop(t *testing.T) {
+	SetExporter(nil)
+
+	ctx := context.Background()
+	spanCtx, span := Start(ctx, "test")
+	require.Equal(t, ctx, spanCtx)
+	require.Equal(t, noopSpan{}, span)
+	require.Empty(t, span.TraceParent())
+	span.End()
+}
+
+func Test_Start_Child(t *testing.T) {
+	e := &memoryExporter{}
+	SetExporter(e)
+	defer SetExporter(nil)
+
+	ctx, parent := Start(context.Background(), "parent", String("key", "value"))
+	_, child := Start(ctx, "child")
+	child.SetError(errors.Newf("failed"))
+	child.End()
+	parent.End()
+	parent.End()
+
+	require.Len(t, e.spans, 2)
+	require.Equal(t, "child", e.spans[0].Name)
+	require.Equal(t, "failed", e.spans[0].Error)
+	require.Equal(t, "parent", e.spans[1].Name)
+	require.Equal(t, e.spans[1].TraceID, e.spans[0].TraceID)
+	require.Equal(t, e.spans[1].SpanID, e.spans[0].ParentID)
+	require.False(t, e.spans[1].ParentID.IsValid())
+	require.Equal(t, []Attribute{String("key", "value")}, e.spans[1].Attributes)
+}
+
+func Test_Transport(t *testing.T) {
+	var traceParent string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		traceParent = req.Header.Get(traceParentHeader)
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	require.Equal(t, http.DefaultTransport, NewTransport(nil))
+
+	e := &memoryExporter{}
+	SetExporter(e)
+	defer SetExporter(nil)
+
+	ctx, parent := Start(context.Background(), "parent")
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/_api/version", nil)
+	require.NoError(t, err)
+
+	client := &http.Client{Transport: NewTransport(nil)}
+	resp, err := client.Do(req.WithContext(ctx))
+	require.NoError(t, err)
+	resp.Body.Close()
+	parent.End()
+
+	require.Len(t, e.spans, 2)
+	s := e.spans[0]
+	require.Equal(t, "HTTP GET", s.Name)
+	require.Equal(t, KindClient, s.Kind)
+	require.Equal(t, e.spans[1].SpanID, s.ParentID)
+	require.Equal(t, "HTTP 503", s.Error)
+	require.Contains(t, s.Attributes, Int("http.status_code", http.StatusServiceUnavailable))
+	require.Equal(t, "00-"+s.TraceID.String()+"-"+s.SpanID.String()+"-01", traceParent)
+}
+
+func Test_OTLPExporter(t *testing.T) {
+	var lock sync.Mutex
+	var paths []string
+	var requests []otlpRequest
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		var r otlpRequest
+		data, _ := ioutil.ReadAll(req.Body)
+		if err := json.Unmarshal(data, &r); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		paths = append(paths, req.URL.Path)
+		requests = append(requests, r)
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	_, err := NewOTLPExporter("", "", nil)
+	require.Error(t, err)
+
+	e, err := NewOTLPExporter(server.URL+"/", "", nil)
+	require.NoError(t, err)
+	SetExporter(e)
+
+	ctx, parent := Start(context.Background(), "InspectDeployment", String("deployment.name", "test"), Int("count", 3))
+	_, child := Start(ctx, "CreatePlan", Bool("plan.changed", true))
+	child.SetError(errors.Newf("failed"))
+	child.End()
+	parent.End()
+
+	// Flushes the queue
+	SetExporter(nil)
+
+	require.Equal(t, []string{otlpTracesPath}, paths)
+	require.Len(t, requests, 1)
+	require.Len(t, requests[0].ResourceSpans, 1)
+
+	rs := requests[0].ResourceSpans[0]
+	require.Equal(t, "service.name", rs.Resource.Attributes[0].Key)
+	require.Equal(t, DefaultServiceName, *rs.Resource.Attributes[0].Value.StringValue)
+
+	spans := rs.ScopeSpans[0].Spans
+	require.Len(t, spans, 2)
+	require.Equal(t, "CreatePlan", spans[0].Name)
+	require.Equal(t, spans[1].SpanID, spans[0].ParentSpanID)
+	require.Equal(t, otlpStatusError, spans[0].Status.Code)
+	require.True(t, *spans[0].Attributes[0].Value.BoolValue)
+	require.Empty(t, spans[1].ParentSpanID)
+	require.Nil(t, spans[1].Status)
+	require.Equal(t, "3", *spans[1].Attributes[1].Value.IntValue)
+	require.Len(t, spans[1].TraceID, 32)
+	require.NotEqual(t, "0", spans[1].StartTimeUnixNano)
+}
@@ -0,0 +1,93 @@
+//
+// DISCLAIMER
+//
+// Copyright 2021 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package tracing
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/arangodb/kube-arangodb/pkg/util/errors"
+)
+
+const (
+	traceParentHeader = "traceparent"
+)
+
+// NewTransport wraps the round tripper with client spans of the HTTP requests.
+// The W3C traceparent header is propagated to the server.
+// The round tripper is returned as is when tracing is disabled, so the driver can still tune *http.Transport.
+func NewTransport(rt http.RoundTripper) http.RoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	if !Enabled() {
+		return rt
+	}
+
+	return &transport{next: rt}
+}
+
+type transport struct {
+	next http.RoundTripper
+}
+
+// Unwrap returns the wrapped round tripper
+func (t *transport) Unwrap() http.RoundTripper {
+	return t.next
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !Enabled() {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx, span := StartWithKind(req.Context(), KindClient, "HTTP "+req.Method,
+		String("http.method", req.Method),
+		String("http.url", redactedURL(req.URL)),
+		String("net.peer.name", req.URL.Host))
+	defer span.End()
+
+	req = req.Clone(ctx)
+	if tp := span.TraceParent(); tp != "" {
+		req.Header.Set(traceParentHeader, tp)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		span.SetError(err)
+		return nil, err
+	}
+
+	span.SetAttributes(Int("http.status_code", resp.StatusCode))
+	if resp.StatusCode >= http.StatusInternalServerError {
+		span.SetError(errors.Newf("HTTP %d", resp.StatusCode))
+	}
+
+	return resp, nil
+}
+
+// redactedURL returns the URL without user credentials
+func redactedURL(u *url.URL) string {
+	r := *u
+	r.User = nil
+	return r.String()
+}